@@ -25,12 +25,19 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/extensions/reconciler/function"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/jqtransformation"
+	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/jsontoxmltransformation"
+	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/schemavalidation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/synchronizer"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/transformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/xmltojsontransformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/xslttransformation"
+	"github.com/triggermesh/triggermesh/pkg/routing/reconciler/aggregator"
+	"github.com/triggermesh/triggermesh/pkg/routing/reconciler/deduplicator"
 	"github.com/triggermesh/triggermesh/pkg/routing/reconciler/filter"
+	"github.com/triggermesh/triggermesh/pkg/routing/reconciler/router"
+	"github.com/triggermesh/triggermesh/pkg/routing/reconciler/sampler"
 	"github.com/triggermesh/triggermesh/pkg/routing/reconciler/splitter"
+	"github.com/triggermesh/triggermesh/pkg/routing/reconciler/throttler"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/awscloudwatchlogssource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/awscloudwatchsource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/awscodecommitsource"
@@ -189,6 +196,8 @@ func main() {
 		zendesktarget.NewController,
 		// flow
 		jqtransformation.NewController,
+		jsontoxmltransformation.NewController,
+		schemavalidation.NewController,
 		synchronizer.NewController,
 		transformation.NewController,
 		xmltojsontransformation.NewController,
@@ -196,7 +205,12 @@ func main() {
 		// extensions
 		function.NewController,
 		// routing
+		aggregator.NewController,
+		deduplicator.NewController,
 		filter.NewController,
+		router.NewController,
+		sampler.NewController,
 		splitter.NewController,
+		throttler.NewController,
 	)
 }
@@ -30,6 +30,18 @@ var (
 		Resource: "jqtransformations",
 	}
 
+	// JSONToXMLTransformationResource respresents a JSON to XML transformation.
+	JSONToXMLTransformationResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "jsontoxmltransformations",
+	}
+
+	// SchemaValidationResource respresents a schema validation.
+	SchemaValidationResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "schemavalidations",
+	}
+
 	// SynchronizerResource respresents a Synchronizer.
 	SynchronizerResource = schema.GroupResource{
 		Group:    GroupName,
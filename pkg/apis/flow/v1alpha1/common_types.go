@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
@@ -52,3 +53,54 @@ type EventOptions struct {
 	// +optional
 	PayloadPolicy *cloudevents.PayloadPolicy `json:"payloadPolicy,omitempty"`
 }
+
+// RedisConnection contains the parameters of the connection to a Redis compatible server.
+type RedisConnection struct {
+	// Address of the server in the "host:port" format.
+	Address string `json:"address"`
+	// Username for the server authentication.
+	// +optional
+	Username *string `json:"username,omitempty"`
+	// Password for the server authentication.
+	// +optional
+	Password *v1alpha1.ValueFromField `json:"password,omitempty"`
+	// Database number to select after connecting to the server.
+	// +optional
+	Database *int `json:"database,omitempty"`
+	// TLSEnabled indicates whether the connection must use TLS.
+	// +optional
+	TLSEnabled *bool `json:"tlsEnabled,omitempty"`
+}
+
+// defaultXMLJSONAttributePrefix is the prefix of the JSON keys that represent
+// XML attributes when XMLJSONConventions do not set one.
+const defaultXMLJSONAttributePrefix = "-"
+
+// XMLJSONConventions set how XML documents are represented as JSON. Applying
+// the same conventions to the XML to JSON and JSON to XML transformations
+// preserves the structure of the documents.
+type XMLJSONConventions struct {
+	// Prefix of the JSON keys that represent XML attributes. Defaults to "-".
+	// +optional
+	AttributePrefix *string `json:"attributePrefix,omitempty"`
+	// JSON key that holds the text of XML elements which also contain
+	// attributes or child elements. Defaults to "#content".
+	// +optional
+	TextKey *string `json:"textKey,omitempty"`
+	// Names of the XML elements that are always represented as JSON arrays,
+	// even when they occur once.
+	// +optional
+	ArrayElements []string `json:"arrayElements,omitempty"`
+	// Whether XML names keep their namespace prefix, along with the namespace
+	// declarations. Otherwise only local names are used.
+	// +optional
+	PreserveNamespaces *bool `json:"preserveNamespaces,omitempty"`
+	// Namespaces declared on the root element of the XML documents, by
+	// prefix. An empty prefix declares the default namespace.
+	// +optional
+	Namespaces map[string]string `json:"namespaces,omitempty"`
+	// Name of the XML root element. When set, the root element is not
+	// represented in JSON documents.
+	// +optional
+	RootElement *string `json:"rootElement,omitempty"`
+}
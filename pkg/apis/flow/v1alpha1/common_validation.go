@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 
 	"knative.dev/pkg/apis"
 )
@@ -46,3 +47,51 @@ func (v *ValueFromField) Validate(_ context.Context) *apis.FieldError {
 
 	return nil
 }
+
+// Validate makes sure that the Redis server address is informed.
+func (r *RedisConnection) Validate(_ context.Context) *apis.FieldError {
+	if r == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+	if r.Address == "" {
+		errs = errs.Also(apis.ErrMissingField("address"))
+	}
+	if r.Database != nil && *r.Database < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*r.Database, "database"))
+	}
+	return errs
+}
+
+// Validate makes sure that the XML to JSON conventions are not ambiguous.
+func (c *XMLJSONConventions) Validate(_ context.Context) *apis.FieldError {
+	if c == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+
+	attrPrefix := defaultXMLJSONAttributePrefix
+	if c.AttributePrefix != nil {
+		if attrPrefix = *c.AttributePrefix; attrPrefix == "" {
+			errs = errs.Also(apis.ErrInvalidValue(attrPrefix, "attributePrefix"))
+		}
+	}
+	if c.TextKey != nil {
+		if *c.TextKey == "" {
+			errs = errs.Also(apis.ErrInvalidValue(*c.TextKey, "textKey"))
+		} else if attrPrefix != "" && strings.HasPrefix(*c.TextKey, attrPrefix) {
+			errs = errs.Also(apis.ErrGeneric("the text key cannot start with the attribute prefix", "textKey"))
+		}
+	}
+	if c.RootElement != nil && *c.RootElement == "" {
+		errs = errs.Also(apis.ErrInvalidValue(*c.RootElement, "rootElement"))
+	}
+	for prefix, uri := range c.Namespaces {
+		if uri == "" {
+			errs = errs.Also(apis.ErrInvalidKeyName(prefix, "namespaces", "the namespace URI cannot be empty"))
+		}
+	}
+	return errs
+}
@@ -96,3 +96,56 @@ func TestValueFromFieldValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestXMLJSONConventionsValidate(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		conventions *XMLJSONConventions
+		expectError *apis.FieldError
+	}{
+		"nil, ok": {
+			conventions: nil,
+			expectError: nil,
+		},
+		"custom conventions, ok": {
+			conventions: &XMLJSONConventions{
+				AttributePrefix: str("@"),
+				TextKey:         str("#text"),
+				ArrayElements:   []string{"item"},
+				Namespaces:      map[string]string{"soap": "http://schemas.xmlsoap.org/soap/envelope/"},
+				RootElement:     str("order"),
+			},
+			expectError: nil,
+		},
+		"empty values, fail": {
+			conventions: &XMLJSONConventions{
+				AttributePrefix: str(""),
+				TextKey:         str(""),
+				RootElement:     str(""),
+			},
+			expectError: errs.Also(apis.ErrInvalidValue("", "attributePrefix")).
+				Also(apis.ErrInvalidValue("", "textKey")).
+				Also(apis.ErrInvalidValue("", "rootElement")),
+		},
+		"text key with default attribute prefix, fail": {
+			conventions: &XMLJSONConventions{
+				TextKey: str("-text"),
+			},
+			expectError: errs.Also(apis.ErrGeneric("the text key cannot start with the attribute prefix", "textKey")),
+		},
+		"empty namespace URI, fail": {
+			conventions: &XMLJSONConventions{
+				Namespaces: map[string]string{"m": ""},
+			},
+			expectError: errs.Also(apis.ErrInvalidKeyName("m", "namespaces", "the namespace URI cannot be empty")),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectError, tc.conventions.Validate(context.Background()))
+		})
+	}
+}
@@ -22,15 +22,53 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apis "github.com/triggermesh/triggermesh/pkg/apis"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	cloudevents "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "knative.dev/eventing/pkg/apis/duck/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AsyncResponse) DeepCopyInto(out *AsyncResponse) {
+	*out = *in
+	if in.AllowedCallbackURLs != nil {
+		in, out := &in.AllowedCallbackURLs, &out.AllowedCallbackURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AsyncResponse.
+func (in *AsyncResponse) DeepCopy() *AsyncResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(AsyncResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Correlation) DeepCopyInto(out *Correlation) {
 	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(CorrelationSource)
+		**out = **in
+	}
+	if in.ResponseSource != nil {
+		in, out := &in.ResponseSource, &out.ResponseSource
+		*out = new(CorrelationSource)
+		**out = **in
+	}
 	return
 }
 
@@ -44,6 +82,22 @@ func (in *Correlation) DeepCopy() *Correlation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CorrelationSource) DeepCopyInto(out *CorrelationSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CorrelationSource.
+func (in *CorrelationSource) DeepCopy() *CorrelationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CorrelationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventOptions) DeepCopyInto(out *EventOptions) {
 	*out = *in
@@ -65,6 +119,80 @@ func (in *EventOptions) DeepCopy() *EventOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSchema) DeepCopyInto(out *EventSchema) {
+	*out = *in
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Schema.DeepCopyInto(&out.Schema)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSchema.
+func (in *EventSchema) DeepCopy() *EventSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JQBatch) DeepCopyInto(out *JQBatch) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JQBatch.
+func (in *JQBatch) DeepCopy() *JQBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(JQBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JQOutput) DeepCopyInto(out *JQOutput) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JQOutput.
+func (in *JQOutput) DeepCopy() *JQOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(JQOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JQTransformation) DeepCopyInto(out *JQTransformation) {
 	*out = *in
@@ -134,6 +262,16 @@ func (in *JQTransformationSpec) DeepCopyInto(out *JQTransformationSpec) {
 		*out = new(EventOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(JQOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(JQBatch)
+		(*in).DeepCopyInto(*out)
+	}
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -153,6 +291,116 @@ func (in *JQTransformationSpec) DeepCopy() *JQTransformationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONToXMLTransformation) DeepCopyInto(out *JSONToXMLTransformation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONToXMLTransformation.
+func (in *JSONToXMLTransformation) DeepCopy() *JSONToXMLTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONToXMLTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JSONToXMLTransformation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONToXMLTransformationList) DeepCopyInto(out *JSONToXMLTransformationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JSONToXMLTransformation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONToXMLTransformationList.
+func (in *JSONToXMLTransformationList) DeepCopy() *JSONToXMLTransformationList {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONToXMLTransformationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JSONToXMLTransformationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONToXMLTransformationSpec) DeepCopyInto(out *JSONToXMLTransformationSpec) {
+	*out = *in
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conventions != nil {
+		in, out := &in.Conventions, &out.Conventions
+		*out = new(XMLJSONConventions)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONToXMLTransformationSpec.
+func (in *JSONToXMLTransformationSpec) DeepCopy() *JSONToXMLTransformationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONToXMLTransformationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LookupTable) DeepCopyInto(out *LookupTable) {
+	*out = *in
+	out.ConfigMap = in.ConfigMap
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LookupTable.
+func (in *LookupTable) DeepCopy() *LookupTable {
+	if in == nil {
+		return nil
+	}
+	out := new(LookupTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Path) DeepCopyInto(out *Path) {
 	*out = *in
@@ -169,9 +417,55 @@ func (in *Path) DeepCopy() *Path {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisConnection) DeepCopyInto(out *RedisConnection) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(int)
+		**out = **in
+	}
+	if in.TLSEnabled != nil {
+		in, out := &in.TLSEnabled, &out.TLSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisConnection.
+func (in *RedisConnection) DeepCopy() *RedisConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Response) DeepCopyInto(out *Response) {
 	*out = *in
+	if in.Async != nil {
+		in, out := &in.Async, &out.Async
+		*out = new(AsyncResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Aggregation != nil {
+		in, out := &in.Aggregation, &out.Aggregation
+		*out = new(ResponseAggregation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -185,6 +479,145 @@ func (in *Response) DeepCopy() *Response {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseAggregation) DeepCopyInto(out *ResponseAggregation) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseAggregation.
+func (in *ResponseAggregation) DeepCopy() *ResponseAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidation) DeepCopyInto(out *SchemaValidation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidation.
+func (in *SchemaValidation) DeepCopy() *SchemaValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchemaValidation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidationList) DeepCopyInto(out *SchemaValidationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SchemaValidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidationList.
+func (in *SchemaValidationList) DeepCopy() *SchemaValidationList {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchemaValidationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidationSpec) DeepCopyInto(out *SchemaValidationSpec) {
+	*out = *in
+	if in.Schemas != nil {
+		in, out := &in.Schemas, &out.Schemas
+		*out = make([]EventSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Delivery != nil {
+		in, out := &in.Delivery, &out.Delivery
+		*out = new(v1.DeliverySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidationSpec.
+func (in *SchemaValidationSpec) DeepCopy() *SchemaValidationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidationStatus) DeepCopyInto(out *SchemaValidationStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	in.DeliveryStatus.DeepCopyInto(&out.DeliveryStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidationStatus.
+func (in *SchemaValidationStatus) DeepCopy() *SchemaValidationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Synchronizer) DeepCopyInto(out *Synchronizer) {
 	*out = *in
@@ -246,11 +679,37 @@ func (in *SynchronizerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SynchronizerSessions) DeepCopyInto(out *SynchronizerSessions) {
+	*out = *in
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SynchronizerSessions.
+func (in *SynchronizerSessions) DeepCopy() *SynchronizerSessions {
+	if in == nil {
+		return nil
+	}
+	out := new(SynchronizerSessions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SynchronizerSpec) DeepCopyInto(out *SynchronizerSpec) {
 	*out = *in
-	out.CorrelationKey = in.CorrelationKey
-	out.Response = in.Response
+	in.CorrelationKey.DeepCopyInto(&out.CorrelationKey)
+	in.Response.DeepCopyInto(&out.Response)
+	if in.Sessions != nil {
+		in, out := &in.Sessions, &out.Sessions
+		*out = new(SynchronizerSessions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -369,6 +828,16 @@ func (in *TransformationSpec) DeepCopyInto(out *TransformationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(TransformationStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LookupTables != nil {
+		in, out := &in.LookupTables, &out.LookupTables
+		*out = make([]LookupTable, len(*in))
+		copy(*out, *in)
+	}
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -388,17 +857,48 @@ func (in *TransformationSpec) DeepCopy() *TransformationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransformationStorage) DeepCopyInto(out *TransformationStorage) {
+	*out = *in
+	if in.MaxEvents != nil {
+		in, out := &in.MaxEvents, &out.MaxEvents
+		*out = new(int)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformationStorage.
+func (in *TransformationStorage) DeepCopy() *TransformationStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(TransformationStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValueFromField) DeepCopyInto(out *ValueFromField) {
 	*out = *in
 	if in.ValueFromSecret != nil {
 		in, out := &in.ValueFromSecret, &out.ValueFromSecret
-		*out = new(v1.SecretKeySelector)
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ValueFromConfigMap != nil {
 		in, out := &in.ValueFromConfigMap, &out.ValueFromConfigMap
-		*out = new(v1.ConfigMapKeySelector)
+		*out = new(corev1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -414,6 +914,54 @@ func (in *ValueFromField) DeepCopy() *ValueFromField {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XMLJSONConventions) DeepCopyInto(out *XMLJSONConventions) {
+	*out = *in
+	if in.AttributePrefix != nil {
+		in, out := &in.AttributePrefix, &out.AttributePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.TextKey != nil {
+		in, out := &in.TextKey, &out.TextKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.ArrayElements != nil {
+		in, out := &in.ArrayElements, &out.ArrayElements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreserveNamespaces != nil {
+		in, out := &in.PreserveNamespaces, &out.PreserveNamespaces
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RootElement != nil {
+		in, out := &in.RootElement, &out.RootElement
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XMLJSONConventions.
+func (in *XMLJSONConventions) DeepCopy() *XMLJSONConventions {
+	if in == nil {
+		return nil
+	}
+	out := new(XMLJSONConventions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *XMLToJSONTransformation) DeepCopyInto(out *XMLToJSONTransformation) {
 	*out = *in
@@ -483,6 +1031,11 @@ func (in *XMLToJSONTransformationSpec) DeepCopyInto(out *XMLToJSONTransformation
 		*out = new(EventOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conventions != nil {
+		in, out := &in.Conventions, &out.Conventions
+		*out = new(XMLJSONConventions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -502,6 +1055,22 @@ func (in *XMLToJSONTransformationSpec) DeepCopy() *XMLToJSONTransformationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSLTParameter) DeepCopyInto(out *XSLTParameter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSLTParameter.
+func (in *XSLTParameter) DeepCopy() *XSLTParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(XSLTParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *XSLTTransformation) DeepCopyInto(out *XSLTTransformation) {
 	*out = *in
@@ -576,6 +1145,11 @@ func (in *XSLTTransformationSpec) DeepCopyInto(out *XSLTTransformationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]XSLTParameter, len(*in))
+		copy(*out, *in)
+	}
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -69,5 +70,21 @@ func (t *JQTransformation) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (t *JQTransformation) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	multiple := t.Spec.Batch != nil || (t.Spec.Output != nil && t.Spec.Output.Split)
+	if multiple && t.Spec.Sink.Ref == nil && t.Spec.Sink.URI == nil {
+		errs = errs.Also(apis.ErrGeneric("a sink is required to emit multiple events", "sink").ViaField("spec"))
+	}
+
+	if b := t.Spec.Batch; b != nil {
+		if time.Duration(b.Window) <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(b.Window, "window").ViaField("spec", "batch"))
+		}
+		if b.Size != nil && *b.Size < 1 {
+			errs = errs.Also(apis.ErrInvalidValue(*b.Size, "size").ViaField("spec", "batch"))
+		}
+	}
+
+	return errs
 }
@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -50,6 +51,14 @@ type JQTransformationSpec struct {
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
+	// Output defines how the query results are emitted.
+	// +optional
+	Output *JQOutput `json:"output,omitempty"`
+
+	// Batch enables the query to be applied to arrays of buffered events.
+	// +optional
+	Batch *JQBatch `json:"batch,omitempty"`
+
 	// Support sending to an event sink instead of replying.
 	duckv1.SourceSpec `json:",inline"`
 
@@ -58,6 +67,31 @@ type JQTransformationSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// JQOutput defines how the query results are turned into CloudEvents.
+type JQOutput struct {
+	// Split emits an event per query result instead of the last result.
+	// +optional
+	Split bool `json:"split,omitempty"`
+
+	// Go templates of the emitted events attributes, rendered with the
+	// incoming event (.Event), the query result (.Data) and its position (.Index).
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// +optional
+	Source *string `json:"source,omitempty"`
+	// +optional
+	Subject *string `json:"subject,omitempty"`
+}
+
+// JQBatch defines the buffering of events which data is passed to the query as an array.
+type JQBatch struct {
+	// Duration of the window during which the events are buffered.
+	Window apis.Duration `json:"window"`
+	// Maximum number of events in a batch.
+	// +optional
+	Size *int `json:"size,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // JQTransformationList is a list of component instances.
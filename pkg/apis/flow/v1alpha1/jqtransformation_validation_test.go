@@ -0,0 +1,89 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestJQTransformationValidate(t *testing.T) {
+	sink, err := pkgapis.ParseURL("http://sink")
+	assert.NoError(t, err)
+
+	zero := 0
+
+	testCases := map[string]struct {
+		spec        JQTransformationSpec
+		noSink      bool
+		expectError string
+	}{
+		"default output": {
+			spec: JQTransformationSpec{Query: "."},
+		},
+		"split with sink": {
+			spec: JQTransformationSpec{
+				Query:  ".[]",
+				Output: &JQOutput{Split: true},
+			},
+		},
+		"split without sink": {
+			spec: JQTransformationSpec{
+				Query:  ".[]",
+				Output: &JQOutput{Split: true},
+			},
+			noSink:      true,
+			expectError: "a sink is required to emit multiple events: spec.sink",
+		},
+		"batch with sink": {
+			spec: JQTransformationSpec{
+				Query: "map(.id)",
+				Batch: &JQBatch{Window: apis.Duration(time.Second)},
+			},
+		},
+		"batch with invalid values": {
+			spec: JQTransformationSpec{
+				Query: "map(.id)",
+				Batch: &JQBatch{Size: &zero},
+			},
+			expectError: "invalid value: 0: spec.batch.size\ninvalid value: 0s: spec.batch.window",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			jq := &JQTransformation{Spec: tc.spec}
+			if !tc.noSink {
+				jq.Spec.Sink.URI = sink
+			}
+
+			err := jq.Validate(context.Background())
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError)
+		})
+	}
+}
@@ -48,6 +48,8 @@ var (
 // AllTypes is a list of all the types defined in this package.
 var AllTypes = []v1alpha1.GroupObject{
 	{Single: &JQTransformation{}, List: &JQTransformationList{}},
+	{Single: &JSONToXMLTransformation{}, List: &JSONToXMLTransformationList{}},
+	{Single: &SchemaValidation{}, List: &SchemaValidationList{}},
 	{Single: &Synchronizer{}, List: &SynchronizerList{}},
 	{Single: &Transformation{}, List: &TransformationList{}},
 	{Single: &XMLToJSONTransformation{}, List: &XMLToJSONTransformationList{}},
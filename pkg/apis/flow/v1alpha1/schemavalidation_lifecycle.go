@@ -0,0 +1,73 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Managed event types
+const (
+	EventTypeSchemaValidationError = "io.triggermesh.schemavalidation.error"
+)
+
+// GetGroupVersionKind implements kmeta.OwnerRefable.
+func (*SchemaValidation) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("SchemaValidation")
+}
+
+// GetConditionSet implements duckv1.KRShaped.
+func (v *SchemaValidation) GetConditionSet() apis.ConditionSet {
+	if v.Spec.Sink.Ref != nil || v.Spec.Sink.URI != nil {
+		return v1alpha1.EventSenderConditionSet
+	}
+	return v1alpha1.DefaultConditionSet
+}
+
+// GetStatus implements duckv1.KRShaped.
+func (v *SchemaValidation) GetStatus() *duckv1.Status {
+	return &v.Status.Status.Status
+}
+
+// GetStatusManager implements Reconcilable.
+func (v *SchemaValidation) GetStatusManager() *v1alpha1.StatusManager {
+	return &v1alpha1.StatusManager{
+		ConditionSet: v.GetConditionSet(),
+		Status:       &v.Status.Status,
+	}
+}
+
+// GetSink implements EventSender.
+func (v *SchemaValidation) GetSink() *duckv1.Destination {
+	return &v.Spec.Sink
+}
+
+// GetAdapterOverrides implements AdapterConfigurable.
+func (v *SchemaValidation) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
+	return v.Spec.AdapterOverrides
+}
+
+// SetDefaults implements apis.Defaultable
+func (v *SchemaValidation) SetDefaults(ctx context.Context) {
+}
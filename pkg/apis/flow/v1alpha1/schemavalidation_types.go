@@ -0,0 +1,119 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchemaValidation is the schema for the event validator.
+type SchemaValidation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SchemaValidationSpec   `json:"spec"`
+	Status SchemaValidationStatus `json:"status,omitempty"`
+}
+
+// Check the interfaces SchemaValidation should be implementing.
+var (
+	_ apis.Validatable = (*SchemaValidation)(nil)
+	_ apis.Defaultable = (*SchemaValidation)(nil)
+
+	_ v1alpha1.Reconcilable        = (*SchemaValidation)(nil)
+	_ v1alpha1.AdapterConfigurable = (*SchemaValidation)(nil)
+	_ v1alpha1.EventSender         = (*SchemaValidation)(nil)
+)
+
+// SchemaLanguage is the language a schema is written in.
+type SchemaLanguage string
+
+// Supported schema languages.
+const (
+	// SchemaLanguageJSONSchema validates JSON payloads against a JSON Schema
+	// (draft 4).
+	SchemaLanguageJSONSchema SchemaLanguage = "JSONSchema"
+	// SchemaLanguageXMLSchema validates XML payloads against an XML Schema
+	// Definition (XSD 1.0).
+	SchemaLanguageXMLSchema SchemaLanguage = "XMLSchema"
+)
+
+// SchemaValidationSpec defines the desired state of the component.
+type SchemaValidationSpec struct {
+	// Schemas the event payloads are validated against. Each event is
+	// validated against the first schema selecting its type. Events
+	// selected by none of the schemas are forwarded without validation.
+	Schemas []EventSchema `json:"schemas"`
+
+	// EventOptions for the replies of the component.
+	// +optional
+	EventOptions *EventOptions `json:"eventOptions,omitempty"`
+
+	// Support sending to an event sink instead of replying.
+	duckv1.SourceSpec `json:",inline"`
+
+	// Delivery defines where the events that fail the validation are sent.
+	// When no dead letter sink is set, they are replied with an error.
+	// +optional
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// EventSchema is a schema applied to the payload of events of given types.
+type EventSchema struct {
+	// Language of the schema.
+	Language SchemaLanguage `json:"language"`
+
+	// Types of the events validated by the schema. The schema applies to
+	// events of any type when it is not set.
+	// +optional
+	EventTypes []string `json:"eventTypes,omitempty"`
+
+	// Schema document, inline or from a ConfigMap or Secret.
+	Schema ValueFromField `json:"schema"`
+}
+
+// SchemaValidationStatus defines the observed state of the component.
+type SchemaValidationStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// DeliveryStatus contains the resolved URI of the dead letter sink.
+	eventingduckv1.DeliveryStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchemaValidationList is a list of component instances.
+type SchemaValidationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []SchemaValidation `json:"items"`
+}
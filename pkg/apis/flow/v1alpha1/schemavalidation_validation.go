@@ -0,0 +1,112 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (v *SchemaValidation) Validate(ctx context.Context) *apis.FieldError {
+	return v.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *SchemaValidationSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if len(s.Schemas) == 0 {
+		errs = errs.Also(apis.ErrMissingField("schemas"))
+	}
+	for i := range s.Schemas {
+		errs = errs.Also(s.Schemas[i].Validate(ctx).ViaFieldIndex("schemas", i))
+	}
+
+	if s.Delivery != nil {
+		errs = errs.Also(s.Delivery.Validate(ctx).ViaField("delivery"))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (s *EventSchema) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch s.Language {
+	case SchemaLanguageJSONSchema, SchemaLanguageXMLSchema:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("language"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.Language, "language"))
+	}
+
+	for i, t := range s.EventTypes {
+		if t == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(t, "eventTypes", i))
+		}
+	}
+
+	if !s.Schema.IsInformed() {
+		errs = errs.Also(apis.ErrMissingField("schema"))
+	} else if err := s.Schema.Validate(ctx); err != nil {
+		errs = errs.Also(err.ViaField("schema"))
+	} else if err := checkInlineSchema(s.Language, s.Schema.Value); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(err.Error(), "value").ViaField("schema"))
+	}
+
+	return errs
+}
+
+// checkInlineSchema verifies that a schema provided inline is a well-formed
+// document. Schemas sourced from ConfigMaps and Secrets are verified by the
+// adapter at startup.
+func checkInlineSchema(lang SchemaLanguage, schema string) error {
+	if schema == "" {
+		return nil
+	}
+
+	switch lang {
+	case SchemaLanguageJSONSchema:
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(schema), &obj); err != nil {
+			return fmt.Errorf("the schema is not a JSON object: %w", err)
+		}
+
+	case SchemaLanguageXMLSchema:
+		dec := xml.NewDecoder(bytes.NewReader([]byte(schema)))
+		for {
+			_, err := dec.Token()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("the schema is not a well-formed XML document: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
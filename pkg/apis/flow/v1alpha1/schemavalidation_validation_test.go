@@ -0,0 +1,107 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	tJSONSchema = `{"type": "object", "required": ["id"]}`
+	tXMLSchema  = `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="id" type="xs:string"/></xs:schema>`
+)
+
+func TestSchemaValidationValidate(t *testing.T) {
+	testCases := map[string]struct {
+		schemas     []EventSchema
+		expectError *apis.FieldError
+	}{
+		"inline schemas": {
+			schemas: []EventSchema{{
+				Language:   SchemaLanguageJSONSchema,
+				EventTypes: []string{"io.triggermesh.json"},
+				Schema:     *valueFromField(vffWithValue(tJSONSchema)),
+			}, {
+				Language: SchemaLanguageXMLSchema,
+				Schema:   *valueFromField(vffWithValue(tXMLSchema)),
+			}},
+			expectError: nil,
+		},
+		"schema from ConfigMap": {
+			schemas: []EventSchema{{
+				Language: SchemaLanguageJSONSchema,
+				Schema:   *valueFromField(vffWithConfigMap(tName, tKey)),
+			}},
+			expectError: nil,
+		},
+		"no schemas": {
+			schemas:     nil,
+			expectError: errs.Also(apis.ErrMissingField("schemas")).ViaField("spec"),
+		},
+		"incomplete schema": {
+			schemas: []EventSchema{{
+				EventTypes: []string{""},
+			}},
+			expectError: errs.Also(errs.Also(
+				apis.ErrMissingField("language"),
+			).Also(
+				apis.ErrInvalidArrayValue("", "eventTypes", 0),
+			).Also(
+				apis.ErrMissingField("schema"),
+			).ViaFieldIndex("schemas", 0)).ViaField("spec"),
+		},
+		"unknown language": {
+			schemas: []EventSchema{{
+				Language: "RelaxNG",
+				Schema:   *valueFromField(vffWithValue(tXMLSchema)),
+			}},
+			expectError: errs.Also(errs.Also(apis.ErrInvalidValue("RelaxNG", "language")).ViaFieldIndex("schemas", 0)).ViaField("spec"),
+		},
+		"malformed JSON schema": {
+			schemas: []EventSchema{{
+				Language: SchemaLanguageJSONSchema,
+				Schema:   *valueFromField(vffWithValue(`{"type": `)),
+			}},
+			expectError: errs.Also(errs.Also(apis.ErrInvalidValue("the schema is not a JSON object: unexpected end of JSON input", "value").
+				ViaField("schema")).ViaFieldIndex("schemas", 0)).ViaField("spec"),
+		},
+		"malformed XML schema": {
+			schemas: []EventSchema{{
+				Language: SchemaLanguageXMLSchema,
+				Schema:   *valueFromField(vffWithValue(`<xs:schema>`)),
+			}},
+			expectError: errs.Also(errs.Also(apis.ErrInvalidValue("the schema is not a well-formed XML document: XML syntax error on line 1: unexpected EOF", "value").
+				ViaField("schema")).ViaFieldIndex("schemas", 0)).ViaField("spec"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			v := &SchemaValidation{
+				Spec: SchemaValidationSpec{
+					Schemas: tc.schemas,
+				},
+			}
+			assert.Equal(t, tc.expectError, v.Validate(context.Background()))
+		})
+	}
+}
@@ -27,6 +27,11 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
+// Managed event types
+const (
+	EventTypeSynchronizerRequestAccepted = "io.triggermesh.synchronizer.request.accepted"
+)
+
 // GetGroupVersionKind implements kmeta.OwnerRefable.
 func (*Synchronizer) GetGroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind("Synchronizer")
@@ -66,8 +71,3 @@ func (s *Synchronizer) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 // SetDefaults implements apis.Defaultable
 func (s *Synchronizer) SetDefaults(ctx context.Context) {
 }
-
-// Validate implements apis.Validatable
-func (s *Synchronizer) Validate(ctx context.Context) *apis.FieldError {
-	return nil
-}
@@ -50,6 +50,10 @@ type SynchronizerSpec struct {
 	CorrelationKey Correlation `json:"correlationKey"`
 	Response       Response    `json:"response"`
 
+	// Sessions configures the storage of the client sessions.
+	// +optional
+	Sessions *SynchronizerSessions `json:"sessions,omitempty"`
+
 	// Support sending to an event sink instead of replying.
 	duckv1.SourceSpec `json:",inline"`
 
@@ -62,11 +66,95 @@ type SynchronizerSpec struct {
 type Correlation struct {
 	Attribute string `json:"attribute"`
 	Length    int    `json:"length"`
+
+	// Source of the correlation keys supplied by the caller. Keys are
+	// generated if the source is not set.
+	// +optional
+	Source *CorrelationSource `json:"source,omitempty"`
+
+	// ResponseSource of the correlation keys of the responses which do not
+	// carry the correlation attribute. Events which contain a key at this
+	// source are handled as responses.
+	// +optional
+	ResponseSource *CorrelationSource `json:"responseSource,omitempty"`
+}
+
+// CorrelationSource defines where the caller supplied correlation keys are read from.
+// Only one of the fields can be set.
+type CorrelationSource struct {
+	// JSON path of the key in the event payload.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Name of the HTTP request header that contains the key.
+	// +optional
+	Header string `json:"header,omitempty"`
+	// Name of the CloudEvent context attribute that contains the key,
+	// standard attributes such as "subject" are allowed.
+	// +optional
+	ContextAttribute string `json:"contextAttribute,omitempty"`
+	// CEL expression evaluated against the event payload that returns the key.
+	// +optional
+	Expression string `json:"expression,omitempty"`
 }
 
 // Response defines the response handling configuration.
 type Response struct {
 	Timeout apis.Duration `json:"timeout"`
+
+	// Async enables the asynchronous mode where requests are acknowledged
+	// immediately with the correlation key and the session status URL.
+	// +optional
+	Async *AsyncResponse `json:"async,omitempty"`
+
+	// Aggregation enables the scatter-gather mode where multiple
+	// responses are merged into a single reply. Can not be combined with Async.
+	// +optional
+	Aggregation *ResponseAggregation `json:"aggregation,omitempty"`
+}
+
+// AsyncResponse defines the asynchronous response handling configuration.
+type AsyncResponse struct {
+	// Name of the request CloudEvent attribute that contains
+	// the URL where the response is sent once received.
+	// +optional
+	CallbackAttribute string `json:"callbackAttribute,omitempty"`
+	// AllowedCallbackURLs are the URLs the callback URLs must start with.
+	// Requests with any other callback URL are rejected.
+	// +optional
+	AllowedCallbackURLs []string `json:"allowedCallbackURLs,omitempty"`
+	// Lifetime of the asynchronous sessions and their responses, which
+	// defaults to the response timeout.
+	// Expressed as a duration string, which format is documented at https://pkg.go.dev/time#ParseDuration.
+	// +optional
+	TTL *apis.Duration `json:"ttl,omitempty"`
+}
+
+// ResponseAggregation defines how the responses are collected and merged.
+// Responses are collected until the timeout unless the count or
+// the completion expression is set.
+type ResponseAggregation struct {
+	// Number of responses that completes the aggregation.
+	// +optional
+	Count *int `json:"count,omitempty"`
+	// CEL expression evaluated against each response.
+	// The aggregation is completed if the expression returns true.
+	// +optional
+	Completion string `json:"completion,omitempty"`
+	// Merge strategy of the responses, "array" or "object".
+	// +optional
+	Merge string `json:"merge,omitempty"`
+	// Name of the response CloudEvent attribute used as the key
+	// by the "object" merge strategy.
+	// +optional
+	KeyAttribute string `json:"keyAttribute,omitempty"`
+}
+
+// SynchronizerSessions defines where the client sessions are kept.
+type SynchronizerSessions struct {
+	// Redis compatible server that keeps the sessions instead of the adapter
+	// memory, which allows the responses to be received by any replica.
+	// +optional
+	Redis *RedisConnection `json:"redis,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
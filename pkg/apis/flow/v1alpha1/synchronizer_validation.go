@@ -0,0 +1,145 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Validate implements apis.Validatable
+func (s *Synchronizer) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *SynchronizerSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if src := s.CorrelationKey.Source; src != nil {
+		errs = errs.Also(src.Validate(ctx).ViaField("source").ViaField("correlationKey"))
+	}
+	if src := s.CorrelationKey.ResponseSource; src != nil {
+		errs = errs.Also(src.Validate(ctx).ViaField("responseSource").ViaField("correlationKey"))
+	}
+	errs = errs.Also(s.Response.Validate(ctx).ViaField("response"))
+	errs = errs.Also(s.Sessions.Validate(ctx).ViaField("sessions"))
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (s *SynchronizerSessions) Validate(ctx context.Context) *apis.FieldError {
+	if s == nil {
+		return nil
+	}
+	return s.Redis.Validate(ctx).ViaField("redis")
+}
+
+// Validate implements apis.Validatable
+func (s *CorrelationSource) Validate(ctx context.Context) *apis.FieldError {
+	var set []string
+	for _, f := range []struct{ name, val string }{
+		{"path", s.Path},
+		{"header", s.Header},
+		{"contextAttribute", s.ContextAttribute},
+		{"expression", s.Expression},
+	} {
+		if f.val != "" {
+			set = append(set, f.name)
+		}
+	}
+
+	switch {
+	case len(set) == 0:
+		return apis.ErrMissingOneOf("path", "header", "contextAttribute", "expression")
+	case len(set) > 1:
+		return apis.ErrMultipleOneOf(set...)
+	}
+
+	if s.Expression != "" {
+		if _, err := cel.CompileValueExpression(s.Expression); err != nil {
+			return apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "expression")
+		}
+	}
+
+	return nil
+}
+
+// Validate implements apis.Validatable
+func (r *Response) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	// responses are not aggregated in the asynchronous mode
+	if r.Async != nil && r.Aggregation != nil {
+		errs = errs.Also(apis.ErrMultipleOneOf("async", "aggregation"))
+	}
+
+	if r.Async != nil {
+		errs = errs.Also(r.Async.Validate(ctx).ViaField("async"))
+	}
+	if r.Aggregation != nil {
+		errs = errs.Also(r.Aggregation.Validate(ctx).ViaField("aggregation"))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (a *AsyncResponse) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i, u := range a.AllowedCallbackURLs {
+		if allowed, err := url.Parse(u); err != nil || allowed.Scheme == "" || allowed.Host == "" {
+			errs = errs.Also(apis.ErrInvalidValue(u, apis.CurrentField).ViaFieldIndex("allowedCallbackURLs", i))
+		}
+	}
+
+	if a.TTL != nil && *a.TTL < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(a.TTL.String(), "ttl"))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (a *ResponseAggregation) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if a.Count != nil && *a.Count < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*a.Count, "count"))
+	}
+
+	if a.Completion != "" {
+		if _, err := cel.CompileExpression(a.Completion); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "completion"))
+		}
+	}
+
+	switch a.Merge {
+	case "", "array", "object":
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(a.Merge, "merge"))
+	}
+
+	return errs
+}
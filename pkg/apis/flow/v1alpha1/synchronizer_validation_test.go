@@ -0,0 +1,228 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestSynchronizerValidate(t *testing.T) {
+	testCases := map[string]struct {
+		spec        SynchronizerSpec
+		expectError bool
+		expectPath  string
+	}{
+		"valid aggregation": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Aggregation: &ResponseAggregation{
+						Count:      intPtr(2),
+						Completion: `$done.(bool) == true`,
+						Merge:      "object",
+					},
+				},
+			},
+		},
+		"valid correlation key expression": {
+			spec: SynchronizerSpec{
+				CorrelationKey: Correlation{
+					Source: &CorrelationSource{
+						Expression: `$order.id.(string)`,
+					},
+				},
+			},
+		},
+		"invalid correlation key expression": {
+			spec: SynchronizerSpec{
+				CorrelationKey: Correlation{
+					Source: &CorrelationSource{
+						Expression: `$order.id.(string) +`,
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.correlationKey.source.expression",
+		},
+		"multiple correlation key sources": {
+			spec: SynchronizerSpec{
+				CorrelationKey: Correlation{
+					Source: &CorrelationSource{
+						Path:   "order.id",
+						Header: "X-Order-Id",
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.correlationKey.source.header, spec.correlationKey.source.path",
+		},
+		"empty correlation key source": {
+			spec: SynchronizerSpec{
+				CorrelationKey: Correlation{
+					Source: &CorrelationSource{},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.correlationKey.source.contextAttribute",
+		},
+		"multiple correlation key response sources": {
+			spec: SynchronizerSpec{
+				CorrelationKey: Correlation{
+					ResponseSource: &CorrelationSource{
+						Path:   "result.order",
+						Header: "X-Order-Id",
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.correlationKey.responseSource.header, spec.correlationKey.responseSource.path",
+		},
+		"valid allowed callback URLs": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Async: &AsyncResponse{
+						AllowedCallbackURLs: []string{"https://example.com/callbacks/"},
+					},
+				},
+			},
+		},
+		"allowed callback URL without host": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Async: &AsyncResponse{
+						AllowedCallbackURLs: []string{"https://example.com", "/callbacks"},
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.response.async.allowedCallbackURLs[1]",
+		},
+		"malformed allowed callback URL": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Async: &AsyncResponse{
+						AllowedCallbackURLs: []string{"https://exa mple.com:port"},
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.response.async.allowedCallbackURLs[0]",
+		},
+		"aggregation in the asynchronous mode": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Async:       &AsyncResponse{},
+					Aggregation: &ResponseAggregation{},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.response.aggregation, spec.response.async",
+		},
+		"negative async sessions ttl": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Async: &AsyncResponse{
+						TTL: durationPtr(-time.Minute),
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.response.async.ttl",
+		},
+		"valid redis sessions storage": {
+			spec: SynchronizerSpec{
+				Sessions: &SynchronizerSessions{
+					Redis: &RedisConnection{
+						Address: "redis:6379",
+					},
+				},
+			},
+		},
+		"redis sessions storage without address": {
+			spec: SynchronizerSpec{
+				Sessions: &SynchronizerSessions{
+					Redis: &RedisConnection{},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.sessions.redis.address",
+		},
+		"invalid completion expression": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Aggregation: &ResponseAggregation{
+						Completion: `$done.(bool) ==`,
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.response.aggregation.completion",
+		},
+		"negative count": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Aggregation: &ResponseAggregation{
+						Count: intPtr(-1),
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.response.aggregation.count",
+		},
+		"unknown merge strategy": {
+			spec: SynchronizerSpec{
+				Response: Response{
+					Aggregation: &ResponseAggregation{
+						Merge: "zip",
+					},
+				},
+			},
+			expectError: true,
+			expectPath:  "spec.response.aggregation.merge",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s := &Synchronizer{Spec: tc.spec}
+
+			err := s.Validate(context.Background())
+			if !tc.expectError {
+				assert.Nil(t, err)
+				return
+			}
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tc.expectPath)
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func durationPtr(d time.Duration) *apis.Duration {
+	ad := apis.Duration(d)
+	return &ad
+}
@@ -17,11 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"knative.dev/pkg/apis"
+	pkgapis "knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -39,8 +41,8 @@ type Transformation struct {
 }
 
 var (
-	_ apis.Validatable = (*Transformation)(nil)
-	_ apis.Defaultable = (*Transformation)(nil)
+	_ pkgapis.Validatable = (*Transformation)(nil)
+	_ pkgapis.Defaultable = (*Transformation)(nil)
 
 	_ v1alpha1.Reconcilable        = (*Transformation)(nil)
 	_ v1alpha1.AdapterConfigurable = (*Transformation)(nil)
@@ -54,6 +56,14 @@ type TransformationSpec struct {
 	// Data contains Transformations that must be applied on CE Data
 	Data []Transform `json:"data,omitempty"`
 
+	// Storage configures the storage of the Pipeline variables.
+	// +optional
+	Storage *TransformationStorage `json:"storage,omitempty"`
+
+	// LookupTables are the tables used by the lookup operation to enrich events.
+	// +optional
+	LookupTables []LookupTable `json:"lookupTables,omitempty"`
+
 	// Support sending to an event sink instead of replying.
 	duckv1.SourceSpec `json:",inline"`
 
@@ -66,6 +76,34 @@ type TransformationSpec struct {
 type Transform struct {
 	Operation string `json:"operation"`
 	Paths     []Path `json:"paths"`
+
+	// Condition is an optional CEL expression evaluated against the incoming
+	// event. The operation is applied only if the expression returns true.
+	// +optional
+	Condition string `json:"condition,omitempty"`
+}
+
+// TransformationStorage defines where and how long the Pipeline variables are kept.
+type TransformationStorage struct {
+	// Maximum number of events which variables are kept in the adapter memory.
+	// Variables of the events beyond the maximum are not stored.
+	// +optional
+	MaxEvents *int `json:"maxEvents,omitempty"`
+	// Lifetime of the stored variables.
+	// Expressed as a duration string, which format is documented at https://pkg.go.dev/time#ParseDuration.
+	// +optional
+	TTL *apis.Duration `json:"ttl,omitempty"`
+	// Redis compatible server that stores the variables instead of the adapter memory.
+	// +optional
+	Redis *RedisConnection `json:"redis,omitempty"`
+}
+
+// LookupTable is a named table which entries are the keys of a ConfigMap.
+type LookupTable struct {
+	// Name of the table referenced by the lookup operation.
+	Name string `json:"name"`
+	// ConfigMap that contains the table entries.
+	ConfigMap corev1.LocalObjectReference `json:"configMap"`
 }
 
 // Path is a key-value pair that represents JSON object path
@@ -18,8 +18,14 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
 )
 
 // Validate implements apis.Validatable
@@ -29,5 +35,63 @@ func (t *Transformation) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (ts *TransformationSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i, t := range ts.Context {
+		errs = errs.Also(t.Validate(ctx).ViaFieldIndex("context", i))
+	}
+	for i, t := range ts.Data {
+		errs = errs.Also(t.Validate(ctx).ViaFieldIndex("data", i))
+	}
+	errs = errs.Also(ts.Storage.Validate(ctx).ViaField("storage"))
+
+	tables := make(map[string]struct{}, len(ts.LookupTables))
+	for i, t := range ts.LookupTables {
+		if _, exists := tables[t.Name]; exists {
+			errs = errs.Also(apis.ErrGeneric("duplicate lookup table name", "name").ViaFieldIndex("lookupTables", i))
+		}
+		tables[t.Name] = struct{}{}
+		errs = errs.Also(t.Validate(ctx).ViaFieldIndex("lookupTables", i))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (t *Transform) Validate(ctx context.Context) *apis.FieldError {
+	if t.Condition == "" {
+		return nil
+	}
+	if _, err := cel.CompileExpression(t.Condition); err != nil {
+		return apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "condition")
+	}
 	return nil
 }
+
+// Validate implements apis.Validatable
+func (s *TransformationStorage) Validate(ctx context.Context) *apis.FieldError {
+	if s == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+	if s.MaxEvents != nil && *s.MaxEvents < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*s.MaxEvents, "maxEvents"))
+	}
+	if s.TTL != nil && *s.TTL < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(s.TTL.String(), "ttl"))
+	}
+	return errs.Also(s.Redis.Validate(ctx).ViaField("redis"))
+}
+
+// Validate implements apis.Validatable
+func (t *LookupTable) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if msgs := validation.IsDNS1123Label(t.Name); len(msgs) != 0 {
+		errs = errs.Also(apis.ErrInvalidValue(strings.Join(msgs, ", "), "name"))
+	}
+	if t.ConfigMap.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("configMap.name"))
+	}
+	return errs
+}
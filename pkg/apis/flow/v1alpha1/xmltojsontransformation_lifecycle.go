@@ -74,5 +74,5 @@ func (t *XMLToJSONTransformation) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (t *XMLToJSONTransformation) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	return t.Spec.Conventions.Validate(ctx).ViaField("spec", "conventions")
 }
@@ -47,6 +47,11 @@ type XMLToJSONTransformationSpec struct {
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
+	// Conventions used to represent XML documents as JSON. Documents are
+	// converted as in previous versions of the component when not set.
+	// +optional
+	Conventions *XMLJSONConventions `json:"conventions,omitempty"`
+
 	// Support sending to an event sink instead of replying.
 	duckv1.SourceSpec `json:",inline"`
 
@@ -59,6 +59,11 @@ type XSLTTransformationSpec struct {
 	// +optional
 	AllowPerEventXSLT *bool `json:"allowPerEventXSLT,omitempty"`
 
+	// Stylesheet parameters bound at each event from CloudEvent context
+	// attributes or extensions.
+	// +optional
+	Parameters []XSLTParameter `json:"parameters,omitempty"`
+
 	// Support sending to an event sink instead of replying.
 	duckv1.SourceSpec `json:",inline"`
 
@@ -67,6 +72,18 @@ type XSLTTransformationSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// XSLTParameter binds a top-level xsl:param of the stylesheet to the value
+// of a CloudEvent context attribute or extension. Parameters whose attribute
+// is absent from the event keep the default value set by the stylesheet.
+type XSLTParameter struct {
+	// Name of the stylesheet parameter.
+	Name string `json:"name"`
+
+	// Name of the CloudEvent context attribute or extension the parameter
+	// value is read from.
+	ValueFromAttribute string `json:"valueFromAttribute"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // XSLTTransformationList is a list of component instances.
@@ -39,5 +39,19 @@ func (s *XSLTTransformationSpec) Validate(ctx context.Context) *apis.FieldError
 		errs = errs.Also(err.ViaField("XSLT"))
 	}
 
+	names := make(map[string]struct{}, len(s.Parameters))
+	for i, p := range s.Parameters {
+		if p.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("parameters", i))
+		} else if _, exists := names[p.Name]; exists {
+			errs = errs.Also(apis.ErrGeneric("duplicate parameter "+p.Name, "name").ViaFieldIndex("parameters", i))
+		}
+		names[p.Name] = struct{}{}
+
+		if p.ValueFromAttribute == "" {
+			errs = errs.Also(apis.ErrMissingField("valueFromAttribute").ViaFieldIndex("parameters", i))
+		}
+	}
+
 	return errs
 }
@@ -28,6 +28,8 @@ var (
 	errs                      = &apis.FieldError{}
 	errXSLTAndOrAllowOverride = errs.Also(apis.ErrGeneric("when XSLT is empty, per event XSLT must be allowed", "allowPerEventXSLT", "xslt").ViaField("spec"))
 	errXSLTTooMany            = errs.Also(apis.ErrMultipleOneOf("value", "valueFromSecret", "valueFromConfigMap").ViaField("XSLT").ViaField("spec"))
+	errXSLTParamIncomplete    = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("parameters", 0).ViaField("spec")).Also(apis.ErrMissingField("valueFromAttribute").ViaFieldIndex("parameters", 1).ViaField("spec"))
+	errXSLTParamDuplicate     = errs.Also(apis.ErrGeneric("duplicate parameter p", "name").ViaFieldIndex("parameters", 1).ViaField("spec"))
 )
 
 func TestXSLTTransformationValidate(t *testing.T) {
@@ -73,6 +75,31 @@ func TestXSLTTransformationValidate(t *testing.T) {
 				))),
 			expectError: errXSLTTooMany,
 		},
+
+		"parameters informed": {
+			xslt: xsltTransform(
+				xsltWithXSLT(valueFromField(vffWithValue(tValue))),
+				xsltWithParameter("p1", "subject"),
+				xsltWithParameter("p2", "myextension"),
+			),
+			expectError: nil,
+		},
+		"parameters incomplete": {
+			xslt: xsltTransform(
+				xsltWithXSLT(valueFromField(vffWithValue(tValue))),
+				xsltWithParameter("", "subject"),
+				xsltWithParameter("p", ""),
+			),
+			expectError: errXSLTParamIncomplete,
+		},
+		"parameters duplicate": {
+			xslt: xsltTransform(
+				xsltWithXSLT(valueFromField(vffWithValue(tValue))),
+				xsltWithParameter("p", "subject"),
+				xsltWithParameter("p", "type"),
+			),
+			expectError: errXSLTParamDuplicate,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -106,3 +133,12 @@ func xsltWithAllowEventXSLT(allowEventXSLT bool) xsltTransformOption {
 		xslt.Spec.AllowPerEventXSLT = &allowEventXSLT
 	}
 }
+
+func xsltWithParameter(name, attribute string) xsltTransformOption {
+	return func(xslt *XSLTTransformation) {
+		xslt.Spec.Parameters = append(xslt.Spec.Parameters, XSLTParameter{
+			Name:               name,
+			ValueFromAttribute: attribute,
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// Default values of the Aggregator spec.
+const (
+	// DefaultAggregatorCorrelationKey is the extension set by the Splitter
+	// on split events.
+	DefaultAggregatorCorrelationKey = "parentid"
+	// DefaultAggregatorTimeout bounds the lifetime of incomplete groups.
+	DefaultAggregatorTimeout = time.Minute
+)
+
+// SetDefaults implements apis.Defaultable
+func (a *Aggregator) SetDefaults(ctx context.Context) {
+	if a.Spec.CorrelationKey == "" {
+		a.Spec.CorrelationKey = DefaultAggregatorCorrelationKey
+	}
+	if a.Spec.Timeout == nil {
+		timeout := apis.Duration(DefaultAggregatorTimeout)
+		a.Spec.Timeout = &timeout
+	}
+	if a.Spec.Merge == "" {
+		a.Spec.Merge = AggregatorMergeArray
+	}
+}
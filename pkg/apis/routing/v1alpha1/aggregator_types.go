@@ -0,0 +1,143 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	pkgapis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Aggregator is an addressable object that collects the events sharing the
+// same correlation attribute and emits them as a single combined event.
+//
+// Incomplete groups are kept in the memory of the adapter, up to a maximum
+// number of groups, and are lost when the adapter restarts.
+type Aggregator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AggregatorSpec   `json:"spec,omitempty"`
+	Status AggregatorStatus `json:"status,omitempty"`
+}
+
+var (
+	_ pkgapis.Validatable = (*Aggregator)(nil)
+	_ pkgapis.Defaultable = (*Aggregator)(nil)
+
+	_ v1alpha1.Reconcilable        = (*Aggregator)(nil)
+	_ v1alpha1.AdapterConfigurable = (*Aggregator)(nil)
+	_ v1alpha1.EventSender         = (*Aggregator)(nil)
+	_ v1alpha1.EventSource         = (*Aggregator)(nil)
+	_ v1alpha1.MultiTenant         = (*Aggregator)(nil)
+)
+
+// AggregatorMergeStrategy defines how the payloads of the collected events
+// are combined.
+type AggregatorMergeStrategy string
+
+// Supported merge strategies.
+const (
+	// AggregatorMergeArray combines the payloads into a JSON array.
+	AggregatorMergeArray AggregatorMergeStrategy = "array"
+	// AggregatorMergeConcat concatenates the JSON arrays of the payloads,
+	// e.g. the chunks emitted by a Splitter.
+	AggregatorMergeConcat AggregatorMergeStrategy = "concat"
+)
+
+// AggregatorSpec defines the desired state of the component.
+type AggregatorSpec struct {
+	// CorrelationKey is the name of the CloudEvent context attribute or
+	// extension which value groups the events, "parentid" by default.
+	// +optional
+	CorrelationKey string `json:"correlationKey,omitempty"`
+
+	// Count is the number of events that completes a group. Groups of events
+	// carrying the "total" extension are completed when it is not set.
+	// +optional
+	Count *int `json:"count,omitempty"`
+	// Size is the total size of the events payloads, in bytes, that
+	// completes a group.
+	// +optional
+	Size *int `json:"size,omitempty"`
+	// Timeout is the maximum lifetime of a group, after which the collected
+	// events are emitted regardless of the other conditions.
+	// +optional
+	Timeout *apis.Duration `json:"timeout,omitempty"`
+	// Completion is a Common Language Expression, with the same syntax as the
+	// Filter expression, that completes a group when it matches an event.
+	// +optional
+	Completion string `json:"completion,omitempty"`
+
+	// Merge strategy of the events payloads, "array" by default.
+	// +optional
+	Merge AggregatorMergeStrategy `json:"merge,omitempty"`
+
+	// CEContext overrides the context attributes of the combined events.
+	// +optional
+	CEContext *AggregatedEventContext `json:"ceContext,omitempty"`
+
+	// Sink is a reference to an object that will resolve to a domain name to use as the sink.
+	Sink *duckv1.Destination `json:"sink"`
+
+	// Delivery defines how the combined events that could not be delivered
+	// to the sink are retried and dead-lettered.
+	// +optional
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AggregatorStatus defines the observed state of the component.
+type AggregatorStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// DeliveryStatus contains the resolved URI of the dead letter sink.
+	eventingduckv1.DeliveryStatus `json:",inline"`
+}
+
+// AggregatedEventContext declares the context attributes of combined events.
+// Unset attributes are inherited from the first collected event.
+type AggregatedEventContext struct {
+	// +optional
+	Type string `json:"type,omitempty"`
+	// +optional
+	Source string `json:"source,omitempty"`
+	// +optional
+	Extensions map[string]string `json:"extensions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AggregatorList is a list of component instances.
+type AggregatorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Aggregator `json:"items"`
+}
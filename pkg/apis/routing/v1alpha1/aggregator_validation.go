@@ -0,0 +1,69 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Validate implements apis.Validatable
+func (a *Aggregator) Validate(ctx context.Context) *apis.FieldError {
+	return a.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (as *AggregatorSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if as.Sink == nil || (as.Sink.Ref == nil && as.Sink.URI == nil) {
+		errs = errs.Also(apis.ErrMissingField("sink"))
+	}
+
+	if as.Count != nil && *as.Count < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*as.Count, "count"))
+	}
+	if as.Size != nil && *as.Size < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*as.Size, "size"))
+	}
+	if as.Timeout != nil && time.Duration(*as.Timeout) <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(as.Timeout, "timeout"))
+	}
+
+	if as.Completion != "" {
+		if _, err := cel.CompileExpression(as.Completion); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "completion"))
+		}
+	}
+
+	switch as.Merge {
+	case "", AggregatorMergeArray, AggregatorMergeConcat:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(as.Merge, "merge"))
+	}
+
+	if as.Delivery != nil {
+		errs = errs.Also(as.Delivery.Validate(ctx).ViaField("delivery"))
+	}
+
+	return errs
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAggregatorValidate(t *testing.T) {
+	sink := &duckv1.Destination{URI: apis.HTTP("sink")}
+	timeout := tmapis.Duration(time.Minute)
+	zero := tmapis.Duration(0)
+	count := 3
+	invalidLimit := 0
+	invalidBackoff := "1s"
+
+	testCases := map[string]struct {
+		spec        AggregatorSpec
+		expectError bool
+	}{
+		"events carrying the total extension": {
+			spec: AggregatorSpec{
+				Sink: sink,
+			},
+		},
+		"count, timeout and completion": {
+			spec: AggregatorSpec{
+				CorrelationKey: "orderid",
+				Count:          &count,
+				Timeout:        &timeout,
+				Completion:     `$last.(bool) == true`,
+				Merge:          AggregatorMergeConcat,
+				Sink:           sink,
+			},
+		},
+		"missing sink": {
+			spec:        AggregatorSpec{},
+			expectError: true,
+		},
+		"invalid count": {
+			spec: AggregatorSpec{
+				Count: &invalidLimit,
+				Sink:  sink,
+			},
+			expectError: true,
+		},
+		"invalid size": {
+			spec: AggregatorSpec{
+				Size: &invalidLimit,
+				Sink: sink,
+			},
+			expectError: true,
+		},
+		"invalid timeout": {
+			spec: AggregatorSpec{
+				Timeout: &zero,
+				Sink:    sink,
+			},
+			expectError: true,
+		},
+		"invalid completion": {
+			spec: AggregatorSpec{
+				Completion: `$last.(bool) ==`,
+				Sink:       sink,
+			},
+			expectError: true,
+		},
+		"unknown merge strategy": {
+			spec: AggregatorSpec{
+				Merge: "zip",
+				Sink:  sink,
+			},
+			expectError: true,
+		},
+		"invalid delivery": {
+			spec: AggregatorSpec{
+				Sink: sink,
+				Delivery: &eventingduckv1.DeliverySpec{
+					BackoffDelay: &invalidBackoff,
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			a := &Aggregator{Spec: tc.spec}
+
+			err := a.Validate(context.Background())
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
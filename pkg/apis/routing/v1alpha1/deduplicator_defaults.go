@@ -0,0 +1,36 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// DefaultDeduplicatorWindow is the period during which duplicate events
+// are dropped when the window is not set.
+const DefaultDeduplicatorWindow = 10 * time.Minute
+
+// SetDefaults implements apis.Defaultable
+func (d *Deduplicator) SetDefaults(ctx context.Context) {
+	if d.Spec.Window == nil {
+		window := apis.Duration(DefaultDeduplicatorWindow)
+		d.Spec.Window = &window
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Supported event types
+const (
+	DeduplicatorGenericEventType = "io.triggermesh.routing.deduplicator"
+)
+
+// GetGroupVersionKind implements kmeta.OwnerRefable
+func (*Deduplicator) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("Deduplicator")
+}
+
+// GetStatus implements duckv1.KRShaped.
+func (d *Deduplicator) GetStatus() *duckv1.Status {
+	return &d.Status.Status
+}
+
+// GetConditionSet implements duckv1.KRShaped.
+func (*Deduplicator) GetConditionSet() apis.ConditionSet {
+	return v1alpha1.DefaultConditionSet
+}
+
+// GetStatusManager implements Reconcilable.
+func (d *Deduplicator) GetStatusManager() *v1alpha1.StatusManager {
+	return &v1alpha1.StatusManager{
+		ConditionSet: d.GetConditionSet(),
+		Status:       &d.Status,
+	}
+}
+
+// GetEventTypes implements EventSource.
+func (*Deduplicator) GetEventTypes() []string {
+	return []string{
+		DeduplicatorGenericEventType,
+	}
+}
+
+// AsEventSource implements EventSource.
+func (d *Deduplicator) AsEventSource() string {
+	return "deduplicator/" + d.Name
+}
+
+// GetSink implements EventSender.
+func (d *Deduplicator) GetSink() *duckv1.Destination {
+	if d.Spec.Sink == nil {
+		return &duckv1.Destination{}
+	}
+	return d.Spec.Sink
+}
+
+// IsMultiTenant implements MultiTenant.
+func (*Deduplicator) IsMultiTenant() bool {
+	return true
+}
+
+// GetAdapterOverrides implements AdapterConfigurable.
+func (d *Deduplicator) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
+	return d.Spec.AdapterOverrides
+}
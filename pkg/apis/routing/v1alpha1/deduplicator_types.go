@@ -0,0 +1,83 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pkgapis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Deduplicator is an addressable object that drops the events which key
+// was already seen within a time window.
+type Deduplicator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeduplicatorSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status  `json:"status,omitempty"`
+}
+
+var (
+	_ pkgapis.Validatable = (*Deduplicator)(nil)
+	_ pkgapis.Defaultable = (*Deduplicator)(nil)
+
+	_ v1alpha1.Reconcilable        = (*Deduplicator)(nil)
+	_ v1alpha1.AdapterConfigurable = (*Deduplicator)(nil)
+	_ v1alpha1.EventSender         = (*Deduplicator)(nil)
+	_ v1alpha1.EventSource         = (*Deduplicator)(nil)
+	_ v1alpha1.MultiTenant         = (*Deduplicator)(nil)
+)
+
+// DeduplicatorSpec defines the desired state of the component.
+type DeduplicatorSpec struct {
+	// Key is a Common Language Expression, with the same syntax as the
+	// Filter expression, which value identifies duplicate events. Events
+	// are identified by their source and ID when it is not set.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Window is the period during which the events with an already seen
+	// key are dropped.
+	// +optional
+	Window *apis.Duration `json:"window,omitempty"`
+
+	// Sink is a reference to an object that will resolve to a domain name to use as the sink.
+	Sink *duckv1.Destination `json:"sink"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeduplicatorList is a list of component instances.
+type DeduplicatorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Deduplicator `json:"items"`
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Validate implements apis.Validatable
+func (d *Deduplicator) Validate(ctx context.Context) *apis.FieldError {
+	return d.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (ds *DeduplicatorSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if ds.Sink == nil || (ds.Sink.Ref == nil && ds.Sink.URI == nil) {
+		errs = errs.Also(apis.ErrMissingField("sink"))
+	}
+
+	if ds.Key != "" {
+		if _, err := cel.CompileValueExpression(ds.Key); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "key"))
+		}
+	}
+
+	if ds.Window != nil && time.Duration(*ds.Window) <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(ds.Window, "window"))
+	}
+
+	return errs
+}
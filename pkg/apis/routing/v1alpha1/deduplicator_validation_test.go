@@ -0,0 +1,92 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestDeduplicatorValidate(t *testing.T) {
+	sink := &duckv1.Destination{URI: apis.HTTP("sink")}
+	window := tmapis.Duration(time.Minute)
+	zero := tmapis.Duration(0)
+
+	testCases := map[string]struct {
+		spec        DeduplicatorSpec
+		expectError bool
+	}{
+		"source and ID of the events": {
+			spec: DeduplicatorSpec{
+				Sink: sink,
+			},
+		},
+		"key and window": {
+			spec: DeduplicatorSpec{
+				Key:    `$order.id.(string)`,
+				Window: &window,
+				Sink:   sink,
+			},
+		},
+		"invalid key": {
+			spec: DeduplicatorSpec{
+				Key:  `ce.source +`,
+				Sink: sink,
+			},
+			expectError: true,
+		},
+		"invalid window": {
+			spec: DeduplicatorSpec{
+				Window: &zero,
+				Sink:   sink,
+			},
+			expectError: true,
+		},
+		"missing sink": {
+			spec:        DeduplicatorSpec{},
+			expectError: true,
+		},
+		"empty sink": {
+			spec: DeduplicatorSpec{
+				Sink: &duckv1.Destination{},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			d := &Deduplicator{Spec: tc.spec}
+
+			err := d.Validate(context.Background())
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
@@ -35,8 +36,8 @@ type Filter struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   FilterSpec      `json:"spec,omitempty"`
-	Status v1alpha1.Status `json:"status,omitempty"`
+	Spec   FilterSpec   `json:"spec,omitempty"`
+	Status FilterStatus `json:"status,omitempty"`
 }
 
 var (
@@ -57,11 +58,24 @@ type FilterSpec struct {
 	// Sink is a reference to an object that will resolve to a domain name to use as the sink.
 	Sink *duckv1.Destination `json:"sink"`
 
+	// Delivery defines how the events that could not be delivered to the
+	// sink are retried and dead-lettered.
+	// +optional
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// FilterStatus defines the observed state of the component.
+type FilterStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// DeliveryStatus contains the resolved URI of the dead letter sink.
+	eventingduckv1.DeliveryStatus `json:",inline"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // FilterList is a list of component instances.
@@ -32,14 +32,22 @@ func (f *Filter) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (fs *FilterSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
 	if fs.Expression == "" {
-		return apis.ErrMissingField("Expression")
+		errs = errs.Also(apis.ErrMissingField("expression"))
+	} else if _, err := cel.CompileExpression(fs.Expression); err != nil {
+		// report the compiler message, which points at the invalid part of the expression
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "expression"))
 	}
-	if fs.Sink == nil {
-		return apis.ErrMissingField("Sink")
+
+	if fs.Sink == nil || (fs.Sink.Ref == nil && fs.Sink.URI == nil) {
+		errs = errs.Also(apis.ErrMissingField("sink"))
 	}
-	if _, err := cel.CompileExpression(fs.Expression); err != nil {
-		return apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "Expression")
+
+	if fs.Delivery != nil {
+		errs = errs.Also(fs.Delivery.Validate(ctx).ViaField("delivery"))
 	}
-	return nil
+
+	return errs
 }
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestFilterValidate(t *testing.T) {
+	sink := &duckv1.Destination{URI: apis.HTTP("sink")}
+
+	testCases := map[string]struct {
+		spec        FilterSpec
+		expectError string
+	}{
+		"valid expression": {
+			spec: FilterSpec{
+				Expression: `ce.type == "io.triggermesh.test" && $amount.(double) > 10.0`,
+				Sink:       sink,
+			},
+		},
+		"missing expression": {
+			spec:        FilterSpec{Sink: sink},
+			expectError: "missing field(s): spec.expression",
+		},
+		"expression does not compile": {
+			spec: FilterSpec{
+				Expression: `ce.type ==`,
+				Sink:       sink,
+			},
+			expectError: "invalid value: Cannot compile expression: ",
+		},
+		"expression with an invalid variable": {
+			spec: FilterSpec{
+				Expression: `$amount.(decimal) > 10`,
+				Sink:       sink,
+			},
+			expectError: "invalid value: Cannot compile expression: ",
+		},
+		"missing sink": {
+			spec:        FilterSpec{Expression: `true`},
+			expectError: "missing field(s): spec.sink",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			f := &Filter{Spec: tc.spec}
+
+			err := f.Validate(context.Background())
+			if tc.expectError != "" {
+				assert.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -47,8 +47,13 @@ var (
 
 // AllTypes is a list of all the types defined in this package.
 var AllTypes = []v1alpha1.GroupObject{
+	{Single: &Aggregator{}, List: &AggregatorList{}},
+	{Single: &Deduplicator{}, List: &DeduplicatorList{}},
 	{Single: &Filter{}, List: &FilterList{}},
+	{Single: &Router{}, List: &RouterList{}},
+	{Single: &Sampler{}, List: &SamplerList{}},
 	{Single: &Splitter{}, List: &SplitterList{}},
+	{Single: &Throttler{}, List: &ThrottlerList{}},
 }
 
 // Adds the list of known types to Scheme.
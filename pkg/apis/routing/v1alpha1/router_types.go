@@ -0,0 +1,114 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Router is an addressable object that routes incoming events to the sinks
+// of the routes which Common Language Expressions match the events.
+type Router struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouterSpec   `json:"spec,omitempty"`
+	Status RouterStatus `json:"status,omitempty"`
+}
+
+var (
+	_ apis.Validatable = (*Router)(nil)
+	_ apis.Defaultable = (*Router)(nil)
+
+	_ v1alpha1.Reconcilable        = (*Router)(nil)
+	_ v1alpha1.AdapterConfigurable = (*Router)(nil)
+	_ v1alpha1.EventSender         = (*Router)(nil)
+	_ v1alpha1.EventSource         = (*Router)(nil)
+	_ v1alpha1.MultiTenant         = (*Router)(nil)
+)
+
+// RouterMatchPolicy defines to how many routes an event is sent.
+type RouterMatchPolicy string
+
+// Supported match policies.
+const (
+	// RouterMatchFirst sends events to the first matching route.
+	RouterMatchFirst RouterMatchPolicy = "first"
+	// RouterMatchAll sends events to every matching route.
+	RouterMatchAll RouterMatchPolicy = "all"
+)
+
+// RouterSpec defines the desired state of the component.
+type RouterSpec struct {
+	// Routes are evaluated in the order of declaration.
+	Routes []Route `json:"routes"`
+
+	// Match policy of the routes, "first" by default.
+	// +optional
+	Match RouterMatchPolicy `json:"match,omitempty"`
+
+	// Sink is the default destination of the events that do not match any route.
+	// +optional
+	Sink *duckv1.Destination `json:"sink,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// Route is a destination of the events that match the condition.
+type Route struct {
+	// Condition is a Common Language Expression with the same syntax as the Filter expression.
+	Condition string `json:"condition"`
+
+	// Sink is a reference to an object that will resolve to a domain name to use as the sink.
+	Sink duckv1.Destination `json:"sink"`
+}
+
+// RouterStatus defines the observed state of the component.
+type RouterStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// Routes contains the resolved sinks of the routes in the order of the spec.
+	// +optional
+	Routes []RouteStatus `json:"routes,omitempty"`
+}
+
+// RouteStatus is the observed state of a route.
+type RouteStatus struct {
+	// SinkURI is the resolved URI of the route sink.
+	SinkURI *apis.URL `json:"sinkUri,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RouterList is a list of component instances.
+type RouterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Router `json:"items"`
+}
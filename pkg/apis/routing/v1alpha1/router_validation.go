@@ -0,0 +1,67 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Validate implements apis.Validatable
+func (r *Router) Validate(ctx context.Context) *apis.FieldError {
+	return r.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (rs *RouterSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if len(rs.Routes) == 0 {
+		errs = errs.Also(apis.ErrMissingField("routes"))
+	}
+	for i, r := range rs.Routes {
+		errs = errs.Also(r.Validate(ctx).ViaFieldIndex("routes", i))
+	}
+
+	switch rs.Match {
+	case "", RouterMatchFirst, RouterMatchAll:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(rs.Match, "match"))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (r *Route) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if r.Condition == "" {
+		errs = errs.Also(apis.ErrMissingField("condition"))
+	} else if _, err := cel.CompileExpression(r.Condition); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "condition"))
+	}
+	if r.Sink.Ref == nil && r.Sink.URI == nil {
+		errs = errs.Also(apis.ErrMissingOneOf("sink.ref", "sink.uri"))
+	}
+
+	return errs
+}
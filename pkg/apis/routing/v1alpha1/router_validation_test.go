@@ -0,0 +1,110 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestRouterValidate(t *testing.T) {
+	sink := duckv1.Destination{URI: apis.HTTP("sink")}
+
+	testCases := map[string]struct {
+		spec        RouterSpec
+		expectError bool
+	}{
+		"first matching route": {
+			spec: RouterSpec{
+				Routes: []Route{{
+					Condition: `ce.type == "io.triggermesh.test"`,
+					Sink:      sink,
+				}},
+			},
+		},
+		"all matching routes": {
+			spec: RouterSpec{
+				Match: RouterMatchAll,
+				Routes: []Route{{
+					Condition: `$amount.(double) > 100.0`,
+					Sink:      sink,
+				}, {
+					Condition: `true`,
+					Sink:      sink,
+				}},
+			},
+		},
+		"unknown match policy": {
+			spec: RouterSpec{
+				Match: "any",
+				Routes: []Route{{
+					Condition: `true`,
+					Sink:      sink,
+				}},
+			},
+			expectError: true,
+		},
+		"missing routes": {
+			spec:        RouterSpec{},
+			expectError: true,
+		},
+		"missing route condition": {
+			spec: RouterSpec{
+				Routes: []Route{{
+					Sink: sink,
+				}},
+			},
+			expectError: true,
+		},
+		"invalid route condition": {
+			spec: RouterSpec{
+				Routes: []Route{{
+					Condition: `ce.type ==`,
+					Sink:      sink,
+				}},
+			},
+			expectError: true,
+		},
+		"missing route sink": {
+			spec: RouterSpec{
+				Routes: []Route{{
+					Condition: `true`,
+				}},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			r := &Router{Spec: tc.spec}
+
+			err := r.Validate(context.Background())
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
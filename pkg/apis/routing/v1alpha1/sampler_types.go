@@ -0,0 +1,81 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Sampler is an addressable object that forwards a percentage of the
+// incoming events.
+type Sampler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SamplerSpec     `json:"spec,omitempty"`
+	Status v1alpha1.Status `json:"status,omitempty"`
+}
+
+var (
+	_ apis.Validatable = (*Sampler)(nil)
+	_ apis.Defaultable = (*Sampler)(nil)
+
+	_ v1alpha1.Reconcilable        = (*Sampler)(nil)
+	_ v1alpha1.AdapterConfigurable = (*Sampler)(nil)
+	_ v1alpha1.EventSender         = (*Sampler)(nil)
+	_ v1alpha1.EventSource         = (*Sampler)(nil)
+	_ v1alpha1.MultiTenant         = (*Sampler)(nil)
+)
+
+// SamplerSpec defines the desired state of the component.
+type SamplerSpec struct {
+	// Percentage of the events that are forwarded to the sink, from 0 to 100.
+	Percentage int `json:"percentage"`
+
+	// Key is a Common Language Expression, with the same syntax as the
+	// Filter expression, which value is hashed to sample the events.
+	// All the events sharing the same key are either forwarded or dropped.
+	// Events are sampled randomly when it is not set.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Sink is a reference to an object that will resolve to a domain name to use as the sink.
+	Sink *duckv1.Destination `json:"sink"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SamplerList is a list of component instances.
+type SamplerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Sampler `json:"items"`
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestSamplerValidate(t *testing.T) {
+	sink := &duckv1.Destination{URI: apis.HTTP("sink")}
+
+	testCases := map[string]struct {
+		spec        SamplerSpec
+		expectError bool
+	}{
+		"random sampling": {
+			spec: SamplerSpec{
+				Percentage: 10,
+				Sink:       sink,
+			},
+		},
+		"sampling by key": {
+			spec: SamplerSpec{
+				Percentage: 100,
+				Key:        `ce.subject`,
+				Sink:       sink,
+			},
+		},
+		"no events forwarded": {
+			spec: SamplerSpec{
+				Percentage: 0,
+				Sink:       sink,
+			},
+		},
+		"negative percentage": {
+			spec: SamplerSpec{
+				Percentage: -1,
+				Sink:       sink,
+			},
+			expectError: true,
+		},
+		"percentage above 100": {
+			spec: SamplerSpec{
+				Percentage: 101,
+				Sink:       sink,
+			},
+			expectError: true,
+		},
+		"invalid key": {
+			spec: SamplerSpec{
+				Percentage: 10,
+				Key:        `ce.subject +`,
+				Sink:       sink,
+			},
+			expectError: true,
+		},
+		"missing sink": {
+			spec: SamplerSpec{
+				Percentage: 10,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s := &Sampler{Spec: tc.spec}
+
+			err := s.Validate(context.Background())
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
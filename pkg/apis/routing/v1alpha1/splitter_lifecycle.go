@@ -42,14 +42,14 @@ func (*Splitter) GetConditionSet() apis.ConditionSet {
 
 // GetStatus implements duckv1.KRShaped.
 func (s *Splitter) GetStatus() *duckv1.Status {
-	return &s.Status.Status
+	return &s.Status.Status.Status
 }
 
 // GetStatusManager implements Reconcilable.
 func (s *Splitter) GetStatusManager() *v1alpha1.StatusManager {
 	return &v1alpha1.StatusManager{
 		ConditionSet: s.GetConditionSet(),
-		Status:       &s.Status,
+		Status:       &s.Status.Status,
 	}
 }
 
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
@@ -35,8 +36,8 @@ type Splitter struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   SplitterSpec    `json:"spec,omitempty"`
-	Status v1alpha1.Status `json:"status,omitempty"`
+	Spec   SplitterSpec   `json:"spec,omitempty"`
+	Status SplitterStatus `json:"status,omitempty"`
 }
 
 var (
@@ -52,9 +53,30 @@ var (
 
 // SplitterSpec defines the desired state of the component.
 type SplitterSpec struct {
-	Path      string              `json:"path"`
-	CEContext CloudEventContext   `json:"ceContext"`
-	Sink      *duckv1.Destination `json:"sink"`
+	// Path is a gjson path to the items to split. The whole data is split
+	// when neither Path, Query nor JSONPath is set.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Query is a jq query which results are the items to split.
+	// +optional
+	Query *string `json:"query,omitempty"`
+	// JSONPath is a JSONPath expression that selects the items to split.
+	// +optional
+	JSONPath *string `json:"jsonPath,omitempty"`
+
+	CEContext CloudEventContext `json:"ceContext"`
+
+	// ChunkSize groups the items in arrays of at most this size, each
+	// of which is emitted as a single event.
+	// +optional
+	ChunkSize *int `json:"chunkSize,omitempty"`
+
+	Sink *duckv1.Destination `json:"sink"`
+
+	// Delivery defines how the events that could not be delivered to the
+	// sink are retried and dead-lettered.
+	// +optional
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
 
 	// Adapter spec overrides parameters.
 	// +optional
@@ -62,12 +84,28 @@ type SplitterSpec struct {
 }
 
 // CloudEventContext declares context attributes that will be propagated to resulting events.
+// Type, Source, Subject and ID may contain JSONPath expressions in brackets
+// that are evaluated against the data of each produced event, e.g. "user/{.name}".
 type CloudEventContext struct {
-	Type       string            `json:"type"`
-	Source     string            `json:"source"`
+	Type   string `json:"type"`
+	Source string `json:"source"`
+	// Subject overrides the subject of the split event.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+	// ID overrides the default "<id>-<index>" ID of produced events.
+	// +optional
+	ID         string            `json:"id,omitempty"`
 	Extensions map[string]string `json:"extensions"`
 }
 
+// SplitterStatus defines the observed state of the component.
+type SplitterStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// DeliveryStatus contains the resolved URI of the dead letter sink.
+	eventingduckv1.DeliveryStatus `json:",inline"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SplitterList is a list of component instances.
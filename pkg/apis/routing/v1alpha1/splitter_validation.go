@@ -18,8 +18,11 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
 	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventsplit"
 )
 
 // Validate implements apis.Validatable
@@ -29,5 +32,40 @@ func (s *Splitter) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (ss *SplitterSpec) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	if _, err := eventsplit.NewSelector(ss.Path, stringValue(ss.Query), stringValue(ss.JSONPath)); err != nil {
+		errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("Invalid items selection: %v", err), "path", "query", "jsonPath"))
+	}
+
+	for _, attr := range []struct {
+		field string
+		tpl   string
+	}{
+		{"type", ss.CEContext.Type},
+		{"source", ss.CEContext.Source},
+		{"subject", ss.CEContext.Subject},
+		{"id", ss.CEContext.ID},
+	} {
+		if _, err := eventsplit.ParseTemplate(attr.field, attr.tpl); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), attr.field).ViaField("ceContext"))
+		}
+	}
+
+	if ss.ChunkSize != nil && *ss.ChunkSize < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*ss.ChunkSize, "chunkSize"))
+	}
+
+	if ss.Delivery != nil {
+		errs = errs.Also(ss.Delivery.Validate(ctx).ViaField("delivery"))
+	}
+
+	return errs
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
 }
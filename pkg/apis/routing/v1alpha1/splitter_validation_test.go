@@ -0,0 +1,80 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitterValidate(t *testing.T) {
+	query := ".items[]"
+	jsonPath := "$.items[*]"
+	zero := 0
+
+	testCases := map[string]struct {
+		spec        SplitterSpec
+		expectError bool
+	}{
+		"gjson path": {
+			spec: SplitterSpec{Path: "items"},
+		},
+		"jq query with templates": {
+			spec: SplitterSpec{
+				Query: &query,
+				CEContext: CloudEventContext{
+					Type:    "item.{.kind}",
+					Source:  "orders",
+					Subject: "items/{.id}",
+				},
+			},
+		},
+		"multiple selectors": {
+			spec: SplitterSpec{
+				Query:    &query,
+				JSONPath: &jsonPath,
+			},
+			expectError: true,
+		},
+		"invalid template": {
+			spec: SplitterSpec{
+				CEContext: CloudEventContext{ID: "item-{.id"},
+			},
+			expectError: true,
+		},
+		"invalid chunk size": {
+			spec:        SplitterSpec{ChunkSize: &zero},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s := &Splitter{Spec: tc.spec}
+
+			err := s.Validate(context.Background())
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
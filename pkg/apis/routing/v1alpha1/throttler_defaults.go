@@ -0,0 +1,38 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// DefaultThrottlerPeriod is the period of the rate when it is not set.
+const DefaultThrottlerPeriod = time.Second
+
+// SetDefaults implements apis.Defaultable
+func (t *Throttler) SetDefaults(ctx context.Context) {
+	if t.Spec.Period == nil {
+		period := apis.Duration(DefaultThrottlerPeriod)
+		t.Spec.Period = &period
+	}
+	if t.Spec.Excess == "" {
+		t.Spec.Excess = ThrottlerExcessDrop
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Supported event types
+const (
+	ThrottlerGenericEventType = "io.triggermesh.routing.throttler"
+)
+
+// GetGroupVersionKind implements kmeta.OwnerRefable
+func (*Throttler) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("Throttler")
+}
+
+// GetStatus implements duckv1.KRShaped.
+func (t *Throttler) GetStatus() *duckv1.Status {
+	return &t.Status.Status.Status
+}
+
+// GetConditionSet implements duckv1.KRShaped.
+func (*Throttler) GetConditionSet() apis.ConditionSet {
+	return v1alpha1.DefaultConditionSet
+}
+
+// GetStatusManager implements Reconcilable.
+func (t *Throttler) GetStatusManager() *v1alpha1.StatusManager {
+	return &v1alpha1.StatusManager{
+		ConditionSet: t.GetConditionSet(),
+		Status:       &t.Status.Status,
+	}
+}
+
+// GetEventTypes implements EventSource.
+func (*Throttler) GetEventTypes() []string {
+	return []string{
+		ThrottlerGenericEventType,
+	}
+}
+
+// AsEventSource implements EventSource.
+func (t *Throttler) AsEventSource() string {
+	return "throttler/" + t.Name
+}
+
+// GetSink implements EventSender.
+func (t *Throttler) GetSink() *duckv1.Destination {
+	return t.Spec.Sink
+}
+
+// IsMultiTenant implements MultiTenant.
+func (*Throttler) IsMultiTenant() bool {
+	return true
+}
+
+// GetAdapterOverrides implements AdapterConfigurable.
+func (t *Throttler) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
+	return t.Spec.AdapterOverrides
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	pkgapis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Throttler is an addressable object that limits the rate of the events
+// forwarded to the sink.
+type Throttler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ThrottlerSpec   `json:"spec,omitempty"`
+	Status ThrottlerStatus `json:"status,omitempty"`
+}
+
+var (
+	_ pkgapis.Validatable = (*Throttler)(nil)
+	_ pkgapis.Defaultable = (*Throttler)(nil)
+
+	_ v1alpha1.Reconcilable        = (*Throttler)(nil)
+	_ v1alpha1.AdapterConfigurable = (*Throttler)(nil)
+	_ v1alpha1.EventSender         = (*Throttler)(nil)
+	_ v1alpha1.EventSource         = (*Throttler)(nil)
+	_ v1alpha1.MultiTenant         = (*Throttler)(nil)
+)
+
+// ThrottlerExcessPolicy defines what happens to the events that exceed
+// the rate limit.
+type ThrottlerExcessPolicy string
+
+// Supported excess policies.
+const (
+	// ThrottlerExcessDrop drops the excess events.
+	ThrottlerExcessDrop ThrottlerExcessPolicy = "drop"
+	// ThrottlerExcessDeadLetter sends the excess events to the dead
+	// letter sink of the delivery spec.
+	ThrottlerExcessDeadLetter ThrottlerExcessPolicy = "deadLetter"
+)
+
+// ThrottlerSpec defines the desired state of the component.
+type ThrottlerSpec struct {
+	// Key is a Common Language Expression, with the same syntax as the
+	// Filter expression, which value selects the rate limit applied to
+	// an event. All the events share the same limit when it is not set.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Rate is the number of events per period forwarded for each key.
+	Rate int `json:"rate"`
+	// Period of the rate, one second by default.
+	// +optional
+	Period *apis.Duration `json:"period,omitempty"`
+
+	// Excess defines what happens to the events that exceed the rate
+	// limit, "drop" by default.
+	// +optional
+	Excess ThrottlerExcessPolicy `json:"excess,omitempty"`
+
+	// Sink is a reference to an object that will resolve to a domain name to use as the sink.
+	Sink *duckv1.Destination `json:"sink"`
+
+	// Delivery defines how the events that could not be delivered to the
+	// sink are retried and dead-lettered.
+	// +optional
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// ThrottlerStatus defines the observed state of the component.
+type ThrottlerStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// DeliveryStatus contains the resolved URI of the dead letter sink.
+	eventingduckv1.DeliveryStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ThrottlerList is a list of component instances.
+type ThrottlerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Throttler `json:"items"`
+}
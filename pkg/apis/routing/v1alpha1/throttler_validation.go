@@ -0,0 +1,70 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Validate implements apis.Validatable
+func (t *Throttler) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (ts *ThrottlerSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if ts.Sink == nil || (ts.Sink.Ref == nil && ts.Sink.URI == nil) {
+		errs = errs.Also(apis.ErrMissingField("sink"))
+	}
+
+	if ts.Key != "" {
+		if _, err := cel.CompileValueExpression(ts.Key); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "key"))
+		}
+	}
+
+	if ts.Rate < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(ts.Rate, "rate"))
+	}
+	if ts.Period != nil && time.Duration(*ts.Period) <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(ts.Period, "period"))
+	}
+
+	switch ts.Excess {
+	case "", ThrottlerExcessDrop:
+	case ThrottlerExcessDeadLetter:
+		if ts.Delivery == nil || ts.Delivery.DeadLetterSink == nil {
+			errs = errs.Also(apis.ErrMissingField("delivery.deadLetterSink"))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(ts.Excess, "excess"))
+	}
+
+	if ts.Delivery != nil {
+		errs = errs.Also(ts.Delivery.Validate(ctx).ViaField("delivery"))
+	}
+
+	return errs
+}
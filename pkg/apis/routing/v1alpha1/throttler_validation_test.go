@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestThrottlerValidate(t *testing.T) {
+	sink := &duckv1.Destination{URI: apis.HTTP("sink")}
+	zero := tmapis.Duration(0)
+
+	testCases := map[string]struct {
+		spec        ThrottlerSpec
+		expectError bool
+	}{
+		"rate per key": {
+			spec: ThrottlerSpec{
+				Key:  `ce.source`,
+				Rate: 10,
+				Sink: sink,
+			},
+		},
+		"missing rate": {
+			spec:        ThrottlerSpec{Sink: sink},
+			expectError: true,
+		},
+		"invalid period": {
+			spec: ThrottlerSpec{
+				Rate:   10,
+				Period: &zero,
+				Sink:   sink,
+			},
+			expectError: true,
+		},
+		"invalid key": {
+			spec: ThrottlerSpec{
+				Key:  `ce.source +`,
+				Rate: 10,
+				Sink: sink,
+			},
+			expectError: true,
+		},
+		"dead letter excess": {
+			spec: ThrottlerSpec{
+				Rate:   10,
+				Excess: ThrottlerExcessDeadLetter,
+				Sink:   sink,
+				Delivery: &eventingduckv1.DeliverySpec{
+					DeadLetterSink: &duckv1.Destination{URI: apis.HTTP("dls")},
+				},
+			},
+		},
+		"dead letter excess without dead letter sink": {
+			spec: ThrottlerSpec{
+				Rate:   10,
+				Excess: ThrottlerExcessDeadLetter,
+				Sink:   sink,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			thr := &Throttler{Spec: tc.spec}
+
+			err := thr.Validate(context.Background())
+			if tc.expectError {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
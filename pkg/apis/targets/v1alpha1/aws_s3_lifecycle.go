@@ -108,5 +108,11 @@ func (t *AWSS3Target) Validate(ctx context.Context) *apis.FieldError {
 	if t.DeletionTimestamp != nil {
 		return nil
 	}
-	return t.Spec.Auth.Validate(ctx)
+
+	errs := t.Spec.Auth.Validate(ctx)
+	errs = errs.Also(validateObjectName(t.Spec.ObjectKey, t.Spec.Batch, "spec.objectKey"))
+	if t.Spec.Batch != nil {
+		errs = errs.Also(t.Spec.Batch.Validate(ctx).ViaField("spec", "batch"))
+	}
+	return errs
 }
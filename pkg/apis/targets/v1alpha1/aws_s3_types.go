@@ -58,6 +58,21 @@ type AWSS3TargetSpec struct {
 	// When this property is true, only the CloudEvent data is included.
 	DiscardCEContext bool `json:"discardCloudEventContext"`
 
+	// ObjectKey is the template of the keys of the objects written to the
+	// bucket. It can reference CloudEvent context attributes and extensions
+	// between braces, e.g. "{type}/{id}", and the time of the event with a
+	// date pattern, e.g. "dt={yyyy-MM-dd}/hour={HH}/{id}". When batching,
+	// the rendered key is the prefix of the batched objects, and can not
+	// reference the ID of the events. Objects are keyed by the subject of
+	// the events when not set.
+	// +optional
+	ObjectKey *string `json:"objectKey,omitempty"`
+
+	// Batch groups events into newline-delimited JSON objects instead of
+	// writing an object per event.
+	// +optional
+	Batch *ObjectBatchOptions `json:"batch,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
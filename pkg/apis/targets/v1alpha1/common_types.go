@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
@@ -42,3 +43,43 @@ type EventOptions struct {
 	// +optional
 	PayloadPolicy *cloudevents.PayloadPolicy `json:"payloadPolicy,omitempty"`
 }
+
+// ObjectBatchOptions groups events into objects written to object storage.
+// An object is written when the first threshold is reached.
+type ObjectBatchOptions struct {
+	// Maximum number of events in an object.
+	// +optional
+	MaxEvents *int `json:"maxEvents,omitempty"`
+	// Maximum size in bytes of the uncompressed content of an object.
+	// +optional
+	MaxBytes *int `json:"maxBytes,omitempty"`
+	// Maximum time an event waits for its object to be written.
+	// +optional
+	MaxAge *apis.Duration `json:"maxAge,omitempty"`
+	// Format of the objects. Possible values are ndjson, for newline-delimited
+	// JSON, and avro, for Avro object container files.
+	// +optional
+	Format *ObjectBatchFormat `json:"format,omitempty"`
+	// Compression of the objects. Possible values are none, gzip and zstd.
+	// +optional
+	Compression *ObjectBatchCompression `json:"compression,omitempty"`
+}
+
+// ObjectBatchFormat is the format of batched objects.
+type ObjectBatchFormat string
+
+// Formats of batched objects.
+const (
+	ObjectBatchFormatNDJSON ObjectBatchFormat = "ndjson"
+	ObjectBatchFormatAvro   ObjectBatchFormat = "avro"
+)
+
+// ObjectBatchCompression is the compression of batched objects.
+type ObjectBatchCompression string
+
+// Compressions of batched objects.
+const (
+	ObjectBatchCompressionNone ObjectBatchCompression = "none"
+	ObjectBatchCompressionGzip ObjectBatchCompression = "gzip"
+	ObjectBatchCompressionZstd ObjectBatchCompression = "zstd"
+)
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apis "github.com/triggermesh/triggermesh/pkg/apis"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	cloudevents "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 	v1 "k8s.io/api/core/v1"
@@ -513,6 +514,16 @@ func (in *AWSS3TargetList) DeepCopyObject() runtime.Object {
 func (in *AWSS3TargetSpec) DeepCopyInto(out *AWSS3TargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.ObjectKey != nil {
+		in, out := &in.ObjectKey, &out.ObjectKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(ObjectBatchOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1334,6 +1345,37 @@ func (in *DatadogTargetSpec) DeepCopy() *DatadogTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchBulkOptions) DeepCopyInto(out *ElasticsearchBulkOptions) {
+	*out = *in
+	if in.MaxEvents != nil {
+		in, out := &in.MaxEvents, &out.MaxEvents
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchBulkOptions.
+func (in *ElasticsearchBulkOptions) DeepCopy() *ElasticsearchBulkOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchBulkOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchTarget) DeepCopyInto(out *ElasticsearchTarget) {
 	*out = *in
@@ -1399,6 +1441,16 @@ func (in *ElasticsearchTargetList) DeepCopyObject() runtime.Object {
 func (in *ElasticsearchTargetSpec) DeepCopyInto(out *ElasticsearchTargetSpec) {
 	*out = *in
 	in.Connection.DeepCopyInto(&out.Connection)
+	if in.DocumentID != nil {
+		in, out := &in.DocumentID, &out.DocumentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Bulk != nil {
+		in, out := &in.Bulk, &out.Bulk
+		*out = new(ElasticsearchBulkOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -1719,6 +1771,21 @@ func (in *GoogleCloudStorageTargetList) DeepCopyObject() runtime.Object {
 func (in *GoogleCloudStorageTargetSpec) DeepCopyInto(out *GoogleCloudStorageTargetSpec) {
 	*out = *in
 	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.ObjectName != nil {
+		in, out := &in.ObjectName, &out.ObjectName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(ObjectBatchOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -1930,9 +1997,52 @@ func (in *HTTPBasicAuth) DeepCopy() *HTTPBasicAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCircuitBreaker) DeepCopyInto(out *HTTPCircuitBreaker) {
+	*out = *in
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.OpenDuration != nil {
+		in, out := &in.OpenDuration, &out.OpenDuration
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCircuitBreaker.
+func (in *HTTPCircuitBreaker) DeepCopy() *HTTPCircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPEventResponse) DeepCopyInto(out *HTTPEventResponse) {
 	*out = *in
+	if in.SuccessCodes != nil {
+		in, out := &in.SuccessCodes, &out.SuccessCodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.DataQuery != nil {
+		in, out := &in.DataQuery, &out.DataQuery
+		*out = new(string)
+		**out = **in
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1946,6 +2056,82 @@ func (in *HTTPEventResponse) DeepCopy() *HTTPEventResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestTemplate) DeepCopyInto(out *HTTPRequestTemplate) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.BodyQuery != nil {
+		in, out := &in.BodyQuery, &out.BodyQuery
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestTemplate.
+func (in *HTTPRequestTemplate) DeepCopy() *HTTPRequestTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetryPolicy) DeepCopyInto(out *HTTPRetryPolicy) {
+	*out = *in
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinBackoff != nil {
+		in, out := &in.MinBackoff, &out.MinBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetryPolicy.
+func (in *HTTPRetryPolicy) DeepCopy() *HTTPRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPTarget) DeepCopyInto(out *HTTPTarget) {
 	*out = *in
@@ -2010,7 +2196,7 @@ func (in *HTTPTargetList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPTargetSpec) DeepCopyInto(out *HTTPTargetSpec) {
 	*out = *in
-	out.Response = in.Response
+	in.Response.DeepCopyInto(&out.Response)
 	in.Endpoint.DeepCopyInto(&out.Endpoint)
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
@@ -2019,6 +2205,21 @@ func (in *HTTPTargetSpec) DeepCopyInto(out *HTTPTargetSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(HTTPRequestTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(HTTPRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(HTTPCircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SkipVerify != nil {
 		in, out := &in.SkipVerify, &out.SkipVerify
 		*out = new(bool)
@@ -2849,6 +3050,47 @@ func (in *MongoDBTargetSpec) DeepCopy() *MongoDBTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectBatchOptions) DeepCopyInto(out *ObjectBatchOptions) {
+	*out = *in
+	if in.MaxEvents != nil {
+		in, out := &in.MaxEvents, &out.MaxEvents
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(ObjectBatchFormat)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(ObjectBatchCompression)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectBatchOptions.
+func (in *ObjectBatchOptions) DeepCopy() *ObjectBatchOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectBatchOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OracleFunctionSpecSpec) DeepCopyInto(out *OracleFunctionSpecSpec) {
 	*out = *in
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -50,9 +51,22 @@ type ElasticsearchTargetSpec struct {
 	// +optional
 	Connection Connection `json:"connection"`
 
-	// IndexName to write to.
+	// IndexName to write to. The name can reference CloudEvent context
+	// attributes and extensions between braces, e.g. "logs-{type}", and the
+	// time of the event with a date pattern, e.g. "logs-{yyyy.MM.dd}".
 	IndexName string `json:"indexName"`
 
+	// DocumentID of the indexed documents. Uses the same template syntax as
+	// the index name, e.g. "{id}" makes the indexing of replayed events
+	// idempotent. Elasticsearch generates the IDs when not set.
+	// +optional
+	DocumentID *string `json:"documentID,omitempty"`
+
+	// Bulk indexing options. Events are buffered and indexed through the
+	// _bulk API when set, otherwise each event is indexed individually.
+	// +optional
+	Bulk *ElasticsearchBulkOptions `json:"bulk,omitempty"`
+
 	// Whether to omit CloudEvent context attributes in documents created in Elasticsearch.
 	// When this property is false (default), the entire CloudEvent payload is included.
 	// When this property is true, only the CloudEvent data is included.
@@ -66,6 +80,20 @@ type ElasticsearchTargetSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// ElasticsearchBulkOptions defines when buffered events are flushed to
+// Elasticsearch. The first threshold reached triggers the flush.
+type ElasticsearchBulkOptions struct {
+	// Maximum number of buffered events.
+	// +optional
+	MaxEvents *int `json:"maxEvents,omitempty"`
+	// Maximum size in bytes of the buffered documents.
+	// +optional
+	MaxBytes *int `json:"maxBytes,omitempty"`
+	// Maximum time an event is buffered.
+	// +optional
+	FlushInterval *apis.Duration `json:"flushInterval,omitempty"`
+}
+
 // Connection contains connection and configuration parameters
 type Connection struct {
 	// Array of hostnames or IP addresses to connect the target to.
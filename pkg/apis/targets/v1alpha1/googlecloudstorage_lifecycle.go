@@ -33,6 +33,7 @@ const (
 	EventTypeGoogleCloudStorageObjectInsert = "com.google.cloud.storage.object.insert"
 
 	EventTypeGoogleCloudStorageResponse = "com.google.cloud.storage.object.insert.response"
+	EventTypeGoogleCloudStorageManifest = "com.google.cloud.storage.object.manifest"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
@@ -70,6 +71,7 @@ func (*GoogleCloudStorageTarget) AcceptedEventTypes() []string {
 func (*GoogleCloudStorageTarget) GetEventTypes() []string {
 	return []string{
 		EventTypeGoogleCloudStorageResponse,
+		EventTypeGoogleCloudStorageManifest,
 	}
 }
 
@@ -90,5 +92,9 @@ func (t *GoogleCloudStorageTarget) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (t *GoogleCloudStorageTarget) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	errs := validateObjectName(t.Spec.ObjectName, t.Spec.Batch, "spec.objectName")
+	if t.Spec.Batch != nil {
+		errs = errs.Also(t.Spec.Batch.Validate(ctx).ViaField("spec", "batch"))
+	}
+	return errs
 }
@@ -56,6 +56,27 @@ type GoogleCloudStorageTargetSpec struct {
 	// When this property is true, only the CloudEvent data is included.
 	DiscardCEContext bool `json:"discardCloudEventContext"`
 
+	// ObjectName is the template of the names of the objects written to the
+	// bucket. It can reference CloudEvent context attributes and extensions
+	// between braces, e.g. "{type}/{id}.json", and the time of the event
+	// with a date pattern, e.g. "dt={yyyy-MM-dd}/hour={HH}/{id}.json". When
+	// batching, the rendered name is the prefix of the batched objects,
+	// and can not reference the ID of the events. Objects are named after
+	// the ID of the events when not set.
+	// +optional
+	ObjectName *string `json:"objectName,omitempty"`
+
+	// ContentType of the objects written to the bucket. Defaults to the
+	// content type of the batch format when batching.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Batch groups events into objects instead of writing an object per
+	// event. The last event of each object is replied with a manifest of
+	// the object.
+	// +optional
+	Batch *ObjectBatchOptions `json:"batch,omitempty"`
+
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
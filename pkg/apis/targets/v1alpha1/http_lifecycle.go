@@ -63,11 +63,6 @@ func (t *HTTPTarget) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 func (t *HTTPTarget) SetDefaults(ctx context.Context) {
 }
 
-// Validate implements apis.Validatable
-func (t *HTTPTarget) Validate(ctx context.Context) *apis.FieldError {
-	return nil
-}
-
 // AcceptedEventTypes implements IntegrationTarget.
 func (*HTTPTarget) AcceptedEventTypes() []string {
 	return []string{
@@ -18,8 +18,9 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"knative.dev/pkg/apis"
+	pkgapis "knative.dev/pkg/apis"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -50,7 +51,7 @@ type HTTPTargetSpec struct {
 	Response HTTPEventResponse `json:"response"`
 
 	// Endpoint to connect to.
-	Endpoint apis.URL `json:"endpoint"`
+	Endpoint pkgapis.URL `json:"endpoint"`
 
 	// Method to use at requests.
 	Method string `json:"method"`
@@ -59,6 +60,20 @@ type HTTPTargetSpec struct {
 	// +optional
 	Headers map[string]string `json:"headers,omitempty"`
 
+	// Request builds HTTP requests from the incoming events.
+	// +optional
+	Request *HTTPRequestTemplate `json:"request,omitempty"`
+
+	// Retry policy of requests which fail with a network error, a 429 or
+	// a 5xx status. Requests are not retried when not set.
+	// +optional
+	Retry *HTTPRetryPolicy `json:"retry,omitempty"`
+
+	// CircuitBreaker stops sending requests to the endpoint after
+	// consecutive failures. Requests are always sent when not set.
+	// +optional
+	CircuitBreaker *HTTPCircuitBreaker `json:"circuitBreaker,omitempty"`
+
 	// SkipVerify disables server certificate validation.
 	// +optional
 	SkipVerify *bool `json:"skipVerify"`
@@ -103,6 +118,86 @@ type HTTPEventResponse struct {
 
 	// EventSource for the reply.
 	EventSource string `json:"eventSource"`
+
+	// SuccessCodes are the status codes of successful HTTP responses.
+	// Defaults to any status code below 400.
+	// +optional
+	SuccessCodes []int `json:"successCodes,omitempty"`
+
+	// DataQuery is a jq query which maps the JSON body of HTTP responses
+	// into the data of replies. The body is replied as is when not set.
+	// +optional
+	DataQuery *string `json:"dataQuery,omitempty"`
+
+	// Attributes of the replies, by name, as Go templates which are
+	// executed with the incoming event (.Event), the decoded body (.Data),
+	// the status code (.StatusCode) and the headers (.Header) of the HTTP
+	// response. Names other than type, source, subject and dataschema are
+	// set as extensions.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// HTTPRequestTemplate builds HTTP requests from the incoming events. The
+// values are Go templates which are executed with the incoming event
+// (.Event) and its decoded data (.Data). Events which data lacks a key
+// referenced by a template are rejected.
+type HTTPRequestTemplate struct {
+	// Path appended to the path of the endpoint.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Query parameters added to the query of the endpoint.
+	// +optional
+	Query map[string]string `json:"query,omitempty"`
+
+	// Headers of the request, which take precedence over the headers
+	// of the target.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body of the request. The data of the event is sent when neither
+	// the body nor the body query are set.
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// BodyQuery is a jq query executed on the data of the event, which
+	// result is sent as the JSON body of the request. jq queries are
+	// only supported for the body.
+	// +optional
+	BodyQuery *string `json:"bodyQuery,omitempty"`
+}
+
+// HTTPRetryPolicy defines how failed requests are retried. Delays between
+// attempts grow exponentially, unless the endpoint specifies one through
+// the Retry-After header.
+type HTTPRetryPolicy struct {
+	// Maximum number of retries of a request. Defaults to 3.
+	// +optional
+	Attempts *int `json:"attempts,omitempty"`
+	// Delay before the first retry, doubled at each subsequent retry.
+	// Delays are randomized between half and all of their value.
+	// Defaults to 500ms.
+	// +optional
+	MinBackoff *apis.Duration `json:"minBackoff,omitempty"`
+	// Maximum delay between retries. Requests for which the endpoint
+	// requires a longer delay are not retried. Defaults to 30s.
+	// +optional
+	MaxBackoff *apis.Duration `json:"maxBackoff,omitempty"`
+}
+
+// HTTPCircuitBreaker defines when requests stop being sent to the endpoint.
+// While the circuit is open, events are rejected without contacting the
+// endpoint.
+type HTTPCircuitBreaker struct {
+	// Number of consecutive failed requests which opens the circuit.
+	// Defaults to 5.
+	// +optional
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+	// Time the circuit stays open before a trial request is sent to the
+	// endpoint. Defaults to 30s.
+	// +optional
+	OpenDuration *apis.Duration `json:"openDuration,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
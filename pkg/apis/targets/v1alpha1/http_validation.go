@@ -0,0 +1,177 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"text/template"
+	"time"
+
+	"github.com/itchyny/gojq"
+
+	"knative.dev/pkg/apis"
+)
+
+// extensionName matches valid names of CloudEvents extension attributes.
+var extensionName = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// Attributes of replies which can not be templated.
+var reservedAttributes = map[string]struct{}{
+	"id":              {},
+	"specversion":     {},
+	"time":            {},
+	"datacontenttype": {},
+}
+
+// Validate implements apis.Validatable
+func (t *HTTPTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate HTTPTargetSpec.
+func (s *HTTPTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.Request != nil {
+		errs = errs.Also(s.Request.Validate(ctx).ViaField("request"))
+	}
+
+	if s.Retry != nil {
+		errs = errs.Also(s.Retry.Validate(ctx).ViaField("retry"))
+	}
+
+	if s.CircuitBreaker != nil {
+		errs = errs.Also(s.CircuitBreaker.Validate(ctx).ViaField("circuitBreaker"))
+	}
+
+	return errs.Also(s.Response.Validate(ctx).ViaField("response"))
+}
+
+// Validate HTTPRetryPolicy.
+func (p *HTTPRetryPolicy) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if p.Attempts != nil && *p.Attempts < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*p.Attempts, "attempts"))
+	}
+
+	if p.MinBackoff != nil && *p.MinBackoff <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(time.Duration(*p.MinBackoff).String(), "minBackoff"))
+	}
+
+	if p.MaxBackoff != nil && *p.MaxBackoff <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(time.Duration(*p.MaxBackoff).String(), "maxBackoff"))
+	}
+
+	if p.MinBackoff != nil && p.MaxBackoff != nil && *p.MinBackoff > *p.MaxBackoff {
+		errs = errs.Also(&apis.FieldError{
+			Message: "minBackoff must not be greater than maxBackoff",
+			Paths:   []string{"minBackoff", "maxBackoff"},
+		})
+	}
+
+	return errs
+}
+
+// Validate HTTPCircuitBreaker.
+func (b *HTTPCircuitBreaker) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if b.FailureThreshold != nil && *b.FailureThreshold < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*b.FailureThreshold, "failureThreshold"))
+	}
+
+	if b.OpenDuration != nil && *b.OpenDuration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(time.Duration(*b.OpenDuration).String(), "openDuration"))
+	}
+
+	return errs
+}
+
+// Validate HTTPRequestTemplate.
+func (r *HTTPRequestTemplate) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if r.Path != nil {
+		errs = errs.Also(validateTemplate(*r.Path).ViaField("path"))
+	}
+
+	for k, v := range r.Query {
+		errs = errs.Also(validateTemplate(v).ViaFieldKey("query", k))
+	}
+
+	for k, v := range r.Headers {
+		errs = errs.Also(validateTemplate(v).ViaFieldKey("headers", k))
+	}
+
+	if r.Body != nil && r.BodyQuery != nil {
+		errs = errs.Also(apis.ErrMultipleOneOf("body", "bodyQuery"))
+	}
+
+	if r.Body != nil {
+		errs = errs.Also(validateTemplate(*r.Body).ViaField("body"))
+	}
+
+	if r.BodyQuery != nil {
+		errs = errs.Also(validateQuery(*r.BodyQuery).ViaField("bodyQuery"))
+	}
+
+	return errs
+}
+
+// Validate HTTPEventResponse.
+func (r *HTTPEventResponse) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i, c := range r.SuccessCodes {
+		if c < http.StatusContinue || c > 599 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(c, http.StatusContinue, 599, apis.CurrentField).ViaFieldIndex("successCodes", i))
+		}
+	}
+
+	if r.DataQuery != nil {
+		errs = errs.Also(validateQuery(*r.DataQuery).ViaField("dataQuery"))
+	}
+
+	for k, v := range r.Attributes {
+		if _, reserved := reservedAttributes[k]; reserved || !extensionName.MatchString(k) {
+			errs = errs.Also(apis.ErrInvalidKeyName(k, "attributes"))
+			continue
+		}
+		errs = errs.Also(validateTemplate(v).ViaFieldKey("attributes", k))
+	}
+
+	return errs
+}
+
+// validateTemplate returns an error if the Go template can not be parsed.
+func validateTemplate(text string) *apis.FieldError {
+	if _, err := template.New("").Parse(text); err != nil {
+		return apis.ErrInvalidValue(err.Error(), apis.CurrentField)
+	}
+	return nil
+}
+
+// validateQuery returns an error if the jq query can not be parsed.
+func validateQuery(query string) *apis.FieldError {
+	if _, err := gojq.Parse(query); err != nil {
+		return apis.ErrInvalidValue(err.Error(), apis.CurrentField)
+	}
+	return nil
+}
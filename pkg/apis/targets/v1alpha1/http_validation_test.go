@@ -0,0 +1,161 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestHTTPTargetValidate(t *testing.T) {
+	str := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+	duration := func(d time.Duration) *apis.Duration { ad := apis.Duration(d); return &ad }
+
+	testCases := []struct {
+		name      string
+		spec      HTTPTargetSpec
+		expectErr string
+	}{{
+		name: "No templating",
+		spec: HTTPTargetSpec{},
+	}, {
+		name: "Valid request and response mappings",
+		spec: HTTPTargetSpec{
+			Request: &HTTPRequestTemplate{
+				Path:      str("/users/{{ .Data.id }}"),
+				Query:     map[string]string{"since": "{{ .Event.Time.Unix }}"},
+				Headers:   map[string]string{"X-Event-Id": "{{ .Event.ID }}"},
+				BodyQuery: str("{name: .name}"),
+			},
+			Response: HTTPEventResponse{
+				SuccessCodes: []int{200, 404},
+				DataQuery:    str(".items"),
+				Attributes:   map[string]string{"subject": "{{ .Data.id }}", "status": "{{ .StatusCode }}"},
+			},
+		},
+	}, {
+		name: "Body and body query",
+		spec: HTTPTargetSpec{
+			Request: &HTTPRequestTemplate{
+				Body:      str("{{ .Data }}"),
+				BodyQuery: str("."),
+			},
+		},
+		expectErr: "expected exactly one, got both: spec.request.body, spec.request.bodyQuery",
+	}, {
+		name: "Invalid template",
+		spec: HTTPTargetSpec{
+			Request: &HTTPRequestTemplate{
+				Headers: map[string]string{"X-Id": "{{ .Event.ID"},
+			},
+		},
+		expectErr: "spec.request.headers[X-Id]",
+	}, {
+		name: "Invalid query",
+		spec: HTTPTargetSpec{
+			Response: HTTPEventResponse{
+				DataQuery: str(".items["),
+			},
+		},
+		expectErr: "spec.response.dataQuery",
+	}, {
+		name: "Out of range success code",
+		spec: HTTPTargetSpec{
+			Response: HTTPEventResponse{
+				SuccessCodes: []int{200, 600},
+			},
+		},
+		expectErr: "expected 100 <= 600 <= 599: spec.response.successCodes[1]",
+	}, {
+		name: "Reserved attribute",
+		spec: HTTPTargetSpec{
+			Response: HTTPEventResponse{
+				Attributes: map[string]string{"id": "{{ .Data.id }}"},
+			},
+		},
+		expectErr: "spec.response.attributes",
+	}, {
+		name: "Invalid attribute name",
+		spec: HTTPTargetSpec{
+			Response: HTTPEventResponse{
+				Attributes: map[string]string{"Status-Code": "{{ .StatusCode }}"},
+			},
+		},
+		expectErr: "spec.response.attributes",
+	}, {
+		name: "Valid retry policy and circuit breaker",
+		spec: HTTPTargetSpec{
+			Retry: &HTTPRetryPolicy{
+				Attempts:   intPtr(5),
+				MinBackoff: duration(time.Second),
+				MaxBackoff: duration(time.Minute),
+			},
+			CircuitBreaker: &HTTPCircuitBreaker{
+				FailureThreshold: intPtr(3),
+				OpenDuration:     duration(time.Minute),
+			},
+		},
+	}, {
+		name: "Negative retry attempts",
+		spec: HTTPTargetSpec{
+			Retry: &HTTPRetryPolicy{
+				Attempts: intPtr(-1),
+			},
+		},
+		expectErr: "invalid value: -1: spec.retry.attempts",
+	}, {
+		name: "Min backoff greater than max backoff",
+		spec: HTTPTargetSpec{
+			Retry: &HTTPRetryPolicy{
+				MinBackoff: duration(time.Minute),
+				MaxBackoff: duration(time.Second),
+			},
+		},
+		expectErr: "minBackoff must not be greater than maxBackoff",
+	}, {
+		name: "Zero failure threshold",
+		spec: HTTPTargetSpec{
+			CircuitBreaker: &HTTPCircuitBreaker{
+				FailureThreshold: intPtr(0),
+			},
+		},
+		expectErr: "invalid value: 0: spec.circuitBreaker.failureThreshold",
+	}}
+
+	for _, tc := range testCases {
+		//nolint:scopelint
+		t.Run(tc.name, func(t *testing.T) {
+			trg := &HTTPTarget{Spec: tc.spec}
+
+			err := trg.Validate(context.Background())
+			if tc.expectErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tc.expectErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/nametemplate"
+)
+
+// perEventAttributes are the attributes which differ for every event, and
+// can therefore not be referenced by the names of batched objects.
+var perEventAttributes = map[string]struct{}{
+	"id": {},
+}
+
+// validateObjectName validates the template of the names of the objects
+// written to a bucket, which is located at the given field. When batching,
+// the rendered name is the prefix of a batch, so referencing an attribute
+// which differs for every event would write an object per event.
+func validateObjectName(tpl *string, batch *ObjectBatchOptions, field string) *apis.FieldError {
+	if tpl == nil {
+		return nil
+	}
+
+	t, err := nametemplate.Parse(*tpl)
+	if err != nil {
+		return apis.ErrInvalidValue(fmt.Sprintf("Cannot parse template: %v", err), field)
+	}
+
+	if batch == nil {
+		return nil
+	}
+
+	for _, attr := range t.Attributes() {
+		if _, isPerEvent := perEventAttributes[attr]; isPerEvent {
+			return apis.ErrInvalidValue(fmt.Sprintf("Template references the attribute %q, "+
+				"which would write every batched event to its own object", attr), field)
+		}
+	}
+
+	return nil
+}
+
+// Validate ObjectBatchOptions.
+func (b *ObjectBatchOptions) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if b.MaxEvents != nil && *b.MaxEvents < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*b.MaxEvents, "maxEvents"))
+	}
+
+	if b.MaxBytes != nil && *b.MaxBytes < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*b.MaxBytes, "maxBytes"))
+	}
+
+	if b.MaxAge != nil && *b.MaxAge <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(time.Duration(*b.MaxAge).String(), "maxAge"))
+	}
+
+	if b.Format != nil {
+		switch *b.Format {
+		case ObjectBatchFormatNDJSON, ObjectBatchFormatAvro:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*b.Format, "format"))
+		}
+	}
+
+	if b.Compression != nil {
+		switch *b.Compression {
+		case ObjectBatchCompressionNone, ObjectBatchCompressionGzip, ObjectBatchCompressionZstd:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*b.Compression, "compression"))
+		}
+	}
+
+	return errs
+}
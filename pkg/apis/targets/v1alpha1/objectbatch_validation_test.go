@@ -0,0 +1,87 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageTargetValidate(t *testing.T) {
+	str := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+	format := func(f ObjectBatchFormat) *ObjectBatchFormat { return &f }
+	compression := func(c ObjectBatchCompression) *ObjectBatchCompression { return &c }
+
+	testCases := []struct {
+		name      string
+		template  *string
+		batch     *ObjectBatchOptions
+		expectErr string
+	}{{
+		name: "Default names",
+	}, {
+		name:     "Templated names",
+		template: str("{type}/dt={yyyy-MM-dd}/{id}.json"),
+	}, {
+		name:     "Templated prefix of batches",
+		template: str("{type}/dt={yyyy-MM-dd}/"),
+		batch: &ObjectBatchOptions{
+			MaxEvents:   intPtr(100),
+			Format:      format(ObjectBatchFormatAvro),
+			Compression: compression(ObjectBatchCompressionZstd),
+		},
+	}, {
+		name:      "Invalid template",
+		template:  str("{type"),
+		expectErr: "Cannot parse template",
+	}, {
+		name:      "Per event attribute in prefix of batches",
+		template:  str("{type}/{id}/"),
+		batch:     &ObjectBatchOptions{},
+		expectErr: `references the attribute "id"`,
+	}, {
+		name: "Invalid batch options",
+		batch: &ObjectBatchOptions{
+			MaxEvents:   intPtr(0),
+			Format:      format("csv"),
+			Compression: compression("lz4"),
+		},
+		expectErr: "invalid value: 0: spec.batch.maxEvents",
+	}}
+
+	for _, tc := range testCases {
+		//nolint:scopelint
+		t.Run(tc.name, func(t *testing.T) {
+			s3 := &AWSS3Target{Spec: AWSS3TargetSpec{ObjectKey: tc.template, Batch: tc.batch}}
+			gcs := &GoogleCloudStorageTarget{Spec: GoogleCloudStorageTargetSpec{ObjectName: tc.template, Batch: tc.batch}}
+
+			for _, err := range []error{s3.Validate(context.Background()), gcs.Validate(context.Background())} {
+				if tc.expectErr == "" {
+					assert.Nil(t, err)
+					continue
+				}
+
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tc.expectErr)
+				}
+			}
+		})
+	}
+}
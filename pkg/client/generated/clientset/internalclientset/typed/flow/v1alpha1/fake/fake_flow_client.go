@@ -32,6 +32,14 @@ func (c *FakeFlowV1alpha1) JQTransformations(namespace string) v1alpha1.JQTransf
 	return &FakeJQTransformations{c, namespace}
 }
 
+func (c *FakeFlowV1alpha1) JSONToXMLTransformations(namespace string) v1alpha1.JSONToXMLTransformationInterface {
+	return &FakeJSONToXMLTransformations{c, namespace}
+}
+
+func (c *FakeFlowV1alpha1) SchemaValidations(namespace string) v1alpha1.SchemaValidationInterface {
+	return &FakeSchemaValidations{c, namespace}
+}
+
 func (c *FakeFlowV1alpha1) Synchronizers(namespace string) v1alpha1.SynchronizerInterface {
 	return &FakeSynchronizers{c, namespace}
 }
@@ -29,6 +29,8 @@ import (
 type FlowV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	JQTransformationsGetter
+	JSONToXMLTransformationsGetter
+	SchemaValidationsGetter
 	SynchronizersGetter
 	TransformationsGetter
 	XMLToJSONTransformationsGetter
@@ -44,6 +46,14 @@ func (c *FlowV1alpha1Client) JQTransformations(namespace string) JQTransformatio
 	return newJQTransformations(c, namespace)
 }
 
+func (c *FlowV1alpha1Client) JSONToXMLTransformations(namespace string) JSONToXMLTransformationInterface {
+	return newJSONToXMLTransformations(c, namespace)
+}
+
+func (c *FlowV1alpha1Client) SchemaValidations(namespace string) SchemaValidationInterface {
+	return newSchemaValidations(c, namespace)
+}
+
 func (c *FlowV1alpha1Client) Synchronizers(namespace string) SynchronizerInterface {
 	return newSynchronizers(c, namespace)
 }
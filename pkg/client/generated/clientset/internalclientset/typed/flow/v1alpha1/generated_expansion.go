@@ -20,6 +20,10 @@ package v1alpha1
 
 type JQTransformationExpansion interface{}
 
+type JSONToXMLTransformationExpansion interface{}
+
+type SchemaValidationExpansion interface{}
+
 type SynchronizerExpansion interface{}
 
 type TransformationExpansion interface{}
@@ -28,14 +28,34 @@ type FakeRoutingV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeRoutingV1alpha1) Aggregators(namespace string) v1alpha1.AggregatorInterface {
+	return &FakeAggregators{c, namespace}
+}
+
+func (c *FakeRoutingV1alpha1) Deduplicators(namespace string) v1alpha1.DeduplicatorInterface {
+	return &FakeDeduplicators{c, namespace}
+}
+
 func (c *FakeRoutingV1alpha1) Filters(namespace string) v1alpha1.FilterInterface {
 	return &FakeFilters{c, namespace}
 }
 
+func (c *FakeRoutingV1alpha1) Routers(namespace string) v1alpha1.RouterInterface {
+	return &FakeRouters{c, namespace}
+}
+
+func (c *FakeRoutingV1alpha1) Samplers(namespace string) v1alpha1.SamplerInterface {
+	return &FakeSamplers{c, namespace}
+}
+
 func (c *FakeRoutingV1alpha1) Splitters(namespace string) v1alpha1.SplitterInterface {
 	return &FakeSplitters{c, namespace}
 }
 
+func (c *FakeRoutingV1alpha1) Throttlers(namespace string) v1alpha1.ThrottlerInterface {
+	return &FakeThrottlers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeRoutingV1alpha1) RESTClient() rest.Interface {
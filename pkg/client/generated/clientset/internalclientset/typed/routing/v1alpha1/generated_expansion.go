@@ -18,6 +18,16 @@ limitations under the License.
 
 package v1alpha1
 
+type AggregatorExpansion interface{}
+
+type DeduplicatorExpansion interface{}
+
 type FilterExpansion interface{}
 
+type RouterExpansion interface{}
+
+type SamplerExpansion interface{}
+
 type SplitterExpansion interface{}
+
+type ThrottlerExpansion interface{}
@@ -28,8 +28,13 @@ import (
 
 type RoutingV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	AggregatorsGetter
+	DeduplicatorsGetter
 	FiltersGetter
+	RoutersGetter
+	SamplersGetter
 	SplittersGetter
+	ThrottlersGetter
 }
 
 // RoutingV1alpha1Client is used to interact with features provided by the routing.triggermesh.io group.
@@ -37,14 +42,34 @@ type RoutingV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *RoutingV1alpha1Client) Aggregators(namespace string) AggregatorInterface {
+	return newAggregators(c, namespace)
+}
+
+func (c *RoutingV1alpha1Client) Deduplicators(namespace string) DeduplicatorInterface {
+	return newDeduplicators(c, namespace)
+}
+
 func (c *RoutingV1alpha1Client) Filters(namespace string) FilterInterface {
 	return newFilters(c, namespace)
 }
 
+func (c *RoutingV1alpha1Client) Routers(namespace string) RouterInterface {
+	return newRouters(c, namespace)
+}
+
+func (c *RoutingV1alpha1Client) Samplers(namespace string) SamplerInterface {
+	return newSamplers(c, namespace)
+}
+
 func (c *RoutingV1alpha1Client) Splitters(namespace string) SplitterInterface {
 	return newSplitters(c, namespace)
 }
 
+func (c *RoutingV1alpha1Client) Throttlers(namespace string) ThrottlerInterface {
+	return newThrottlers(c, namespace)
+}
+
 // NewForConfig creates a new RoutingV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -26,6 +26,10 @@ import (
 type Interface interface {
 	// JQTransformations returns a JQTransformationInformer.
 	JQTransformations() JQTransformationInformer
+	// JSONToXMLTransformations returns a JSONToXMLTransformationInformer.
+	JSONToXMLTransformations() JSONToXMLTransformationInformer
+	// SchemaValidations returns a SchemaValidationInformer.
+	SchemaValidations() SchemaValidationInformer
 	// Synchronizers returns a SynchronizerInformer.
 	Synchronizers() SynchronizerInformer
 	// Transformations returns a TransformationInformer.
@@ -52,6 +56,16 @@ func (v *version) JQTransformations() JQTransformationInformer {
 	return &jQTransformationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// JSONToXMLTransformations returns a JSONToXMLTransformationInformer.
+func (v *version) JSONToXMLTransformations() JSONToXMLTransformationInformer {
+	return &jSONToXMLTransformationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SchemaValidations returns a SchemaValidationInformer.
+func (v *version) SchemaValidations() SchemaValidationInformer {
+	return &schemaValidationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Synchronizers returns a SynchronizerInformer.
 func (v *version) Synchronizers() SynchronizerInformer {
 	return &synchronizerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
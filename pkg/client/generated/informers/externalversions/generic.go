@@ -63,6 +63,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=flow.triggermesh.io, Version=v1alpha1
 	case flowv1alpha1.SchemeGroupVersion.WithResource("jqtransformations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().JQTransformations().Informer()}, nil
+	case flowv1alpha1.SchemeGroupVersion.WithResource("jsontoxmltransformations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().JSONToXMLTransformations().Informer()}, nil
+	case flowv1alpha1.SchemeGroupVersion.WithResource("schemavalidations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().SchemaValidations().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("synchronizers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().Synchronizers().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("transformations"):
@@ -73,10 +77,20 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().XSLTTransformations().Informer()}, nil
 
 		// Group=routing.triggermesh.io, Version=v1alpha1
+	case routingv1alpha1.SchemeGroupVersion.WithResource("aggregators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Aggregators().Informer()}, nil
+	case routingv1alpha1.SchemeGroupVersion.WithResource("deduplicators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Deduplicators().Informer()}, nil
 	case routingv1alpha1.SchemeGroupVersion.WithResource("filters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Filters().Informer()}, nil
+	case routingv1alpha1.SchemeGroupVersion.WithResource("routers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Routers().Informer()}, nil
+	case routingv1alpha1.SchemeGroupVersion.WithResource("samplers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Samplers().Informer()}, nil
 	case routingv1alpha1.SchemeGroupVersion.WithResource("splitters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Splitters().Informer()}, nil
+	case routingv1alpha1.SchemeGroupVersion.WithResource("throttlers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Throttlers().Informer()}, nil
 
 		// Group=sources.triggermesh.io, Version=v1alpha1
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("awscloudwatchlogssources"):
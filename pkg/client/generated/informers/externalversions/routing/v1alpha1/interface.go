@@ -24,10 +24,20 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Aggregators returns a AggregatorInformer.
+	Aggregators() AggregatorInformer
+	// Deduplicators returns a DeduplicatorInformer.
+	Deduplicators() DeduplicatorInformer
 	// Filters returns a FilterInformer.
 	Filters() FilterInformer
+	// Routers returns a RouterInformer.
+	Routers() RouterInformer
+	// Samplers returns a SamplerInformer.
+	Samplers() SamplerInformer
 	// Splitters returns a SplitterInformer.
 	Splitters() SplitterInformer
+	// Throttlers returns a ThrottlerInformer.
+	Throttlers() ThrottlerInformer
 }
 
 type version struct {
@@ -41,12 +51,37 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Aggregators returns a AggregatorInformer.
+func (v *version) Aggregators() AggregatorInformer {
+	return &aggregatorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Deduplicators returns a DeduplicatorInformer.
+func (v *version) Deduplicators() DeduplicatorInformer {
+	return &deduplicatorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Filters returns a FilterInformer.
 func (v *version) Filters() FilterInformer {
 	return &filterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// Routers returns a RouterInformer.
+func (v *version) Routers() RouterInformer {
+	return &routerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Samplers returns a SamplerInformer.
+func (v *version) Samplers() SamplerInformer {
+	return &samplerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Splitters returns a SplitterInformer.
 func (v *version) Splitters() SplitterInformer {
 	return &splitterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// Throttlers returns a ThrottlerInformer.
+func (v *version) Throttlers() ThrottlerInformer {
+	return &throttlerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
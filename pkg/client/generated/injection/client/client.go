@@ -396,6 +396,268 @@ func (w *wrapFlowV1alpha1JQTransformationImpl) Watch(ctx context.Context, opts v
 	return nil, errors.New("NYI: Watch")
 }
 
+func (w *wrapFlowV1alpha1) JSONToXMLTransformations(namespace string) typedflowv1alpha1.JSONToXMLTransformationInterface {
+	return &wrapFlowV1alpha1JSONToXMLTransformationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "jsontoxmltransformations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1alpha1JSONToXMLTransformationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1alpha1.JSONToXMLTransformationInterface = (*wrapFlowV1alpha1JSONToXMLTransformationImpl)(nil)
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) Create(ctx context.Context, in *flowv1alpha1.JSONToXMLTransformation, opts v1.CreateOptions) (*flowv1alpha1.JSONToXMLTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "JSONToXMLTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.JSONToXMLTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.JSONToXMLTransformation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.JSONToXMLTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.JSONToXMLTransformationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.JSONToXMLTransformationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.JSONToXMLTransformation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.JSONToXMLTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) Update(ctx context.Context, in *flowv1alpha1.JSONToXMLTransformation, opts v1.UpdateOptions) (*flowv1alpha1.JSONToXMLTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "JSONToXMLTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.JSONToXMLTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.JSONToXMLTransformation, opts v1.UpdateOptions) (*flowv1alpha1.JSONToXMLTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "JSONToXMLTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.JSONToXMLTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1JSONToXMLTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1alpha1) SchemaValidations(namespace string) typedflowv1alpha1.SchemaValidationInterface {
+	return &wrapFlowV1alpha1SchemaValidationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "schemavalidations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1alpha1SchemaValidationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1alpha1.SchemaValidationInterface = (*wrapFlowV1alpha1SchemaValidationImpl)(nil)
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) Create(ctx context.Context, in *flowv1alpha1.SchemaValidation, opts v1.CreateOptions) (*flowv1alpha1.SchemaValidation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SchemaValidation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.SchemaValidation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.SchemaValidation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.SchemaValidation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.SchemaValidationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.SchemaValidationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.SchemaValidation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.SchemaValidation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) Update(ctx context.Context, in *flowv1alpha1.SchemaValidation, opts v1.UpdateOptions) (*flowv1alpha1.SchemaValidation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SchemaValidation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.SchemaValidation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.SchemaValidation, opts v1.UpdateOptions) (*flowv1alpha1.SchemaValidation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SchemaValidation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.SchemaValidation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SchemaValidationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
 func (w *wrapFlowV1alpha1) Synchronizers(namespace string) typedflowv1alpha1.SynchronizerInterface {
 	return &wrapFlowV1alpha1SynchronizerImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
@@ -935,31 +1197,31 @@ func (w *wrapRoutingV1alpha1) RESTClient() rest.Interface {
 	panic("RESTClient called on dynamic client!")
 }
 
-func (w *wrapRoutingV1alpha1) Filters(namespace string) typedroutingv1alpha1.FilterInterface {
-	return &wrapRoutingV1alpha1FilterImpl{
+func (w *wrapRoutingV1alpha1) Aggregators(namespace string) typedroutingv1alpha1.AggregatorInterface {
+	return &wrapRoutingV1alpha1AggregatorImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "routing.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "filters",
+			Resource: "aggregators",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapRoutingV1alpha1FilterImpl struct {
+type wrapRoutingV1alpha1AggregatorImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedroutingv1alpha1.FilterInterface = (*wrapRoutingV1alpha1FilterImpl)(nil)
+var _ typedroutingv1alpha1.AggregatorInterface = (*wrapRoutingV1alpha1AggregatorImpl)(nil)
 
-func (w *wrapRoutingV1alpha1FilterImpl) Create(ctx context.Context, in *routingv1alpha1.Filter, opts v1.CreateOptions) (*routingv1alpha1.Filter, error) {
+func (w *wrapRoutingV1alpha1AggregatorImpl) Create(ctx context.Context, in *routingv1alpha1.Aggregator, opts v1.CreateOptions) (*routingv1alpha1.Aggregator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "routing.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Filter",
+		Kind:    "Aggregator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -969,62 +1231,62 @@ func (w *wrapRoutingV1alpha1FilterImpl) Create(ctx context.Context, in *routingv
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &routingv1alpha1.Aggregator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapRoutingV1alpha1AggregatorImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapRoutingV1alpha1AggregatorImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Filter, error) {
+func (w *wrapRoutingV1alpha1AggregatorImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Aggregator, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &routingv1alpha1.Aggregator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.FilterList, error) {
+func (w *wrapRoutingV1alpha1AggregatorImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.AggregatorList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.FilterList{}
+	out := &routingv1alpha1.AggregatorList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Filter, err error) {
+func (w *wrapRoutingV1alpha1AggregatorImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Aggregator, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &routingv1alpha1.Aggregator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Update(ctx context.Context, in *routingv1alpha1.Filter, opts v1.UpdateOptions) (*routingv1alpha1.Filter, error) {
+func (w *wrapRoutingV1alpha1AggregatorImpl) Update(ctx context.Context, in *routingv1alpha1.Aggregator, opts v1.UpdateOptions) (*routingv1alpha1.Aggregator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "routing.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Filter",
+		Kind:    "Aggregator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1034,18 +1296,18 @@ func (w *wrapRoutingV1alpha1FilterImpl) Update(ctx context.Context, in *routingv
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &routingv1alpha1.Aggregator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Filter, opts v1.UpdateOptions) (*routingv1alpha1.Filter, error) {
+func (w *wrapRoutingV1alpha1AggregatorImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Aggregator, opts v1.UpdateOptions) (*routingv1alpha1.Aggregator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "routing.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Filter",
+		Kind:    "Aggregator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1055,42 +1317,42 @@ func (w *wrapRoutingV1alpha1FilterImpl) UpdateStatus(ctx context.Context, in *ro
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &routingv1alpha1.Aggregator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapRoutingV1alpha1AggregatorImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapRoutingV1alpha1) Splitters(namespace string) typedroutingv1alpha1.SplitterInterface {
-	return &wrapRoutingV1alpha1SplitterImpl{
+func (w *wrapRoutingV1alpha1) Deduplicators(namespace string) typedroutingv1alpha1.DeduplicatorInterface {
+	return &wrapRoutingV1alpha1DeduplicatorImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "routing.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "splitters",
+			Resource: "deduplicators",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapRoutingV1alpha1SplitterImpl struct {
+type wrapRoutingV1alpha1DeduplicatorImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedroutingv1alpha1.SplitterInterface = (*wrapRoutingV1alpha1SplitterImpl)(nil)
+var _ typedroutingv1alpha1.DeduplicatorInterface = (*wrapRoutingV1alpha1DeduplicatorImpl)(nil)
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Create(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.CreateOptions) (*routingv1alpha1.Splitter, error) {
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) Create(ctx context.Context, in *routingv1alpha1.Deduplicator, opts v1.CreateOptions) (*routingv1alpha1.Deduplicator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "routing.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Splitter",
+		Kind:    "Deduplicator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1100,58 +1362,582 @@ func (w *wrapRoutingV1alpha1SplitterImpl) Create(ctx context.Context, in *routin
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Splitter{}
+	out := &routingv1alpha1.Deduplicator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Splitter, error) {
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Deduplicator, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Splitter{}
+	out := &routingv1alpha1.Deduplicator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.SplitterList, error) {
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.DeduplicatorList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.SplitterList{}
+	out := &routingv1alpha1.DeduplicatorList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Splitter, err error) {
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Deduplicator, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Splitter{}
+	out := &routingv1alpha1.Deduplicator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Update(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.UpdateOptions) (*routingv1alpha1.Splitter, error) {
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) Update(ctx context.Context, in *routingv1alpha1.Deduplicator, opts v1.UpdateOptions) (*routingv1alpha1.Deduplicator, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Deduplicator",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Deduplicator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Deduplicator, opts v1.UpdateOptions) (*routingv1alpha1.Deduplicator, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Deduplicator",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Deduplicator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1DeduplicatorImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapRoutingV1alpha1) Filters(namespace string) typedroutingv1alpha1.FilterInterface {
+	return &wrapRoutingV1alpha1FilterImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "filters",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1alpha1FilterImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1alpha1.FilterInterface = (*wrapRoutingV1alpha1FilterImpl)(nil)
+
+func (w *wrapRoutingV1alpha1FilterImpl) Create(ctx context.Context, in *routingv1alpha1.Filter, opts v1.CreateOptions) (*routingv1alpha1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Filter, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.FilterList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.FilterList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Filter, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Update(ctx context.Context, in *routingv1alpha1.Filter, opts v1.UpdateOptions) (*routingv1alpha1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Filter, opts v1.UpdateOptions) (*routingv1alpha1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapRoutingV1alpha1) Routers(namespace string) typedroutingv1alpha1.RouterInterface {
+	return &wrapRoutingV1alpha1RouterImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "routers",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1alpha1RouterImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1alpha1.RouterInterface = (*wrapRoutingV1alpha1RouterImpl)(nil)
+
+func (w *wrapRoutingV1alpha1RouterImpl) Create(ctx context.Context, in *routingv1alpha1.Router, opts v1.CreateOptions) (*routingv1alpha1.Router, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Router",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Router{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1RouterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1alpha1RouterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1alpha1RouterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Router, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Router{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1RouterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.RouterList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.RouterList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1RouterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Router, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Router{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1RouterImpl) Update(ctx context.Context, in *routingv1alpha1.Router, opts v1.UpdateOptions) (*routingv1alpha1.Router, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Router",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Router{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1RouterImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Router, opts v1.UpdateOptions) (*routingv1alpha1.Router, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Router",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Router{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1RouterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapRoutingV1alpha1) Samplers(namespace string) typedroutingv1alpha1.SamplerInterface {
+	return &wrapRoutingV1alpha1SamplerImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "samplers",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1alpha1SamplerImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1alpha1.SamplerInterface = (*wrapRoutingV1alpha1SamplerImpl)(nil)
+
+func (w *wrapRoutingV1alpha1SamplerImpl) Create(ctx context.Context, in *routingv1alpha1.Sampler, opts v1.CreateOptions) (*routingv1alpha1.Sampler, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Sampler",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Sampler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SamplerImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1alpha1SamplerImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1alpha1SamplerImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Sampler, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Sampler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SamplerImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.SamplerList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.SamplerList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SamplerImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Sampler, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Sampler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SamplerImpl) Update(ctx context.Context, in *routingv1alpha1.Sampler, opts v1.UpdateOptions) (*routingv1alpha1.Sampler, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Sampler",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Sampler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SamplerImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Sampler, opts v1.UpdateOptions) (*routingv1alpha1.Sampler, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Sampler",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Sampler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SamplerImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapRoutingV1alpha1) Splitters(namespace string) typedroutingv1alpha1.SplitterInterface {
+	return &wrapRoutingV1alpha1SplitterImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "splitters",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1alpha1SplitterImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1alpha1.SplitterInterface = (*wrapRoutingV1alpha1SplitterImpl)(nil)
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Create(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.CreateOptions) (*routingv1alpha1.Splitter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Splitter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Splitter, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.SplitterList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.SplitterList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Splitter, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Update(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.UpdateOptions) (*routingv1alpha1.Splitter, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "routing.triggermesh.io",
 		Version: "v1alpha1",
@@ -1197,6 +1983,137 @@ func (w *wrapRoutingV1alpha1SplitterImpl) Watch(ctx context.Context, opts v1.Lis
 	return nil, errors.New("NYI: Watch")
 }
 
+func (w *wrapRoutingV1alpha1) Throttlers(namespace string) typedroutingv1alpha1.ThrottlerInterface {
+	return &wrapRoutingV1alpha1ThrottlerImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "throttlers",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1alpha1ThrottlerImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1alpha1.ThrottlerInterface = (*wrapRoutingV1alpha1ThrottlerImpl)(nil)
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) Create(ctx context.Context, in *routingv1alpha1.Throttler, opts v1.CreateOptions) (*routingv1alpha1.Throttler, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Throttler",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Throttler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Throttler, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Throttler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.ThrottlerList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.ThrottlerList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Throttler, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Throttler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) Update(ctx context.Context, in *routingv1alpha1.Throttler, opts v1.UpdateOptions) (*routingv1alpha1.Throttler, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Throttler",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Throttler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Throttler, opts v1.UpdateOptions) (*routingv1alpha1.Throttler, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Throttler",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Throttler{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1ThrottlerImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
 // SourcesV1alpha1 retrieves the SourcesV1alpha1Client
 func (w *wrapClient) SourcesV1alpha1() typedsourcesv1alpha1.SourcesV1alpha1Interface {
 	return &wrapSourcesV1alpha1{
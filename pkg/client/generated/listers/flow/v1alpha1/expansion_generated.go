@@ -26,6 +26,22 @@ type JQTransformationListerExpansion interface{}
 // JQTransformationNamespaceLister.
 type JQTransformationNamespaceListerExpansion interface{}
 
+// JSONToXMLTransformationListerExpansion allows custom methods to be added to
+// JSONToXMLTransformationLister.
+type JSONToXMLTransformationListerExpansion interface{}
+
+// JSONToXMLTransformationNamespaceListerExpansion allows custom methods to be added to
+// JSONToXMLTransformationNamespaceLister.
+type JSONToXMLTransformationNamespaceListerExpansion interface{}
+
+// SchemaValidationListerExpansion allows custom methods to be added to
+// SchemaValidationLister.
+type SchemaValidationListerExpansion interface{}
+
+// SchemaValidationNamespaceListerExpansion allows custom methods to be added to
+// SchemaValidationNamespaceLister.
+type SchemaValidationNamespaceListerExpansion interface{}
+
 // SynchronizerListerExpansion allows custom methods to be added to
 // SynchronizerLister.
 type SynchronizerListerExpansion interface{}
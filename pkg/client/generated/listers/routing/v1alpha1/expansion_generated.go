@@ -18,6 +18,22 @@ limitations under the License.
 
 package v1alpha1
 
+// AggregatorListerExpansion allows custom methods to be added to
+// AggregatorLister.
+type AggregatorListerExpansion interface{}
+
+// AggregatorNamespaceListerExpansion allows custom methods to be added to
+// AggregatorNamespaceLister.
+type AggregatorNamespaceListerExpansion interface{}
+
+// DeduplicatorListerExpansion allows custom methods to be added to
+// DeduplicatorLister.
+type DeduplicatorListerExpansion interface{}
+
+// DeduplicatorNamespaceListerExpansion allows custom methods to be added to
+// DeduplicatorNamespaceLister.
+type DeduplicatorNamespaceListerExpansion interface{}
+
 // FilterListerExpansion allows custom methods to be added to
 // FilterLister.
 type FilterListerExpansion interface{}
@@ -26,6 +42,22 @@ type FilterListerExpansion interface{}
 // FilterNamespaceLister.
 type FilterNamespaceListerExpansion interface{}
 
+// RouterListerExpansion allows custom methods to be added to
+// RouterLister.
+type RouterListerExpansion interface{}
+
+// RouterNamespaceListerExpansion allows custom methods to be added to
+// RouterNamespaceLister.
+type RouterNamespaceListerExpansion interface{}
+
+// SamplerListerExpansion allows custom methods to be added to
+// SamplerLister.
+type SamplerListerExpansion interface{}
+
+// SamplerNamespaceListerExpansion allows custom methods to be added to
+// SamplerNamespaceLister.
+type SamplerNamespaceListerExpansion interface{}
+
 // SplitterListerExpansion allows custom methods to be added to
 // SplitterLister.
 type SplitterListerExpansion interface{}
@@ -33,3 +65,11 @@ type SplitterListerExpansion interface{}
 // SplitterNamespaceListerExpansion allows custom methods to be added to
 // SplitterNamespaceLister.
 type SplitterNamespaceListerExpansion interface{}
+
+// ThrottlerListerExpansion allows custom methods to be added to
+// ThrottlerLister.
+type ThrottlerListerExpansion interface{}
+
+// ThrottlerNamespaceListerExpansion allows custom methods to be added to
+// ThrottlerNamespaceLister.
+type ThrottlerNamespaceListerExpansion interface{}
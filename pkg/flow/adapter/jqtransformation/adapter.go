@@ -18,7 +18,7 @@ package jqtransformation
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 
 	"github.com/itchyny/gojq"
 
@@ -59,8 +59,18 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicf("Error creating query: %v", err)
 	}
 
-	return &jqadapter{
-		query: query,
+	out, err := newOutput(env.OutputSplit, env.OutputType, env.OutputSource, env.OutputSubject)
+	if err != nil {
+		logger.Panicf("Error creating output: %v", err)
+	}
+
+	if (env.OutputSplit || env.BatchWindow != 0) && env.Sink == "" {
+		logger.Panic("A sink is required to emit multiple events")
+	}
+
+	a := &jqadapter{
+		query:  query,
+		output: out,
 
 		sink:     env.Sink,
 		replier:  replier,
@@ -70,12 +80,20 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		mt: mt,
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.BatchWindow != 0 {
+		a.batch = newBatcher(env.BatchSize, env.BatchWindow, a.processBatch)
+	}
+
+	return a
 }
 
 var _ pkgadapter.Adapter = (*jqadapter)(nil)
 
 type jqadapter struct {
-	query *gojq.Query
+	query  *gojq.Query
+	output *output
+	batch  *batcher
 
 	sink     string
 	replier  *targetce.Replier
@@ -91,44 +109,75 @@ type jqadapter struct {
 func (a *jqadapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting JQTransformation Adapter")
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+	if a.batch != nil {
+		go a.batch.run(ctx)
+	}
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
 func (a *jqadapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	var data interface{}
-	var qd interface{}
 	if err := event.DataAs(&data); err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 	}
 
-	iter := a.query.Run(data)
-	for {
-		v, ok := iter.Next()
-		if !ok {
-			break
+	if a.batch != nil {
+		if err := a.batch.add(ctx, event, data); err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, "processing the batch of events")
 		}
-		if err, ok := v.(error); ok {
-			return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
-		}
-		qd = v
+		return nil, cloudevents.ResultACK
 	}
 
-	// Reserialize the query results for the response
-	bs, err := json.Marshal(&qd)
+	results, err := run(a.query, data)
 	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 	}
 
-	if err := event.SetData(cloudevents.ApplicationJSON, bs); err != nil {
+	events, err := a.output.events(event, results)
+	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
 	if a.sink != "" {
-		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
-			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+		if err := a.send(ctx, events); err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, "sending the cloudevent to the sink")
 		}
 		return nil, cloudevents.ResultACK
 	}
 
-	return &event, cloudevents.ResultACK
+	return &events[0], cloudevents.ResultACK
+}
+
+// processBatch applies the query to the array of buffered events data and
+// sends the results to the sink.
+func (a *jqadapter) processBatch(ctx context.Context, batch []batchItem) error {
+	data := make([]interface{}, 0, len(batch))
+	for _, item := range batch {
+		data = append(data, item.data)
+	}
+
+	results, err := run(a.query, data)
+	if err != nil {
+		return fmt.Errorf("running the query: %w", err)
+	}
+
+	events, err := a.output.events(batch[0].event, results)
+	if err != nil {
+		return err
+	}
+
+	return a.send(ctx, events)
+}
+
+// send sends the events to the sink in order and stops at the first failure,
+// which is reported for the whole incoming event or batch. The events sent
+// before the failure are sent again if the incoming events are redelivered,
+// the delivery to the sink is therefore at-least-once.
+func (a *jqadapter) send(ctx context.Context, events []cloudevents.Event) error {
+	for _, event := range events {
+		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
+			return result
+		}
+	}
+	return nil
 }
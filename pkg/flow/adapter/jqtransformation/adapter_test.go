@@ -146,7 +146,8 @@ func TestReplier(t *testing.T) {
 			mt := &adapter.MetricTag{}
 
 			a := &jqadapter{
-				query: query,
+				query:  query,
+				output: &output{},
 
 				replier:  replier,
 				ceClient: ceClient,
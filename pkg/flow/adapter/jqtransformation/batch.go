@@ -0,0 +1,144 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jqtransformation
+
+import (
+	"context"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// batcher buffers incoming events until either the batch size or the
+// window duration is reached and hands them over to the process function.
+type batcher struct {
+	size   int
+	window time.Duration
+
+	items   chan batchItem
+	process func(context.Context, []batchItem) error
+}
+
+// batchItem is a buffered event along with its decoded data.
+type batchItem struct {
+	event cloudevents.Event
+	data  interface{}
+
+	// done receives the result of the batch processing.
+	done chan error
+	// cancelled is closed when the sender stops waiting for the result.
+	cancelled <-chan struct{}
+}
+
+func newBatcher(size int, window time.Duration, process func(context.Context, []batchItem) error) *batcher {
+	return &batcher{
+		size:    size,
+		window:  window,
+		items:   make(chan batchItem),
+		process: process,
+	}
+}
+
+// add buffers the event and blocks until its batch is processed, so that
+// the processing errors are reported to the sender.
+//
+// The event is dropped from its batch if the context is done before the
+// batch is processed, so that it is not processed along with the redelivery
+// of the event. Once the batch is being processed, it is no longer dropped.
+func (b *batcher) add(ctx context.Context, event cloudevents.Event, data interface{}) error {
+	item := batchItem{
+		event:     event,
+		data:      data,
+		done:      make(chan error, 1),
+		cancelled: ctx.Done(),
+	}
+
+	select {
+	case b.items <- item:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-item.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run collects the events into batches until the context is cancelled.
+func (b *batcher) run(ctx context.Context) {
+	var batch []batchItem
+	var timer *time.Timer
+	var expired <-chan time.Time
+
+	flush := func(err error) {
+		if timer != nil {
+			timer.Stop()
+			timer, expired = nil, nil
+		}
+		batch = pending(batch)
+		if len(batch) == 0 {
+			return
+		}
+		if err == nil {
+			err = b.process(ctx, batch)
+		}
+		for _, item := range batch {
+			item.done <- err
+		}
+		batch = nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			flush(ctx.Err())
+			return
+
+		case item := <-b.items:
+			batch = append(batch, item)
+			if timer == nil {
+				timer = time.NewTimer(b.window)
+				expired = timer.C
+			}
+			// dropped events do not count towards the batch size
+			if b.size > 0 && len(batch) >= b.size {
+				if batch = pending(batch); len(batch) >= b.size {
+					flush(nil)
+				}
+			}
+
+		case <-expired:
+			flush(nil)
+		}
+	}
+}
+
+// pending returns the items of the batch which senders still wait for the result.
+func pending(batch []batchItem) []batchItem {
+	items := batch[:0]
+	for _, item := range batch {
+		select {
+		case <-item.cancelled:
+		default:
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jqtransformation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatcher(t *testing.T) {
+	testCases := map[string]struct {
+		size       int
+		window     time.Duration
+		events     int
+		processErr error
+
+		expectBatches []int
+	}{
+		"flush on size": {
+			size:          2,
+			window:        time.Minute,
+			events:        4,
+			expectBatches: []int{2, 2},
+		},
+		"flush on window": {
+			window:        50 * time.Millisecond,
+			events:        3,
+			expectBatches: []int{3},
+		},
+		"processing error": {
+			size:          3,
+			window:        time.Minute,
+			events:        3,
+			processErr:    errors.New("fake error"),
+			expectBatches: []int{3},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var batches []int
+
+			b := newBatcher(tc.size, tc.window, func(ctx context.Context, batch []batchItem) error {
+				mu.Lock()
+				defer mu.Unlock()
+				batches = append(batches, len(batch))
+				return tc.processErr
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			go b.run(ctx)
+
+			event := newCloudEvent(t, `{}`, "application/json")
+
+			var wg sync.WaitGroup
+			for i := 0; i < tc.events; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					err := b.add(ctx, event, i)
+					assert.Equal(t, tc.processErr, err)
+				}(i)
+			}
+			wg.Wait()
+
+			assert.Equal(t, tc.expectBatches, batches)
+		})
+	}
+}
+
+func TestBatcherCancelled(t *testing.T) {
+	processed := make(chan int, 1)
+
+	b := newBatcher(2, time.Minute, func(ctx context.Context, batch []batchItem) error {
+		processed <- len(batch)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go b.run(ctx)
+
+	event := newCloudEvent(t, `{}`, "application/json")
+
+	addCtx, addCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer addCancel()
+	assert.ErrorIs(t, b.add(addCtx, event, 0), context.DeadlineExceeded)
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 2; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, b.add(ctx, event, i))
+		}(i)
+	}
+	wg.Wait()
+
+	// the batch is flushed once the size is reached, the cancelled
+	// event was dropped from it
+	assert.Equal(t, 2, <-processed)
+}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package jqtransformation
 
-import pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+import (
+	"time"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+)
 
 // EnvAccessorCtor for configuration parameters
 func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
@@ -34,4 +38,17 @@ type envAccessor struct {
 	// Sink defines the target sink for the events. If no Sink is defined the
 	// events are replied back to the sender.
 	Sink string `envconfig:"K_SINK"`
+
+	// OutputSplit emits an event per query result instead of the last result.
+	OutputSplit bool `envconfig:"OUTPUT_SPLIT"`
+	// Go templates of the emitted events attributes.
+	OutputType    string `envconfig:"OUTPUT_TYPE"`
+	OutputSource  string `envconfig:"OUTPUT_SOURCE"`
+	OutputSubject string `envconfig:"OUTPUT_SUBJECT"`
+
+	// BatchWindow enables the batch mode, where the query is applied to the
+	// array of events data buffered during the window.
+	BatchWindow time.Duration `envconfig:"BATCH_WINDOW"`
+	// BatchSize is the maximum number of events in a batch.
+	BatchSize int `envconfig:"BATCH_SIZE"`
 }
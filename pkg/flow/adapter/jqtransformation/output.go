@@ -0,0 +1,160 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jqtransformation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/itchyny/gojq"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// output defines how the query results are turned into CloudEvents.
+type output struct {
+	// split emits an event per query result instead of the last result.
+	split bool
+
+	// optional templates of the emitted events attributes.
+	typ     *template.Template
+	source  *template.Template
+	subject *template.Template
+}
+
+// templateData is passed to the attribute templates.
+type templateData struct {
+	// Event is the incoming event, the first buffered event in the batch mode.
+	Event cloudevents.Event
+	// Data is the query result.
+	Data interface{}
+	// Index is the position of the query result.
+	Index int
+}
+
+// newOutput parses the attribute templates.
+func newOutput(split bool, typ, source, subject string) (*output, error) {
+	o := &output{split: split}
+
+	var err error
+	if o.typ, err = parseTemplate("type", typ); err != nil {
+		return nil, err
+	}
+	if o.source, err = parseTemplate("source", source); err != nil {
+		return nil, err
+	}
+	if o.subject, err = parseTemplate("subject", subject); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+	tpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s template: %w", name, err)
+	}
+	return tpl, nil
+}
+
+// run executes the query and returns all of its results.
+func run(query *gojq.Query, data interface{}) ([]interface{}, error) {
+	var results []interface{}
+
+	iter := query.Run(data)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			return nil, err
+		}
+		results = append(results, v)
+	}
+	return results, nil
+}
+
+// events returns the CloudEvents based on the incoming event that contain
+// either every query result or the last one.
+func (o *output) events(base cloudevents.Event, results []interface{}) ([]cloudevents.Event, error) {
+	if !o.split {
+		var last interface{}
+		if len(results) != 0 {
+			last = results[len(results)-1]
+		}
+		event, err := o.event(base, base.ID(), last, len(results)-1)
+		if err != nil {
+			return nil, err
+		}
+		return []cloudevents.Event{event}, nil
+	}
+
+	events := make([]cloudevents.Event, 0, len(results))
+	for i, r := range results {
+		event, err := o.event(base, fmt.Sprintf("%s-%d", base.ID(), i), r, i)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (o *output) event(base cloudevents.Event, id string, result interface{}, index int) (cloudevents.Event, error) {
+	event := base.Clone()
+	event.SetID(id)
+
+	// Reserialize the query results for the response
+	bs, err := json.Marshal(&result)
+	if err != nil {
+		return event, fmt.Errorf("cannot encode query result: %w", err)
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, bs); err != nil {
+		return event, fmt.Errorf("cannot set event data: %w", err)
+	}
+
+	data := templateData{
+		Event: base,
+		Data:  result,
+		Index: index,
+	}
+	for _, attr := range []struct {
+		tpl *template.Template
+		set func(string)
+	}{
+		{o.typ, event.SetType},
+		{o.source, event.SetSource},
+		{o.subject, event.SetSubject},
+	} {
+		if attr.tpl == nil {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := attr.tpl.Execute(&buf, data); err != nil {
+			return event, fmt.Errorf("cannot execute %s template: %w", attr.tpl.Name(), err)
+		}
+		attr.set(buf.String())
+	}
+
+	return event, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jqtransformation
+
+import (
+	"testing"
+
+	"github.com/itchyny/gojq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputEvents(t *testing.T) {
+	const data = `{"items":[{"id":"a"},{"id":"b"}]}`
+
+	testCases := map[string]struct {
+		split   bool
+		typ     string
+		subject string
+
+		expectIDs      []string
+		expectData     []string
+		expectTypes    []string
+		expectSubjects []string
+	}{
+		"last result": {
+			expectIDs:      []string{tCloudEventID},
+			expectData:     []string{`{"id":"b"}`},
+			expectTypes:    []string{tCloudEventType},
+			expectSubjects: []string{""},
+		},
+		"split results": {
+			split:          true,
+			expectIDs:      []string{tCloudEventID + "-0", tCloudEventID + "-1"},
+			expectData:     []string{`{"id":"a"}`, `{"id":"b"}`},
+			expectTypes:    []string{tCloudEventType, tCloudEventType},
+			expectSubjects: []string{"", ""},
+		},
+		"split with templates": {
+			split:          true,
+			typ:            "{{ .Event.Type }}.item",
+			subject:        "{{ .Data.id }}-{{ .Index }}",
+			expectIDs:      []string{tCloudEventID + "-0", tCloudEventID + "-1"},
+			expectData:     []string{`{"id":"a"}`, `{"id":"b"}`},
+			expectTypes:    []string{tCloudEventType + ".item", tCloudEventType + ".item"},
+			expectSubjects: []string{"a-0", "b-1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			o, err := newOutput(tc.split, tc.typ, "", tc.subject)
+			require.NoError(t, err)
+
+			query, err := gojq.Parse(".items[]")
+			require.NoError(t, err)
+
+			event := newCloudEvent(t, data, "application/json")
+
+			var in interface{}
+			require.NoError(t, event.DataAs(&in))
+
+			results, err := run(query, in)
+			require.NoError(t, err)
+
+			events, err := o.events(event, results)
+			require.NoError(t, err)
+			require.Len(t, events, len(tc.expectIDs))
+
+			for i, e := range events {
+				assert.Equal(t, tc.expectIDs[i], e.ID())
+				assert.Equal(t, tc.expectData[i], string(e.Data()))
+				assert.Equal(t, tc.expectTypes[i], e.Type())
+				assert.Equal(t, tc.expectSubjects[i], e.Subject())
+				assert.Equal(t, tCloudEventSource, e.Source())
+			}
+		})
+	}
+}
+
+func TestOutputInvalidTemplate(t *testing.T) {
+	_, err := newOutput(true, "{{ .Event", "", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsontoxmltransformation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/xmljson"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	metricstesting "github.com/triggermesh/triggermesh/pkg/metrics/testing"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const (
+	tCloudEventID     = "ce-abcd-0123"
+	tCloudEventType   = "ce.test.type"
+	tCloudEventSource = "ce.test.source"
+
+	tJSON1      = `{"note": {"to": "Tove"}}`
+	tXMLOutput1 = `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<note><to>Tove</to></note>`
+
+	tJSON2      = `{"@id": "1", "item": [{"$": "2", "@sku": "a"}]}`
+	tXMLOutput2 = `<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<order id="1"><item sku="a">2</item></order>`
+
+	tFalseJSON         = `this is not json`
+	tFalseJSONResponse = `{"Code":"request-validation","Description":"invalid JSON","Details":null}`
+
+	tMultipleRootsJSON         = `{"a": 1, "b": 2}`
+	tMultipleRootsJSONResponse = `{"Code":"request-validation","Description":"the JSON document must be an object with a single member ` +
+		`when the name of the root element is not set","Details":null}`
+)
+
+func TestSink(t *testing.T) {
+	testCases := map[string]struct {
+		inEvent     cloudevents.Event
+		expectEvent cloudevents.Event
+	}{
+		"sink ok": {
+			inEvent:     newCloudEvent(t, tJSON1, cloudevents.ApplicationJSON),
+			expectEvent: newCloudEvent(t, tXMLOutput1, cloudevents.ApplicationXML),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			metricstesting.ResetMetrics(t)
+
+			ceClient := adaptertest.NewTestClient()
+
+			logger := logtesting.TestLogger(t)
+
+			replier, err := targetce.New("test-jsontoxml", logger)
+			require.NoError(t, err)
+
+			mt := &adapter.MetricTag{}
+
+			a := &Adapter{
+				converter: xmljson.New(xmljson.Conventions{}),
+
+				sink:     "http://fake",
+				replier:  replier,
+				ceClient: ceClient,
+				logger:   logger,
+
+				mt: mt,
+				sr: metrics.MustNewEventProcessingStatsReporter(mt),
+			}
+
+			ctx := context.Background()
+
+			e, r := a.dispatch(ctx, tc.inEvent)
+			assert.Nil(t, e)
+			assert.Equal(t, cloudevents.ResultACK, r)
+
+			events := ceClient.Sent()
+			require.Equal(t, 1, len(events))
+			assert.Equal(t, tc.expectEvent, events[0])
+		})
+	}
+}
+
+func TestReplier(t *testing.T) {
+	testCases := map[string]struct {
+		conventions xmljson.Conventions
+		inEvent     cloudevents.Event
+		expectEvent cloudevents.Event
+	}{
+		"transform ok": {
+			inEvent:     newCloudEvent(t, tJSON1, cloudevents.ApplicationJSON),
+			expectEvent: newCloudEvent(t, tXMLOutput1, cloudevents.ApplicationXML),
+		},
+		"transform with conventions ok": {
+			conventions: xmljson.Conventions{
+				AttributePrefix: "@",
+				TextKey:         "$",
+				ArrayElements:   []string{"item"},
+				RootElement:     "order",
+			},
+			inEvent:     newCloudEvent(t, tJSON2, cloudevents.ApplicationJSON),
+			expectEvent: newCloudEvent(t, tXMLOutput2, cloudevents.ApplicationXML),
+		},
+		"transform error": {
+			inEvent:     newCloudEvent(t, tFalseJSON, cloudevents.ApplicationJSON),
+			expectEvent: newCloudEvent(t, tFalseJSONResponse, cloudevents.ApplicationJSON),
+		},
+		"transform multiple root members error": {
+			inEvent:     newCloudEvent(t, tMultipleRootsJSON, cloudevents.ApplicationJSON),
+			expectEvent: newCloudEvent(t, tMultipleRootsJSONResponse, cloudevents.ApplicationJSON),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			metricstesting.ResetMetrics(t)
+
+			ceClient, send, responses := cetest.NewMockResponderClient(t, 1)
+
+			logger := logtesting.TestLogger(t)
+
+			replier, err := targetce.New(tCloudEventSource, logger)
+			require.NoError(t, err)
+
+			mt := &adapter.MetricTag{}
+
+			a := &Adapter{
+				converter: xmljson.New(tc.conventions),
+
+				replier:  replier,
+				ceClient: ceClient,
+				logger:   logger,
+
+				mt: mt,
+				sr: metrics.MustNewEventProcessingStatsReporter(mt),
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			go func() {
+				if err := a.Start(ctx); err != nil {
+					assert.FailNow(t, "could not start test adapter")
+				}
+			}()
+
+			send <- tc.inEvent
+
+			select {
+			case event := <-responses:
+				assert.Equal(t, tCloudEventSource, event.Event.Source())
+				assert.Equal(t, string(tc.expectEvent.DataEncoded), string(event.Event.DataEncoded))
+
+			case <-time.After(2 * time.Second):
+				assert.Fail(t, "expected cloud event response was not received")
+			}
+
+		})
+	}
+}
+
+func newCloudEvent(t *testing.T, data, contentType string) cloudevents.Event {
+	t.Helper()
+
+	event := cloudevents.NewEvent()
+
+	event.SetID(tCloudEventID)
+	event.SetType(tCloudEventType)
+	event.SetSource(tCloudEventSource)
+
+	err := event.SetData(contentType, []byte(data))
+	require.NoError(t, err)
+
+	return event
+}
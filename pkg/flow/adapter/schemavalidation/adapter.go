@@ -0,0 +1,149 @@
+//go:build !noclibs
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidation
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// errInvalidPayload is reported for events that fail the validation.
+var errInvalidPayload = errors.New("the event data does not match the schema")
+
+// violationDetails are the details of the errors replied for invalid events.
+type violationDetails struct {
+	Violations []string `json:"violations"`
+}
+
+// NewAdapter adapter implementation
+func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+	logger := logging.FromContext(ctx)
+
+	mt := &pkgadapter.MetricTag{
+		ResourceGroup: flow.SchemaValidationResource.String(),
+		Namespace:     envAcc.GetNamespace(),
+		Name:          envAcc.GetName(),
+	}
+
+	metrics.MustRegisterEventProcessingStatsView()
+
+	env := envAcc.(*envAccessor)
+
+	sels, err := newSelectors(env.Schemas, schemaDocument)
+	if err != nil {
+		logger.Panicw("Invalid schemas", zap.Error(err))
+	}
+
+	replier, err := targetce.New(env.Component, logger.Named("replier"),
+		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
+		targetce.ReplierWithStaticErrorResponseType(v1alpha1.EventTypeSchemaValidationError),
+		targetce.ReplierWithPayloadPolicy(targetce.PayloadPolicy(env.CloudEventPayloadPolicy)))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
+	return &Adapter{
+		selectors: sels,
+
+		sink:           env.Sink,
+		deadLetterSink: env.DeadLetterSink,
+		replier:        replier,
+		ceClient:       ceClient,
+		logger:         logger,
+
+		mt: mt,
+		sr: metrics.MustNewEventProcessingStatsReporter(mt),
+	}
+}
+
+var _ pkgadapter.Adapter = (*Adapter)(nil)
+
+// Adapter validates the data of events against schemas.
+type Adapter struct {
+	selectors selectors
+
+	sink           string
+	deadLetterSink string
+	replier        *targetce.Replier
+	ceClient       cloudevents.Client
+	logger         *zap.SugaredLogger
+
+	mt *pkgadapter.MetricTag
+	sr *metrics.EventProcessingStatsReporter
+}
+
+// Start is a blocking function and will return if an error occurs
+// or the context is cancelled.
+func (a *Adapter) Start(ctx context.Context) error {
+	a.logger.Info("Starting SchemaValidation Adapter")
+	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+	return a.ceClient.StartReceiver(ctx, a.dispatch)
+}
+
+// dispatch forwards valid events unchanged. Invalid events are sent to the
+// dead letter sink if there is one, or replied with an error otherwise.
+func (a *Adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	if v := a.selectors.lookup(event.Type()); v != nil {
+		violations, err := v.validate(event.Data())
+		if err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+		}
+		if len(violations) != 0 {
+			return a.reject(ctx, &event, violations)
+		}
+	}
+
+	if a.sink != "" {
+		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, nil)
+		}
+		return nil, cloudevents.ResultACK
+	}
+
+	return &event, cloudevents.ResultACK
+}
+
+// reject handles an event that failed the validation.
+func (a *Adapter) reject(ctx context.Context, event *cloudevents.Event, violations []string) (*cloudevents.Event, cloudevents.Result) {
+	details := violationDetails{Violations: violations}
+
+	if a.deadLetterSink == "" {
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, errInvalidPayload, details)
+	}
+
+	a.logger.Debugw("Sending invalid event to the dead letter sink",
+		zap.String("id", event.ID()), zap.Strings("violations", violations))
+
+	if result := a.ceClient.Send(cloudevents.ContextWithTarget(ctx, a.deadLetterSink), *event); !cloudevents.IsACK(result) {
+		return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, result, details)
+	}
+	return nil, cloudevents.ResultACK
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	metricstesting "github.com/triggermesh/triggermesh/pkg/metrics/testing"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const (
+	tCloudEventID     = "ce-abcd-0123"
+	tCloudEventSource = "ce.test.source"
+
+	tJSONType = "order.json"
+	tXMLType  = "order.xml"
+
+	tValidJSON   = `{"id": 1, "customer": {"name": "Jane"}}`
+	tInvalidJSON = `{"id": 1}`
+	tValidXML    = `<order id="1"><customer>Jane</customer></order>`
+	tInvalidXML  = `<order><customer>Jane</customer></order>`
+
+	tInvalidJSONResponse = `{"Code":"request-validation","Description":"the event data does not match the schema",` +
+		`"Details":{"violations":["customer in body is required"]}}`
+	tInvalidXMLResponse = `{"Code":"request-validation","Description":"the event data does not match the schema",` +
+		`"Details":{"violations":["line 1: Element 'order': The attribute 'id' is required but missing."]}}`
+)
+
+func TestSink(t *testing.T) {
+	testCases := map[string]struct {
+		deadLetterSink string
+		inEvent        cloudevents.Event
+		expectSent     bool
+	}{
+		"valid JSON": {
+			inEvent:    newCloudEvent(t, tJSONType, tValidJSON, cloudevents.ApplicationJSON),
+			expectSent: true,
+		},
+		"valid XML": {
+			inEvent:    newCloudEvent(t, tXMLType, tValidXML, cloudevents.ApplicationXML),
+			expectSent: true,
+		},
+		"unmatched type": {
+			inEvent:    newCloudEvent(t, "other", tInvalidJSON, cloudevents.ApplicationJSON),
+			expectSent: true,
+		},
+		"invalid with dead letter sink": {
+			deadLetterSink: "http://fake-dls",
+			inEvent:        newCloudEvent(t, tJSONType, tInvalidJSON, cloudevents.ApplicationJSON),
+			expectSent:     true,
+		},
+		"invalid without dead letter sink": {
+			inEvent:    newCloudEvent(t, tXMLType, tInvalidXML, cloudevents.ApplicationXML),
+			expectSent: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			metricstesting.ResetMetrics(t)
+
+			ceClient := adaptertest.NewTestClient()
+
+			a := newTestAdapter(t, ceClient)
+			a.sink = "http://fake"
+			a.deadLetterSink = tc.deadLetterSink
+
+			e, r := a.dispatch(context.Background(), tc.inEvent)
+			assert.Equal(t, cloudevents.ResultACK, r)
+
+			events := ceClient.Sent()
+			if !tc.expectSent {
+				assert.Empty(t, events)
+				require.NotNil(t, e)
+				assert.Equal(t, v1alpha1.EventTypeSchemaValidationError, e.Type())
+				return
+			}
+
+			assert.Nil(t, e)
+			require.Equal(t, 1, len(events))
+			assert.Equal(t, tc.inEvent, events[0])
+		})
+	}
+}
+
+func TestReplier(t *testing.T) {
+	testCases := map[string]struct {
+		inEvent     cloudevents.Event
+		expectEvent cloudevents.Event
+	}{
+		"valid JSON": {
+			inEvent:     newCloudEvent(t, tJSONType, tValidJSON, cloudevents.ApplicationJSON),
+			expectEvent: newCloudEvent(t, tJSONType, tValidJSON, cloudevents.ApplicationJSON),
+		},
+		"invalid JSON": {
+			inEvent:     newCloudEvent(t, tJSONType, tInvalidJSON, cloudevents.ApplicationJSON),
+			expectEvent: newCloudEvent(t, v1alpha1.EventTypeSchemaValidationError, tInvalidJSONResponse, cloudevents.ApplicationJSON),
+		},
+		"invalid XML": {
+			inEvent:     newCloudEvent(t, tXMLType, tInvalidXML, cloudevents.ApplicationXML),
+			expectEvent: newCloudEvent(t, v1alpha1.EventTypeSchemaValidationError, tInvalidXMLResponse, cloudevents.ApplicationJSON),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			metricstesting.ResetMetrics(t)
+
+			ceClient, send, responses := cetest.NewMockResponderClient(t, 1)
+
+			a := newTestAdapter(t, ceClient)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			go func() {
+				if err := a.Start(ctx); err != nil {
+					assert.FailNow(t, "could not start test adapter")
+				}
+			}()
+
+			send <- tc.inEvent
+
+			select {
+			case event := <-responses:
+				assert.Equal(t, tc.expectEvent.Type(), event.Event.Type())
+				assert.Equal(t, tCloudEventSource, event.Event.Source())
+				assert.Equal(t, string(tc.expectEvent.DataEncoded), string(event.Event.DataEncoded))
+
+			case <-time.After(2 * time.Second):
+				assert.Fail(t, "expected cloud event response was not received")
+			}
+		})
+	}
+}
+
+func newTestAdapter(t *testing.T, ceClient cloudevents.Client) *Adapter {
+	t.Helper()
+
+	schemas := []v1alpha1.EventSchema{{
+		Language:   v1alpha1.SchemaLanguageJSONSchema,
+		EventTypes: []string{tJSONType},
+	}, {
+		Language:   v1alpha1.SchemaLanguageXMLSchema,
+		EventTypes: []string{tXMLType},
+	}}
+	docs := []string{tJSONSchema, tXMLSchema}
+
+	sels, err := newSelectors(schemas, func(i int) ([]byte, error) {
+		return []byte(docs[i]), nil
+	})
+	require.NoError(t, err)
+
+	logger := logtesting.TestLogger(t)
+
+	replier, err := targetce.New(tCloudEventSource, logger,
+		targetce.ReplierWithStaticErrorResponseType(v1alpha1.EventTypeSchemaValidationError))
+	require.NoError(t, err)
+
+	mt := &adapter.MetricTag{}
+
+	return &Adapter{
+		selectors: sels,
+
+		replier:  replier,
+		ceClient: ceClient,
+		logger:   logger,
+
+		mt: mt,
+		sr: metrics.MustNewEventProcessingStatsReporter(mt),
+	}
+}
+
+func newCloudEvent(t *testing.T, typ, data, contentType string) cloudevents.Event {
+	t.Helper()
+
+	event := cloudevents.NewEvent()
+
+	event.SetID(tCloudEventID)
+	event.SetType(typ)
+	event.SetSource(tCloudEventSource)
+
+	err := event.SetData(contentType, []byte(data))
+	require.NoError(t, err)
+
+	return event
+}
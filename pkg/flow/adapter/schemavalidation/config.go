@@ -0,0 +1,73 @@
+//go:build !noclibs
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+// envSchemaPrefix is the prefix of the variables holding the schema
+// documents, which are suffixed by the index of the schema.
+const envSchemaPrefix = "SCHEMAVALIDATION_SCHEMA_"
+
+// EnvAccessorCtor for configuration parameters
+func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
+	return &envAccessor{}
+}
+
+type envAccessor struct {
+	pkgadapter.EnvConfig
+
+	// Schemas the events are validated against, without their document.
+	Schemas Schemas `envconfig:"SCHEMAVALIDATION_SCHEMAS" required:"true"`
+	// DeadLetterSink is the destination of the events that fail the
+	// validation. If not defined, those events are replied with an error.
+	DeadLetterSink string `envconfig:"SCHEMAVALIDATION_DEAD_LETTER_SINK"`
+	// BridgeIdentifier is the name of the bridge workflow this target is part of
+	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
+	// CloudEvents responses parametrization
+	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"error"`
+	// Sink defines the target sink for the events. If no Sink is defined the
+	// events are replied back to the sender.
+	Sink string `envconfig:"K_SINK"`
+}
+
+// Schemas is the list of schemas the events are validated against.
+type Schemas []v1alpha1.EventSchema
+
+// Decode a JSON array of schemas.
+func (s *Schemas) Decode(value string) error {
+	return json.Unmarshal([]byte(value), s)
+}
+
+// schemaDocument returns the document of the schema at the given index.
+func schemaDocument(i int) ([]byte, error) {
+	doc, ok := os.LookupEnv(envSchemaPrefix + strconv.Itoa(i))
+	if !ok || doc == "" {
+		return nil, fmt.Errorf("the document of schema %d is not set", i)
+	}
+	return []byte(doc), nil
+}
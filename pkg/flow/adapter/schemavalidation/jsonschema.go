@@ -0,0 +1,176 @@
+//go:build !noclibs
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// Limits of the expansion of schema references. The depth prevents
+// recursive schemas from being expanded endlessly, the number of nodes
+// prevents schemas which reference a same definition many times at each
+// level from growing exponentially.
+const (
+	maxRefDepth    = 32
+	maxSchemaNodes = 100000
+)
+
+// jsonSchema validates JSON documents against a JSON Schema (draft 4).
+type jsonSchema struct {
+	schema *spec.Schema
+}
+
+var _ validator = (*jsonSchema)(nil)
+
+// newJSONSchema compiles the JSON Schema document. References to
+// definitions of the document are expanded, other references are not
+// supported.
+func newJSONSchema(doc []byte) (*jsonSchema, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(doc, &root); err != nil {
+		return nil, fmt.Errorf("the schema is not a JSON object: %w", err)
+	}
+
+	// definitions are inlined where they are referenced
+	node := make(map[string]interface{}, len(root))
+	for k, v := range root {
+		if k != "definitions" && k != "$defs" {
+			node[k] = v
+		}
+	}
+
+	exp := &refExpander{root: root}
+	expanded, err := exp.expand(node, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(expanded)
+	if err != nil {
+		return nil, fmt.Errorf("encoding expanded schema: %w", err)
+	}
+	s := &spec.Schema{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+
+	return &jsonSchema{schema: s}, nil
+}
+
+// validate implements validator.
+func (s *jsonSchema) validate(doc []byte) ([]string, error) {
+	var data interface{}
+	if err := json.Unmarshal(doc, &data); err != nil {
+		return []string{"invalid JSON: " + err.Error()}, nil
+	}
+
+	res := validate.NewSchemaValidator(s.schema, nil, "", strfmt.Default).Validate(data)
+	if res.IsValid() {
+		return nil, nil
+	}
+
+	violations := make([]string, 0, len(res.Errors))
+	for _, err := range res.Errors {
+		// properties of the root object are prefixed by its empty name
+		violations = append(violations, strings.TrimPrefix(err.Error(), "."))
+	}
+	sort.Strings(violations)
+
+	return violations, nil
+}
+
+// refExpander replaces the "$ref" objects of schema nodes with the nodes
+// they point to in the root document.
+type refExpander struct {
+	root map[string]interface{}
+	// number of nodes of the expanded schema
+	nodes int
+}
+
+// expand returns a copy of the schema node with its references expanded.
+func (e *refExpander) expand(node interface{}, depth int) (interface{}, error) {
+	if e.nodes++; e.nodes > maxSchemaNodes {
+		return nil, fmt.Errorf("the expanded schema exceeds %d nodes", maxSchemaNodes)
+	}
+
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := n["$ref"].(string); ok {
+			if depth >= maxRefDepth {
+				return nil, errors.New("too many nested references, recursive schemas are not supported")
+			}
+			target, err := resolveRef(ref, e.root)
+			if err != nil {
+				return nil, err
+			}
+			return e.expand(target, depth+1)
+		}
+
+		out := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			exp, err := e.expand(v, depth)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = exp
+		}
+		return out, nil
+
+	case []interface{}:
+		out := make([]interface{}, 0, len(n))
+		for _, v := range n {
+			exp, err := e.expand(v, depth)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, exp)
+		}
+		return out, nil
+	}
+
+	return node, nil
+}
+
+// resolveRef returns the node of the root document the local reference
+// points to.
+func resolveRef(ref string, root map[string]interface{}) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("only local references are supported: %s", ref)
+	}
+
+	ptr, err := jsonpointer.New(strings.TrimPrefix(ref, "#"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference %s: %w", ref, err)
+	}
+	target, _, err := ptr.Get(root)
+	if err != nil {
+		return nil, fmt.Errorf("unresolvable reference %s: %w", ref, err)
+	}
+
+	return target, nil
+}
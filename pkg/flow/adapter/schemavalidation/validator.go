@@ -0,0 +1,93 @@
+//go:build !noclibs
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidation
+
+import (
+	"fmt"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+// validator checks documents against a schema.
+type validator interface {
+	// validate returns the violations of the schema found in the document.
+	// An error is returned when the document could not be processed.
+	validate(doc []byte) ([]string, error)
+}
+
+// selector associates a validator to the types of events it applies to.
+type selector struct {
+	// types is the set of event types, any type is selected when empty.
+	types     map[string]struct{}
+	validator validator
+}
+
+// selectors is an ordered list of validators selected by event type.
+type selectors []selector
+
+// newSelectors compiles the schemas. Documents are read by the doc
+// function, from their index in the list.
+func newSelectors(schemas []v1alpha1.EventSchema, doc func(int) ([]byte, error)) (selectors, error) {
+	sels := make(selectors, 0, len(schemas))
+
+	for i, s := range schemas {
+		d, err := doc(i)
+		if err != nil {
+			return nil, err
+		}
+
+		var v validator
+		switch s.Language {
+		case v1alpha1.SchemaLanguageJSONSchema:
+			v, err = newJSONSchema(d)
+		case v1alpha1.SchemaLanguageXMLSchema:
+			v, err = newXMLSchema(d)
+		default:
+			err = fmt.Errorf("unsupported language %q", s.Language)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("compiling schema %d: %w", i, err)
+		}
+
+		sel := selector{validator: v}
+		if len(s.EventTypes) != 0 {
+			sel.types = make(map[string]struct{}, len(s.EventTypes))
+			for _, t := range s.EventTypes {
+				sel.types[t] = struct{}{}
+			}
+		}
+		sels = append(sels, sel)
+	}
+
+	return sels, nil
+}
+
+// lookup returns the validator of the first schema selecting the event
+// type, or nil if none does.
+func (s selectors) lookup(eventType string) validator {
+	for _, sel := range s {
+		if sel.types == nil {
+			return sel.validator
+		}
+		if _, ok := sel.types[eventType]; ok {
+			return sel.validator
+		}
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+const (
+	tJSONSchema = `{
+  "type": "object",
+  "required": ["id", "customer"],
+  "properties": {
+    "id": {"type": "integer", "minimum": 1},
+    "customer": {"$ref": "#/definitions/customer"}
+  },
+  "definitions": {
+    "customer": {
+      "type": "object",
+      "required": ["name"],
+      "properties": {
+        "name": {"type": "string"},
+        "tags": {"type": "array", "items": {"type": "string"}}
+      }
+    }
+  }
+}`
+
+	tXMLSchema = `<?xml version="1.0"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:element name="order">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element name="customer" type="xs:string"/>
+      </xs:sequence>
+      <xs:attribute name="id" type="xs:positiveInteger" use="required"/>
+    </xs:complexType>
+  </xs:element>
+</xs:schema>`
+)
+
+func TestJSONSchema(t *testing.T) {
+	s, err := newJSONSchema([]byte(tJSONSchema))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		doc        string
+		violations []string
+	}{
+		"valid": {
+			doc: `{"id": 1, "customer": {"name": "Jane", "tags": ["vip"]}}`,
+		},
+		"invalid": {
+			doc: `{"id": 0, "customer": {"tags": [1]}}`,
+			violations: []string{
+				"customer.name in body is required",
+				`customer.tags[0] in body must be of type string: "number"`,
+				"id in body should be greater than or equal to 1",
+			},
+		},
+		"not JSON": {
+			doc:        `<order/>`,
+			violations: []string{"invalid JSON: invalid character '<' looking for beginning of value"},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			violations, err := s.validate([]byte(tc.doc))
+			require.NoError(t, err)
+			assert.Equal(t, tc.violations, violations)
+		})
+	}
+}
+
+func TestJSONSchemaCompile(t *testing.T) {
+	testCases := map[string]struct {
+		schema string
+		errMsg string
+	}{
+		"not an object": {
+			schema: `[]`,
+			errMsg: "the schema is not a JSON object: json: cannot unmarshal array into Go value of type map[string]interface {}",
+		},
+		"remote reference": {
+			schema: `{"properties": {"a": {"$ref": "http://example.com/schema.json"}}}`,
+			errMsg: "only local references are supported: http://example.com/schema.json",
+		},
+		"unresolvable reference": {
+			schema: `{"properties": {"a": {"$ref": "#/definitions/a"}}}`,
+			errMsg: `unresolvable reference #/definitions/a: object has no key "definitions"`,
+		},
+		"recursive reference": {
+			schema: `{"properties": {"a": {"$ref": "#/definitions/a"}}, "definitions": {"a": {"items": {"$ref": "#/definitions/a"}}}}`,
+			errMsg: "too many nested references, recursive schemas are not supported",
+		},
+		"exponential references": {
+			schema: fanOutSchema(24),
+			errMsg: "the expanded schema exceeds 100000 nodes",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			_, err := newJSONSchema([]byte(tc.schema))
+			assert.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
+
+// fanOutSchema returns a schema which definitions reference the next
+// definition twice, up to the given number of levels.
+func fanOutSchema(levels int) string {
+	defs := make([]string, 0, levels+1)
+	for i := 0; i < levels; i++ {
+		defs = append(defs, fmt.Sprintf(`"d%d": {"properties": {"a": {"$ref": "#/definitions/d%d"}, `+
+			`"b": {"$ref": "#/definitions/d%d"}}}`, i, i+1, i+1))
+	}
+	defs = append(defs, fmt.Sprintf(`"d%d": {"type": "string"}`, levels))
+
+	return `{"$ref": "#/definitions/d0", "definitions": {` + strings.Join(defs, ", ") + `}}`
+}
+
+func TestXMLSchema(t *testing.T) {
+	s, err := newXMLSchema([]byte(tXMLSchema))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		doc        string
+		violations []string
+	}{
+		"valid": {
+			doc: `<order id="1"><customer>Jane</customer></order>`,
+		},
+		"invalid": {
+			doc: `<order id="0"><client>Jane</client></order>`,
+			violations: []string{
+				"line 1: Element 'order', attribute 'id': '0' is not a valid value of the atomic type 'xs:positiveInteger'.",
+				"line 1: Element 'client': This element is not expected. Expected is ( customer ).",
+			},
+		},
+		"not XML": {
+			doc:        `{"id": 1}`,
+			violations: []string{"invalid XML: no root element"},
+		},
+		"not well-formed": {
+			doc:        `<order id="1"><customer>Jane</order>`,
+			violations: []string{"invalid XML: XML syntax error on line 1: element <customer> closed by </order>"},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			violations, err := s.validate([]byte(tc.doc))
+			require.NoError(t, err)
+			assert.Equal(t, tc.violations, violations)
+		})
+	}
+}
+
+func TestXMLSchemaCompile(t *testing.T) {
+	const xsd = `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">%s</xs:schema>`
+
+	testCases := map[string]struct {
+		schema string
+		errMsg string
+	}{
+		"unknown type": {
+			schema: fmt.Sprintf(xsd, `<xs:element name="a" type="xs:unknown"/>`),
+			errMsg: "invalid XML Schema: line 1: element decl. 'a', attribute 'type': The QName value " +
+				"'{http://www.w3.org/2001/XMLSchema}unknown' does not resolve to a(n) type definition.",
+		},
+		"external resource": {
+			schema: fmt.Sprintf(xsd, `<xs:include schemaLocation="/etc/passwd"/>`),
+			errMsg: "invalid XML Schema: line 1: Element '{http://www.w3.org/2001/XMLSchema}include': " +
+				"Failed to parse the XML resource '/etc/passwd'.",
+		},
+		"empty": {
+			schema: "",
+			errMsg: "empty schema",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			_, err := newXMLSchema([]byte(tc.schema))
+			assert.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestSelectors(t *testing.T) {
+	schemas := []v1alpha1.EventSchema{{
+		Language:   v1alpha1.SchemaLanguageXMLSchema,
+		EventTypes: []string{"order.xml"},
+	}, {
+		Language: v1alpha1.SchemaLanguageJSONSchema,
+	}}
+	docs := []string{tXMLSchema, tJSONSchema}
+
+	sels, err := newSelectors(schemas, func(i int) ([]byte, error) {
+		return []byte(docs[i]), nil
+	})
+	require.NoError(t, err)
+
+	assert.IsType(t, (*xmlSchema)(nil), sels.lookup("order.xml"))
+	assert.IsType(t, (*jsonSchema)(nil), sels.lookup("order.json"))
+
+	sels = sels[:1]
+	assert.Nil(t, sels.lookup("order.json"))
+
+	_, err = newSelectors(schemas, func(i int) ([]byte, error) {
+		return []byte("{}"), nil
+	})
+	assert.EqualError(t, err, "compiling schema 0: invalid XML Schema: Failed to parse the XML resource 'in_memory_buffer'.")
+}
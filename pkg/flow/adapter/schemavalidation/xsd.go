@@ -0,0 +1,217 @@
+//go:build !noclibs
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidation
+
+/*
+#cgo pkg-config: libxml-2.0
+
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+#include <libxml/parser.h>
+#include <libxml/xmlschemas.h>
+
+// xsd_errors accumulates the error messages reported by libxml2, one per line.
+typedef struct {
+	char  *buf;
+	size_t len;
+} xsd_errors;
+
+static void xsd_collect_error(void *ctx, xmlErrorPtr err) {
+	xsd_errors *errs = (xsd_errors *)ctx;
+	if (err == NULL || err->message == NULL) {
+		return;
+	}
+
+	// messages are terminated by a newline, lines are unknown when 0
+	const char *format = err->line > 0 ? "line %d: %s" : "%.0d%s";
+	int n = snprintf(NULL, 0, format, err->line, err->message);
+	if (n <= 0) {
+		return;
+	}
+	char *buf = realloc(errs->buf, errs->len + n + 1);
+	if (buf == NULL) {
+		return;
+	}
+	snprintf(buf + errs->len, n + 1, format, err->line, err->message);
+	errs->buf = buf;
+	errs->len += n;
+}
+
+// xsd_no_loader prevents libxml2 from loading external resources, such as
+// imported schemas or DTDs, from the network or the file system.
+static xmlParserInputPtr xsd_no_loader(const char *url, const char *id, xmlParserCtxtPtr ctxt) {
+	return NULL;
+}
+
+static void xsd_init() {
+	xmlInitParser();
+	xmlSetExternalEntityLoader(xsd_no_loader);
+}
+
+static xmlSchemaPtr xsd_parse(const char *doc, int size, xsd_errors *errs) {
+	xmlSchemaParserCtxtPtr ctxt = xmlSchemaNewMemParserCtxt(doc, size);
+	if (ctxt == NULL) {
+		return NULL;
+	}
+	xmlSchemaSetParserStructuredErrors(ctxt, (xmlStructuredErrorFunc)xsd_collect_error, errs);
+
+	xmlSchemaPtr schema = xmlSchemaParse(ctxt);
+	xmlSchemaFreeParserCtxt(ctxt);
+	return schema;
+}
+
+// xsd_validate returns 0 if the document is valid, a positive error code if
+// it is not, -1 in case of internal error and -2 if it could not be parsed.
+static int xsd_validate(xmlSchemaPtr schema, const char *doc, int size, xsd_errors *errs) {
+	xmlDocPtr xml = xmlReadMemory(doc, size, NULL, NULL, XML_PARSE_NONET | XML_PARSE_NOERROR | XML_PARSE_NOWARNING);
+	if (xml == NULL) {
+		return -2;
+	}
+
+	xmlSchemaValidCtxtPtr ctxt = xmlSchemaNewValidCtxt(schema);
+	if (ctxt == NULL) {
+		xmlFreeDoc(xml);
+		return -1;
+	}
+	xmlSchemaSetValidStructuredErrors(ctxt, (xmlStructuredErrorFunc)xsd_collect_error, errs);
+
+	int ret = xmlSchemaValidateDoc(ctxt, xml);
+	xmlSchemaFreeValidCtxt(ctxt);
+	xmlFreeDoc(xml);
+	return ret;
+}
+*/
+import "C"
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+func init() {
+	C.xsd_init()
+}
+
+// xmlSchema validates XML documents against an XML Schema Definition.
+// Schemas must be self-contained, imports and includes are not resolved.
+type xmlSchema struct {
+	ptr C.xmlSchemaPtr
+}
+
+var _ validator = (*xmlSchema)(nil)
+
+// newXMLSchema compiles the XSD document. The returned schema is released
+// when garbage collected.
+func newXMLSchema(doc []byte) (*xmlSchema, error) {
+	if len(doc) == 0 {
+		return nil, errors.New("empty schema")
+	}
+
+	var errs C.xsd_errors
+	defer func() { C.free(unsafe.Pointer(errs.buf)) }()
+
+	cdoc := C.CBytes(doc)
+	defer C.free(cdoc)
+
+	ptr := C.xsd_parse((*C.char)(cdoc), C.int(len(doc)), &errs)
+	if ptr == nil {
+		if msgs := errorMessages(&errs); len(msgs) != 0 {
+			return nil, fmt.Errorf("invalid XML Schema: %s", strings.Join(msgs, "; "))
+		}
+		return nil, errors.New("invalid XML Schema")
+	}
+
+	s := &xmlSchema{ptr: ptr}
+	runtime.SetFinalizer(s, (*xmlSchema).close)
+
+	return s, nil
+}
+
+// close releases the compiled schema.
+func (s *xmlSchema) close() {
+	C.xmlSchemaFree(s.ptr)
+}
+
+// validate implements validator.
+func (s *xmlSchema) validate(doc []byte) ([]string, error) {
+	var errs C.xsd_errors
+	defer func() { C.free(unsafe.Pointer(errs.buf)) }()
+
+	cdoc := C.CBytes(doc)
+	defer C.free(cdoc)
+
+	ret := C.xsd_validate(s.ptr, (*C.char)(cdoc), C.int(len(doc)), &errs)
+	runtime.KeepAlive(s)
+
+	switch {
+	case ret == 0:
+		return nil, nil
+	case ret == -2:
+		// libxml2 does not report the errors of documents which are
+		// not well-formed without altering its global state
+		return []string{"invalid XML: " + malformation(doc)}, nil
+	case ret < 0:
+		return nil, errors.New("internal error of the XML Schema validator")
+	}
+
+	violations := errorMessages(&errs)
+	if len(violations) == 0 {
+		violations = []string{fmt.Sprintf("the document is not valid (code %d)", int(ret))}
+	}
+	return violations, nil
+}
+
+// errorMessages returns the messages accumulated in errs.
+func errorMessages(errs *C.xsd_errors) []string {
+	if errs.buf == nil {
+		return nil
+	}
+	out := C.GoStringN(errs.buf, C.int(errs.len))
+	return strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+}
+
+// malformation returns the reason why the XML document is not well-formed.
+func malformation(doc []byte) string {
+	dec := xml.NewDecoder(bytes.NewReader(doc))
+	var root bool
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err.Error()
+		}
+		if _, ok := tok.(xml.StartElement); ok {
+			root = true
+		}
+	}
+	if !root {
+		return "no root element"
+	}
+	return "the document is not well-formed"
+}
@@ -18,10 +18,14 @@ package synchronizer
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -35,6 +39,14 @@ import (
 
 var _ pkgadapter.Adapter = (*adapter)(nil)
 
+// sessionExpirationMargin extends the lifetime of the stored session records
+// beyond the response timeout to tolerate the clock skew between replicas.
+const sessionExpirationMargin = time.Minute
+
+// sessionSweepInterval is the period of the removal of the expired
+// asynchronous sessions kept in memory.
+const sessionSweepInterval = 10 * time.Second
+
 type adapter struct {
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -45,7 +57,18 @@ type adapter struct {
 	correlationKey  *correlationKey
 	responseTimeout time.Duration
 
-	sessions *storage
+	// async enables the asynchronous mode where requests are acknowledged
+	// immediately and responses are polled or sent to the callback URL.
+	async             bool
+	sessionTTL        time.Duration
+	callbackAttribute string
+	allowedCallbacks  []*url.URL
+	component         string
+
+	// aggregation merges multiple responses into a single reply.
+	aggregation *aggregation
+
+	sessions sessionStorage
 	sinkURL  string
 	bridgeID string
 }
@@ -64,12 +87,52 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	env := envAcc.(*envAccessor)
 
-	key, err := newCorrelationKey(env.CorrelationKey, env.CorrelationKeyLength)
+	key, err := newCorrelationKey(env.CorrelationKey, env.CorrelationKeyLength, keySource{
+		path:             env.CorrelationKeyPath,
+		header:           env.CorrelationKeyHeader,
+		contextAttribute: env.CorrelationKeyContextAttribute,
+		expression:       env.CorrelationKeyExpression,
+	}, keySource{
+		path:             env.ResponseCorrelationKeyPath,
+		header:           env.ResponseCorrelationKeyHeader,
+		contextAttribute: env.ResponseCorrelationKeyContextAttribute,
+		expression:       env.ResponseCorrelationKeyExpression,
+	})
 	if err != nil {
 		logger.Panic("Cannot create an instance of Correlation Key: %v", err)
 	}
 
-	return &adapter{
+	var aggr *aggregation
+	if env.AggregationEnabled {
+		if aggr, err = newAggregation(env.AggregationCount, env.AggregationCompletion,
+			env.AggregationMerge, env.AggregationKeyAttribute); err != nil {
+			logger.Panicf("Cannot create responses aggregation: %v", err)
+		}
+		if env.AsyncEnabled {
+			logger.Warn("Responses aggregation is not supported in the asynchronous mode and will be ignored")
+		}
+	}
+
+	allowedCallbacks := make([]*url.URL, 0, len(env.AsyncAllowedCallbackURLs))
+	for _, u := range env.AsyncAllowedCallbackURLs {
+		allowed, err := url.Parse(u)
+		if err != nil || allowed.Scheme == "" || allowed.Host == "" {
+			logger.Panicf("Invalid allowed callback URL %q", u)
+		}
+		allowedCallbacks = append(allowedCallbacks, allowed)
+	}
+
+	sessions, err := newSessionStorage(ctx, env, logger)
+	if err != nil {
+		logger.Panicf("Cannot create sessions storage: %v", err)
+	}
+
+	sessionTTL := env.ResponseWaitTimeout
+	if env.AsyncTTL != 0 {
+		sessionTTL = env.AsyncTTL
+	}
+
+	a := &adapter{
 		ceClient: ceClient,
 		logger:   logger,
 
@@ -79,35 +142,97 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		correlationKey:  key,
 		responseTimeout: env.ResponseWaitTimeout,
 
-		sessions: newStorage(),
+		async:             env.AsyncEnabled,
+		sessionTTL:        sessionTTL,
+		callbackAttribute: env.AsyncCallbackAttribute,
+		allowedCallbacks:  allowedCallbacks,
+		component:         env.Component,
+
+		aggregation: aggr,
+
+		sessions: sessions,
 		sinkURL:  env.Sink,
 		bridgeID: env.BridgeIdentifier,
 	}
+
+	if a.async {
+		// the client of the asynchronous mode also serves the session
+		// status requests, on the port of the CloudEvents receiver
+		if a.ceClient, err = newAsyncClient(env, a.serveStatus); err != nil {
+			logger.Panicf("Cannot create the CloudEvents client: %v", err)
+		}
+	}
+
+	return a
+}
+
+// newSessionStorage returns Redis backed sessions storage if the server
+// address is set, otherwise sessions are kept in the adapter memory.
+func newSessionStorage(ctx context.Context, env *envAccessor, logger *zap.SugaredLogger) (sessionStorage, error) {
+	if env.RedisAddress == "" {
+		return newStorage(), nil
+	}
+
+	opts := &redis.Options{
+		Addr:     env.RedisAddress,
+		Username: env.RedisUsername,
+		Password: env.RedisPassword,
+		DB:       env.RedisDatabase,
+	}
+	if env.RedisTLSEnabled {
+		opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	prefix := strings.Join([]string{flow.SynchronizerResource.String(), env.GetNamespace(), env.GetName()}, ":")
+	return newRedisStorage(ctx, opts, prefix, env.ResponseWaitTimeout+sessionExpirationMargin, logger)
 }
 
 // Returns if stopCh is closed or Send() returns an error.
 func (a *adapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting Synchronizer Adapter")
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+
+	if s, ok := a.sessions.(*storage); ok && a.async {
+		go s.runSweep(ctx, sessionSweepInterval)
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
 func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Debugf("Received the event: %s", event.String())
 
-	if correlationID, exists := a.correlationKey.get(event); exists {
+	if correlationID, exists := a.correlationKey.response(ctx, event); exists {
 		return a.serveResponse(ctx, correlationID, event)
 	}
 
-	correlationID := a.correlationKey.set(&event)
+	correlationID, err := a.correlationKey.extract(ctx, event)
+	if err != nil {
+		a.logger.Errorw("Unable to read the correlation key", zap.Error(err))
+		return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "cannot read the correlation key: %v", err)
+	}
+	correlationID = a.correlationKey.set(&event, correlationID)
+
+	if a.async {
+		return a.acceptRequest(ctx, correlationID, event)
+	}
 	return a.serveRequest(ctx, correlationID, event)
 }
 
 // serveRequest creates the session for the incoming events and blocks the client.
+// Requests with the key of an open session are rejected with the "409 Conflict" status.
 func (a *adapter) serveRequest(ctx context.Context, correlationID string, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Debugf("Handling request %q", correlationID)
 
-	respChan, err := a.sessions.add(correlationID)
+	capacity := 1
+	if a.aggregation != nil {
+		capacity = a.aggregation.capacity()
+	}
+
+	respChan, err := a.sessions.add(correlationID, capacity)
+	if err == errSessionExists {
+		return nil, conflictResult(correlationID)
+	}
 	if err != nil {
 		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "cannot add session %q: %w", correlationID, err)
 	}
@@ -124,43 +249,81 @@ func (a *adapter) serveRequest(ctx context.Context, correlationID string, event
 
 	a.logger.Debugf("Waiting response for %q", correlationID)
 
-	select {
-	case err := <-sendErr:
-		a.logger.Errorw("Unable to forward the request", zap.Error(err))
-		return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "unable to forward the request: %v", err)
-	case result := <-respChan:
-		if result == nil {
-			a.logger.Errorw("No response", zap.Error(fmt.Errorf("response channel with ID %q is closed", correlationID)))
-			return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "failed to communicate the response")
+	// responses collected by the aggregation
+	var responses []*cloudevents.Event
+	timeout := time.After(a.responseTimeout)
+
+	for {
+		select {
+		case err := <-sendErr:
+			a.logger.Errorw("Unable to forward the request", zap.Error(err))
+			return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "unable to forward the request: %v", err)
+		case result := <-respChan:
+			if result == nil {
+				a.logger.Errorw("No response", zap.Error(fmt.Errorf("response channel with ID %q is closed", correlationID)))
+				return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "failed to communicate the response")
+			}
+			a.logger.Debugf("Received response for %q", correlationID)
+			if a.aggregation == nil {
+				res := a.withBridgeIdentifier(result)
+				return &res, cloudevents.ResultACK
+			}
+			if responses = append(responses, result); a.aggregation.completed(responses) {
+				return a.replyAggregated(correlationID, responses)
+			}
+		case <-timeout:
+			if len(responses) != 0 {
+				a.logger.Debugf("Aggregation of %q is timed out", correlationID)
+				return a.replyAggregated(correlationID, responses)
+			}
+			a.logger.Errorw("Request time out", zap.Error(fmt.Errorf("request %q did not receive backend response in time", correlationID)))
+			return nil, cloudevents.NewHTTPResult(http.StatusGatewayTimeout, "backend did not respond in time")
 		}
-		a.logger.Debugf("Received response for %q", correlationID)
-		res := a.withBridgeIdentifier(result)
-		return &res, cloudevents.ResultACK
-	case <-time.After(a.responseTimeout):
-		a.logger.Errorw("Request time out", zap.Error(fmt.Errorf("request %q did not receive backend response in time", correlationID)))
-		return nil, cloudevents.NewHTTPResult(http.StatusGatewayTimeout, "backend did not respond in time")
 	}
 }
 
+// conflictResult returns the result of the requests which correlation key
+// is the key of a session in flight.
+func conflictResult(correlationID string) cloudevents.Result {
+	return cloudevents.NewHTTPResult(http.StatusConflict, "a request with the correlation key %q is in flight", correlationID)
+}
+
+// replyAggregated merges the responses into a single reply.
+func (a *adapter) replyAggregated(correlationID string, responses []*cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	result, err := a.aggregation.merge(responses)
+	if err != nil {
+		a.logger.Errorw("Unable to merge the responses", zap.Error(fmt.Errorf("responses of %q cannot be merged: %w", correlationID, err)))
+		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "failed to merge the responses")
+	}
+	a.logger.Debugf("Merged %d responses for %q", len(responses), correlationID)
+	res := a.withBridgeIdentifier(result)
+	return &res, cloudevents.ResultACK
+}
+
 // serveResponse matches event's correlation key and writes response back to the session's communication channel.
 func (a *adapter) serveResponse(ctx context.Context, correlationID string, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Debugf("Handling response %q", correlationID)
 
-	responseChan, exists := a.sessions.get(correlationID)
-	if !exists {
-		a.logger.Errorw("Session not found", zap.Error(fmt.Errorf("client session with ID %q does not exist", correlationID)))
-		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client session does not exist")
+	if a.async {
+		return a.completeRequest(ctx, correlationID, event)
 	}
 
 	a.logger.Debugf("Forwarding response %q", correlationID)
-	select {
-	case responseChan <- &event:
-		a.logger.Debugf("Response %q completed", correlationID)
-		return nil, cloudevents.ResultACK
-	default:
+
+	switch err := a.sessions.respond(correlationID, &event); {
+	case err == errSessionNotFound:
+		a.logger.Errorw("Session not found", zap.Error(fmt.Errorf("client session with ID %q does not exist", correlationID)))
+		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client session does not exist")
+	case err == errSessionClosed:
 		a.logger.Errorw("Unable to forward the response", zap.Error(fmt.Errorf("client connection with ID %q is closed", correlationID)))
 		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client connection is closed")
+	case err != nil:
+		a.logger.Errorw("Unable to forward the response", zap.Error(err))
+		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "failed to communicate the response")
 	}
+
+	a.logger.Debugf("Response %q completed", correlationID)
+	return nil, cloudevents.ResultACK
 }
 
 // withBridgeIdentifier adds Bridge ID to the event context.
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Responses merge strategies.
+const (
+	mergeArray  = "array"
+	mergeObject = "object"
+)
+
+const (
+	// defaultKeyAttribute is the attribute used as the key of the merged responses.
+	defaultKeyAttribute = "source"
+	// aggregationBufferSize is the number of responses buffered
+	// by the session when the count is not limited.
+	aggregationBufferSize = 100
+)
+
+// aggregation collects multiple responses of the same session
+// and merges them into a single reply.
+type aggregation struct {
+	// count is the number of responses to wait for.
+	count int
+	// completion is the expression that completes the aggregation
+	// when it returns true for the received response.
+	completion *cel.ConditionalFilter
+
+	strategy     string
+	keyAttribute string
+}
+
+// newAggregation returns an instance of the responses aggregation.
+// Responses are collected until the timeout if neither count
+// nor completion expression is set.
+func newAggregation(count int, completion, strategy, keyAttribute string) (*aggregation, error) {
+	a := &aggregation{
+		count:        count,
+		strategy:     strategy,
+		keyAttribute: keyAttribute,
+	}
+
+	if count < 0 {
+		return nil, fmt.Errorf("responses count must not be negative")
+	}
+
+	switch a.strategy {
+	case "":
+		a.strategy = mergeArray
+	case mergeArray, mergeObject:
+	default:
+		return nil, fmt.Errorf("unknown merge strategy %q", strategy)
+	}
+
+	if a.keyAttribute == "" {
+		a.keyAttribute = defaultKeyAttribute
+	}
+
+	if completion != "" {
+		filter, err := cel.CompileExpression(completion)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compile completion expression: %w", err)
+		}
+		a.completion = &filter
+	}
+
+	return a, nil
+}
+
+// capacity returns the number of responses the session must buffer.
+func (a *aggregation) capacity() int {
+	if a.count > 0 {
+		return a.count
+	}
+	return aggregationBufferSize
+}
+
+// completed returns true if the collected responses complete the aggregation.
+func (a *aggregation) completed(responses []*cloudevents.Event) bool {
+	if a.count > 0 && len(responses) >= a.count {
+		return true
+	}
+	if a.completion == nil || len(responses) == 0 {
+		return false
+	}
+
+	last := responses[len(responses)-1]
+	done, err := a.completion.EvaluateEvent(last, func(path string) gjson.Result {
+		return gjson.GetBytes(last.Data(), path)
+	})
+	return err == nil && done
+}
+
+// merge combines the payloads of the responses into a JSON array or an object
+// keyed by the response attribute. The context of the first response
+// is used for the reply.
+func (a *aggregation) merge(responses []*cloudevents.Event) (*cloudevents.Event, error) {
+	if len(responses) == 0 {
+		return nil, fmt.Errorf("no responses to merge")
+	}
+
+	var data interface{}
+	switch a.strategy {
+	case mergeObject:
+		object := make(map[string]interface{}, len(responses))
+		for _, r := range responses {
+			// the latest response wins if the keys are equal
+			object[attributeValue(r, a.keyAttribute)] = payload(r)
+		}
+		data = object
+	default:
+		array := make([]interface{}, 0, len(responses))
+		for _, r := range responses {
+			array = append(array, payload(r))
+		}
+		data = array
+	}
+
+	reply := responses[0].Clone()
+	reply.SetID(uuid.NewString())
+	if err := reply.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return nil, fmt.Errorf("cannot encode merged payload: %w", err)
+	}
+	return &reply, nil
+}
+
+// payload returns the JSON payload of the event as is,
+// other payloads are returned as strings.
+func payload(event *cloudevents.Event) interface{} {
+	if json.Valid(event.Data()) {
+		return json.RawMessage(event.Data())
+	}
+	return string(event.Data())
+}
+
+// attributeValue returns the value of the CloudEvent context attribute or extension.
+func attributeValue(event *cloudevents.Event, name string) string {
+	switch name {
+	case "id":
+		return event.ID()
+	case "source":
+		return event.Source()
+	case "type":
+		return event.Type()
+	case "subject":
+		return event.Subject()
+	}
+	if val, exists := event.Extensions()[name]; exists {
+		str, _ := types.ToString(val)
+		return str
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregationCompleted(t *testing.T) {
+	testCases := []struct {
+		name       string
+		count      int
+		completion string
+		responses  []string
+		expected   bool
+	}{
+		{
+			name:      "count is not reached",
+			count:     3,
+			responses: []string{`{}`, `{}`},
+			expected:  false,
+		}, {
+			name:      "count is reached",
+			count:     2,
+			responses: []string{`{}`, `{}`},
+			expected:  true,
+		}, {
+			name:       "completion expression is true",
+			completion: `$done.(bool) == true`,
+			responses:  []string{`{"done":false}`, `{"done":true}`},
+			expected:   true,
+		}, {
+			name:       "completion expression is false",
+			completion: `$done.(bool) == true`,
+			responses:  []string{`{"done":true}`, `{"done":false}`},
+			expected:   false,
+		}, {
+			name:      "unlimited aggregation",
+			responses: []string{`{}`, `{}`, `{}`},
+			expected:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := newAggregation(tc.count, tc.completion, "", "")
+			assert.NoError(t, err)
+
+			var responses []*cloudevents.Event
+			for i, data := range tc.responses {
+				responses = append(responses, newResponse(t, string(rune('a'+i)), data))
+			}
+			assert.Equal(t, tc.expected, a.completed(responses))
+		})
+	}
+}
+
+func TestAggregationMerge(t *testing.T) {
+	responses := []*cloudevents.Event{
+		newResponse(t, "inventory", `{"stock":3}`),
+		newResponse(t, "pricing", `{"price":9.5}`),
+	}
+
+	a, err := newAggregation(0, "", mergeArray, "")
+	assert.NoError(t, err)
+	reply, err := a.merge(responses)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"stock":3},{"price":9.5}]`, string(reply.Data()))
+	assert.Equal(t, "inventory", reply.Source())
+
+	a, err = newAggregation(0, "", mergeObject, "")
+	assert.NoError(t, err)
+	reply, err = a.merge(responses)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"inventory":{"stock":3},"pricing":{"price":9.5}}`, string(reply.Data()))
+
+	_, err = newAggregation(0, "", "concat", "")
+	assert.Error(t, err)
+}
+
+func newResponse(t *testing.T, source, data string) *cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(source)
+	event.SetType("test.response")
+	event.SetSource(source)
+	assert.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(data)))
+	return &event
+}
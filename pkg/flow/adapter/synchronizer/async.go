@@ -0,0 +1,249 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/metrics/source"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+// statusPath is the path prefix of the asynchronous session status URLs.
+const statusPath = "/sessions/"
+
+// statusTokenParam is the query parameter of the status URLs that contains
+// the token of the session.
+const statusTokenParam = "token"
+
+// asyncSessionStatus describes the state of the asynchronous session.
+type asyncSessionStatus struct {
+	CorrelationKey string `json:"correlationKey"`
+	StatusURL      string `json:"statusURL,omitempty"`
+	Status         string `json:"status,omitempty"`
+}
+
+// newAsyncClient returns the CloudEvents client of the asynchronous mode. The
+// client is created the same way as the client of the adapter's shared main,
+// and its receiver also serves the session status requests.
+func newAsyncClient(env *envAccessor, statusHandler http.HandlerFunc) (cloudevents.Client, error) {
+	ceOverrides, err := env.GetCloudEventOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the CloudEvent overrides: %w", err)
+	}
+	reporter, err := source.NewStatsReporter()
+	if err != nil {
+		return nil, fmt.Errorf("cannot create the stats reporter: %w", err)
+	}
+	return pkgadapter.NewCloudEventsClientWithOptions(ceOverrides, reporter,
+		cehttp.WithGetHandlerFunc(statusHandler))
+}
+
+// acceptRequest creates the asynchronous session for the incoming event, forwards
+// the event and replies with the correlation key and the session status URL.
+// Requests with the key of an open session are rejected with the "409 Conflict" status.
+func (a *adapter) acceptRequest(ctx context.Context, correlationID string, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	a.logger.Debugf("Accepting request %q", correlationID)
+
+	callback, err := a.callbackURL(event)
+	if err != nil {
+		a.logger.Errorw("Invalid callback URL", zap.Error(err))
+		return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "invalid callback URL: %v", err)
+	}
+
+	// the token makes the status URL unguessable for other clients
+	token := uuid.NewString()
+
+	err = a.sessions.open(correlationID, callback, token, a.sessionTTL)
+	if err == errSessionExists {
+		return nil, conflictResult(correlationID)
+	}
+	if err != nil {
+		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "cannot add session %q: %v", correlationID, err)
+	}
+
+	if res := a.ceClient.Send(cloudevents.ContextWithTarget(ctx, a.sinkURL), a.withBridgeIdentifier(&event)); cloudevents.IsUndelivered(res) {
+		a.logger.Errorw("Unable to forward the request", zap.Error(res))
+		return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "unable to forward the request: %v", res)
+	}
+
+	accepted := cloudevents.NewEvent()
+	accepted.SetID(uuid.NewString())
+	accepted.SetType(v1alpha1.EventTypeSynchronizerRequestAccepted)
+	accepted.SetSource(a.component)
+	accepted.SetExtension(a.correlationKey.attribute, correlationID)
+	if err := accepted.SetData(cloudevents.ApplicationJSON, asyncSessionStatus{
+		CorrelationKey: correlationID,
+		StatusURL:      statusURL(ctx, correlationID, token),
+	}); err != nil {
+		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "cannot encode the session status: %v", err)
+	}
+
+	res := a.withBridgeIdentifier(&accepted)
+	return &res, cloudevents.NewHTTPResult(http.StatusAccepted, "")
+}
+
+// callbackURL returns the callback URL of the request, if any. The URL must
+// start with one of the allowed callback URLs so that the adapter can not be
+// used to send requests to arbitrary destinations.
+func (a *adapter) callbackURL(event cloudevents.Event) (string, error) {
+	if a.callbackAttribute == "" {
+		return "", nil
+	}
+	val, exists := event.Extensions()[a.callbackAttribute]
+	if !exists {
+		return "", nil
+	}
+
+	callback, err := types.ToString(val)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(callback)
+	if err != nil {
+		return "", err
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("URL %q must not contain user information", callback)
+	}
+
+	// dot segments are resolved before matching the allowed paths
+	trailingSlash := strings.HasSuffix(u.Path, "/")
+	u.Path, u.RawPath = path.Clean("/"+u.Path), ""
+	if trailingSlash && u.Path != "/" {
+		u.Path += "/"
+	}
+
+	for _, allowed := range a.allowedCallbacks {
+		if isAllowedCallback(allowed, u) {
+			return u.String(), nil
+		}
+	}
+	return "", fmt.Errorf("URL %q is not allowed", callback)
+}
+
+// isAllowedCallback returns whether the URL has the scheme and host of the
+// allowed URL and a path under the allowed path.
+func isAllowedCallback(allowed, u *url.URL) bool {
+	if !strings.EqualFold(allowed.Scheme, u.Scheme) || !strings.EqualFold(allowed.Host, u.Host) {
+		return false
+	}
+	prefix := allowed.Path
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return strings.HasPrefix(u.Path, prefix)
+	}
+	return u.Path == prefix || strings.HasPrefix(u.Path, prefix+"/")
+}
+
+// completeRequest stores the response of the asynchronous session
+// and sends it to the session callback URL if it is set.
+func (a *adapter) completeRequest(ctx context.Context, correlationID string, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	callback, err := a.sessions.complete(correlationID, &event)
+	switch {
+	case err == errSessionNotFound:
+		a.logger.Errorw("Session not found", zap.Error(fmt.Errorf("client session with ID %q does not exist", correlationID)))
+		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client session does not exist")
+	case err == errSessionClosed:
+		a.logger.Errorw("Unable to store the response", zap.Error(fmt.Errorf("client session with ID %q is completed", correlationID)))
+		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client session is completed")
+	case err != nil:
+		a.logger.Errorw("Unable to store the response", zap.Error(err))
+		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "failed to store the response")
+	}
+
+	if callback != "" {
+		a.logger.Debugf("Sending response %q to the callback URL", correlationID)
+		if res := a.ceClient.Send(cloudevents.ContextWithTarget(ctx, callback), a.withBridgeIdentifier(&event)); cloudevents.IsUndelivered(res) {
+			// the response is still available at the status URL
+			a.logger.Errorw("Unable to send the response to the callback URL", zap.Error(res))
+		}
+	}
+
+	a.logger.Debugf("Response %q completed", correlationID)
+	return nil, cloudevents.ResultACK
+}
+
+// serveStatus handles the requests to the asynchronous session status URL.
+// The response event is returned once received, pending sessions return
+// the "202 Accepted" status. Requests without the token of the session
+// are answered as if the session did not exist.
+func (a *adapter) serveStatus(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, statusPath) {
+		http.NotFound(w, r)
+		return
+	}
+	correlationID := strings.TrimPrefix(r.URL.Path, statusPath)
+
+	response, err := a.sessions.result(correlationID, r.URL.Query().Get(statusTokenParam))
+	switch {
+	case err == errSessionNotFound:
+		http.Error(w, "client session does not exist", http.StatusNotFound)
+		return
+	case err != nil:
+		a.logger.Errorw("Unable to read the session", zap.Error(err))
+		http.Error(w, "failed to read the session", http.StatusInternalServerError)
+		return
+	case response == nil:
+		w.Header().Set("Content-Type", cloudevents.ApplicationJSON)
+		w.WriteHeader(http.StatusAccepted)
+		if err := json.NewEncoder(w).Encode(asyncSessionStatus{
+			CorrelationKey: correlationID,
+			Status:         "pending",
+		}); err != nil {
+			a.logger.Errorw("Unable to write the session status", zap.Error(err))
+		}
+		return
+	}
+
+	res := a.withBridgeIdentifier(response)
+	if err := cehttp.WriteResponseWriter(r.Context(), binding.ToMessage(&res), http.StatusOK, w); err != nil {
+		a.logger.Errorw("Unable to write the response", zap.Error(err))
+	}
+}
+
+// statusURL returns the URL of the asynchronous session status
+// based on the host of the incoming request.
+func statusURL(ctx context.Context, correlationID, token string) string {
+	sessionPath := statusPath + url.PathEscape(correlationID) + "?" +
+		url.Values{statusTokenParam: []string{token}}.Encode()
+
+	req := cehttp.RequestDataFromContext(ctx)
+	if req == nil || req.Host == "" {
+		return sessionPath
+	}
+
+	scheme := "http"
+	if proto := req.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + req.Host + sessionPath
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/stretchr/testify/assert"
+
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestServeStatus(t *testing.T) {
+	a := &adapter{
+		logger:   logtesting.TestLogger(t),
+		sessions: newStorage(),
+	}
+
+	assert.NoError(t, a.sessions.open("foo", "", "secret", time.Minute))
+
+	testCases := []struct {
+		name           string
+		path           string
+		complete       bool
+		expectedStatus int
+	}{
+		{
+			name:           "unknown path",
+			path:           "/foo",
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name:           "unknown session",
+			path:           "/sessions/bar",
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name:           "missing token",
+			path:           "/sessions/foo",
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name:           "wrong token",
+			path:           "/sessions/foo?token=guess",
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name:           "pending session",
+			path:           "/sessions/foo?token=secret",
+			expectedStatus: http.StatusAccepted,
+		}, {
+			name:           "completed session",
+			path:           "/sessions/foo?token=secret",
+			complete:       true,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.complete {
+				response := cloudevents.NewEvent()
+				response.SetID("response")
+				response.SetType("test.response")
+				response.SetSource("test")
+				_, err := a.sessions.complete("foo", &response)
+				assert.NoError(t, err)
+			}
+
+			rec := httptest.NewRecorder()
+			a.serveStatus(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			if tc.complete {
+				assert.Equal(t, "response", rec.Header().Get("Ce-Id"))
+			}
+		})
+	}
+}
+
+func TestCallbackURL(t *testing.T) {
+	allowed := func(urls ...string) []*url.URL {
+		var res []*url.URL
+		for _, u := range urls {
+			parsed, err := url.Parse(u)
+			assert.NoError(t, err)
+			res = append(res, parsed)
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name             string
+		allowedCallbacks []*url.URL
+		callback         string
+		expectedURL      string
+		expectedErr      bool
+	}{
+		{
+			name: "no callback",
+		}, {
+			name:        "callbacks not allowed",
+			callback:    "http://example.com/cb",
+			expectedErr: true,
+		}, {
+			name:             "allowed host",
+			allowedCallbacks: allowed("http://example.com"),
+			callback:         "http://example.com/cb",
+			expectedURL:      "http://example.com/cb",
+		}, {
+			name:             "other host",
+			allowedCallbacks: allowed("http://example.com"),
+			callback:         "http://example.com.evil.net/cb",
+			expectedErr:      true,
+		}, {
+			name:             "other scheme",
+			allowedCallbacks: allowed("https://example.com"),
+			callback:         "http://example.com/cb",
+			expectedErr:      true,
+		}, {
+			name:             "allowed path",
+			allowedCallbacks: allowed("http://example.com/callbacks", "http://other.com/"),
+			callback:         "http://example.com/callbacks/1?x=y",
+			expectedURL:      "http://example.com/callbacks/1?x=y",
+		}, {
+			name:             "path prefix of another segment",
+			allowedCallbacks: allowed("http://example.com/callbacks"),
+			callback:         "http://example.com/callbacks-admin",
+			expectedErr:      true,
+		}, {
+			name:             "path escaping the allowed path",
+			allowedCallbacks: allowed("http://example.com/callbacks/"),
+			callback:         "http://example.com/callbacks/../admin",
+			expectedErr:      true,
+		}, {
+			name:             "user information",
+			allowedCallbacks: allowed("http://example.com"),
+			callback:         "http://user@example.com/cb",
+			expectedErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := &adapter{
+				callbackAttribute: "callback",
+				allowedCallbacks:  tc.allowedCallbacks,
+			}
+
+			event := cloudevents.NewEvent()
+			if tc.callback != "" {
+				event.SetExtension("callback", tc.callback)
+			}
+
+			callback, err := a.callbackURL(event)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedURL, callback)
+		})
+	}
+}
+
+func TestDuplicateRequest(t *testing.T) {
+	key, err := newCorrelationKey("correlationid", 24, keySource{path: "order.id"}, keySource{})
+	assert.NoError(t, err)
+
+	for _, async := range []bool{false, true} {
+		request := cloudevents.NewEvent()
+		request.SetID("request")
+		assert.NoError(t, request.SetData(cloudevents.ApplicationJSON, []byte(`{"order":{"id":"foo"}}`)))
+
+		a := &adapter{
+			logger:         logtesting.TestLogger(t),
+			correlationKey: key,
+			async:          async,
+			sessions:       newStorage(),
+		}
+
+		_, err := a.sessions.add("foo", 1)
+		assert.NoError(t, err)
+		assert.NoError(t, a.sessions.open("foo", "", "", time.Minute))
+
+		_, res := a.dispatch(context.Background(), request)
+		assertStatus(t, http.StatusConflict, res)
+	}
+}
+
+// assertStatus asserts that the result has the given HTTP status code.
+func assertStatus(t *testing.T, expected int, res cloudevents.Result) {
+	t.Helper()
+
+	var httpResult *cehttp.Result
+	if assert.True(t, cloudevents.ResultAs(res, &httpResult), "expected an HTTP result") {
+		assert.Equal(t, expected, httpResult.StatusCode)
+	}
+}
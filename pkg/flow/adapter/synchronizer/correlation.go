@@ -17,11 +17,17 @@ limitations under the License.
 package synchronizer
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/tidwall/gjson"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
 )
 
 // Correlation Key charset.
@@ -49,22 +55,111 @@ var (
 type correlationKey struct {
 	attribute string
 	length    int
+
+	// extractor returns the correlation key supplied by the caller.
+	extractor keyExtractor
+	// responseExtractor returns the correlation key of the responses
+	// which do not carry the correlation attribute.
+	responseExtractor keyExtractor
+}
+
+// keyExtractor reads the correlation key from the incoming event or its HTTP request.
+type keyExtractor func(ctx context.Context, event cloudevents.Event) (string, error)
+
+// keySource defines where the caller supplied correlation keys are read from.
+// Only one of the fields is expected to be set.
+type keySource struct {
+	// JSON path in the event payload.
+	path string
+	// HTTP request header.
+	header string
+	// CloudEvent context attribute, including the standard attributes.
+	contextAttribute string
+	// CEL expression evaluated against the event payload.
+	expression string
 }
 
 // NewCorrelationKey returns an instance of the CloudEvent Correlation key.
-func newCorrelationKey(attribute string, length int) (*correlationKey, error) {
+func newCorrelationKey(attribute string, length int, source, responseSource keySource) (*correlationKey, error) {
 	for _, rk := range restrictedKeys {
 		if attribute == rk {
 			return nil, fmt.Errorf("%q cannot be used as a correlation key", attribute)
 		}
 	}
 
+	extractor, err := newKeyExtractor(source)
+	if err != nil {
+		return nil, err
+	}
+
+	responseExtractor, err := newKeyExtractor(responseSource)
+	if err != nil {
+		return nil, err
+	}
+
 	return &correlationKey{
-		attribute: attribute,
-		length:    length,
+		attribute:         attribute,
+		length:            length,
+		extractor:         extractor,
+		responseExtractor: responseExtractor,
 	}, nil
 }
 
+// newKeyExtractor returns the function that reads the correlation key
+// from the source, nil if the source is not set.
+func newKeyExtractor(source keySource) (keyExtractor, error) {
+	switch {
+	case source.path != "":
+		return func(_ context.Context, event cloudevents.Event) (string, error) {
+			return nonEmpty(gjson.GetBytes(event.Data(), source.path).String(), "payload path", source.path)
+		}, nil
+
+	case source.header != "":
+		return func(ctx context.Context, _ cloudevents.Event) (string, error) {
+			var val string
+			if req := cehttp.RequestDataFromContext(ctx); req != nil {
+				val = req.Header.Get(source.header)
+			}
+			return nonEmpty(val, "header", source.header)
+		}, nil
+
+	case source.contextAttribute != "":
+		return func(_ context.Context, event cloudevents.Event) (string, error) {
+			return nonEmpty(attributeValue(&event, source.contextAttribute), "context attribute", source.contextAttribute)
+		}, nil
+
+	case source.expression != "":
+		expr, err := cel.CompileValueExpression(source.expression)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compile correlation key expression: %w", err)
+		}
+		return func(_ context.Context, event cloudevents.Event) (string, error) {
+			val, err := expr.EventValue(&event, func(path string) gjson.Result {
+				return gjson.GetBytes(event.Data(), path)
+			})
+			if err != nil {
+				return "", fmt.Errorf("cannot evaluate the correlation key expression: %w", err)
+			}
+			if val == nil {
+				return "", errors.New("correlation key expression returned no value")
+			}
+			return nonEmpty(fmt.Sprint(val), "expression", source.expression)
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// extract returns the correlation key supplied by the caller, or an empty
+// string if the source is not set. Events which do not contain the key
+// of a configured source return an error.
+func (k *correlationKey) extract(ctx context.Context, event cloudevents.Event) (string, error) {
+	if k.extractor == nil {
+		return "", nil
+	}
+	return k.extractor(ctx, event)
+}
+
 // Get returns the value of Correlation Key.
 func (k *correlationKey) get(event cloudevents.Event) (string, bool) {
 	if val, exists := event.Extensions()[k.attribute]; exists {
@@ -73,13 +168,41 @@ func (k *correlationKey) get(event cloudevents.Event) (string, bool) {
 	return "", false
 }
 
-// Set updates the CloudEvent's context with the random Correlation Key value.
-func (k *correlationKey) set(event *cloudevents.Event) string {
-	correlationID := randString(k.length)
+// response returns the correlation key of the event if it is a response,
+// that is if it carries the correlation attribute or, when the response
+// source is set, if it contains the key of that source.
+func (k *correlationKey) response(ctx context.Context, event cloudevents.Event) (string, bool) {
+	if val, exists := k.get(event); exists {
+		return val, true
+	}
+	if k.responseExtractor == nil {
+		return "", false
+	}
+	val, err := k.responseExtractor(ctx, event)
+	if err != nil {
+		return "", false
+	}
+	return val, true
+}
+
+// Set updates the CloudEvent's context with the Correlation Key value,
+// the random value is generated if the correlationID is empty.
+func (k *correlationKey) set(event *cloudevents.Event, correlationID string) string {
+	if correlationID == "" {
+		correlationID = randString(k.length)
+	}
 	event.SetExtension(k.attribute, correlationID)
 	return correlationID
 }
 
+// nonEmpty returns an error if the key read from the source is empty.
+func nonEmpty(key, source, name string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("correlation key %s %q is missing or empty", source, name)
+	}
+	return key, nil
+}
+
 // randString generates the random string with fixed length.
 func randString(length int) string {
 	k := make([]byte, length)
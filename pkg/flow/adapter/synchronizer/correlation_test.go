@@ -0,0 +1,152 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/stretchr/testify/assert"
+
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestCorrelationKeyExtract(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("123")
+	event.SetSubject("order-1")
+	assert.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(`{"order":{"id":"order-2","line":7}}`)))
+
+	ctx := cehttp.WithRequestDataAtContext(context.Background(), &http.Request{
+		Header: http.Header{"X-Request-Id": []string{"order-3"}},
+	})
+
+	testCases := []struct {
+		name     string
+		source   keySource
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "no source",
+		}, {
+			name:     "payload path",
+			source:   keySource{path: "order.id"},
+			expected: "order-2",
+		}, {
+			name:    "missing payload path",
+			source:  keySource{path: "order.number"},
+			wantErr: true,
+		}, {
+			name:     "context attribute",
+			source:   keySource{contextAttribute: "subject"},
+			expected: "order-1",
+		}, {
+			name:     "request header",
+			source:   keySource{header: "X-Request-ID"},
+			expected: "order-3",
+		}, {
+			name:     "expression",
+			source:   keySource{expression: `$order.id.(string) + "-" + string($order.line.(int64))`},
+			expected: "order-2-7",
+		}, {
+			name:    "missing request header",
+			source:  keySource{header: "X-Order-ID"},
+			wantErr: true,
+		}, {
+			name:    "expression which can not be evaluated",
+			source:  keySource{expression: `$order.number.(string)`},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := newCorrelationKey("correlationid", 24, tc.source, keySource{})
+			assert.NoError(t, err)
+
+			id, err := key.extract(ctx, event)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, id)
+		})
+	}
+}
+
+func TestCorrelationKeySet(t *testing.T) {
+	key, err := newCorrelationKey("correlationid", 24, keySource{}, keySource{})
+	assert.NoError(t, err)
+
+	event := cloudevents.NewEvent()
+	assert.Equal(t, "order-1", key.set(&event, "order-1"))
+	id, exists := key.get(event)
+	assert.True(t, exists)
+	assert.Equal(t, "order-1", id)
+
+	assert.Len(t, key.set(&event, ""), 24)
+
+	_, err = newCorrelationKey("subject", 24, keySource{}, keySource{})
+	assert.Error(t, err)
+}
+
+func TestDispatchResponseSource(t *testing.T) {
+	key, err := newCorrelationKey("correlationid", 24, keySource{path: "order.id"}, keySource{path: "result.order"})
+	assert.NoError(t, err)
+
+	a := &adapter{
+		logger:         logtesting.TestLogger(t),
+		correlationKey: key,
+		sessions:       newStorage(),
+	}
+
+	respChan, err := a.sessions.add("order-1", 1)
+	assert.NoError(t, err)
+
+	// the request key is not a response key,
+	// the duplicate request is rejected
+	request := cloudevents.NewEvent()
+	request.SetID("request")
+	assert.NoError(t, request.SetData(cloudevents.ApplicationJSON, []byte(`{"order":{"id":"order-1"}}`)))
+
+	_, res := a.dispatch(context.Background(), request)
+	assertStatus(t, http.StatusConflict, res)
+
+	// the response does not carry the correlation attribute,
+	// but the key at the response source
+	response := cloudevents.NewEvent()
+	response.SetID("response")
+	assert.NoError(t, response.SetData(cloudevents.ApplicationJSON, []byte(`{"result":{"order":"order-1"}}`)))
+
+	_, res = a.dispatch(context.Background(), response)
+	assert.True(t, cloudevents.IsACK(res))
+	if received := <-respChan; assert.NotNil(t, received) {
+		assert.Equal(t, "response", received.ID())
+	}
+
+	request = cloudevents.NewEvent()
+	request.SetID("request")
+	assert.NoError(t, request.SetData(cloudevents.ApplicationJSON, []byte(`{"order":{}}`)))
+
+	_, res = a.dispatch(context.Background(), request)
+	assertStatus(t, http.StatusBadRequest, res)
+}
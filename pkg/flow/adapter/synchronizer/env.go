@@ -34,6 +34,38 @@ type envAccessor struct {
 	CorrelationKeyLength int           `envconfig:"CORRELATION_KEY_LENGTH"`
 	ResponseWaitTimeout  time.Duration `envconfig:"RESPONSE_WAIT_TIMEOUT"`
 
+	// Source of the caller supplied correlation keys
+	CorrelationKeyPath             string `envconfig:"CORRELATION_KEY_PATH"`
+	CorrelationKeyHeader           string `envconfig:"CORRELATION_KEY_HEADER"`
+	CorrelationKeyContextAttribute string `envconfig:"CORRELATION_KEY_CONTEXT_ATTRIBUTE"`
+	CorrelationKeyExpression       string `envconfig:"CORRELATION_KEY_EXPRESSION"`
+
+	// Source of the correlation keys of the responses
+	ResponseCorrelationKeyPath             string `envconfig:"RESPONSE_CORRELATION_KEY_PATH"`
+	ResponseCorrelationKeyHeader           string `envconfig:"RESPONSE_CORRELATION_KEY_HEADER"`
+	ResponseCorrelationKeyContextAttribute string `envconfig:"RESPONSE_CORRELATION_KEY_CONTEXT_ATTRIBUTE"`
+	ResponseCorrelationKeyExpression       string `envconfig:"RESPONSE_CORRELATION_KEY_EXPRESSION"`
+
+	// Asynchronous mode parameters
+	AsyncEnabled             bool          `envconfig:"ASYNC_ENABLED"`
+	AsyncCallbackAttribute   string        `envconfig:"ASYNC_CALLBACK_ATTRIBUTE"`
+	AsyncAllowedCallbackURLs []string      `envconfig:"ASYNC_ALLOWED_CALLBACK_URLS"`
+	AsyncTTL                 time.Duration `envconfig:"ASYNC_TTL"`
+
+	// Responses aggregation parameters
+	AggregationEnabled      bool   `envconfig:"AGGREGATION_ENABLED"`
+	AggregationCount        int    `envconfig:"AGGREGATION_COUNT"`
+	AggregationCompletion   string `envconfig:"AGGREGATION_COMPLETION"`
+	AggregationMerge        string `envconfig:"AGGREGATION_MERGE"`
+	AggregationKeyAttribute string `envconfig:"AGGREGATION_KEY_ATTRIBUTE"`
+
+	// Redis compatible server that keeps the sessions shared between replicas
+	RedisAddress    string `envconfig:"REDIS_ADDRESS"`
+	RedisUsername   string `envconfig:"REDIS_USERNAME"`
+	RedisPassword   string `envconfig:"REDIS_PASSWORD"`
+	RedisDatabase   int    `envconfig:"REDIS_DATABASE"`
+	RedisTLSEnabled bool   `envconfig:"REDIS_TLS_ENABLED"`
+
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 }
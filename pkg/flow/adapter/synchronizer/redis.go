@@ -0,0 +1,272 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+var _ sessionStorage = (*redisStorage)(nil)
+
+// Hash fields of the asynchronous session.
+const (
+	asyncFieldCallback = "callback"
+	asyncFieldToken    = "token"
+	asyncFieldResponse = "response"
+)
+
+var (
+	// openScript creates the asynchronous session unless it exists.
+	openScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+  return 0
+end
+redis.call('HSET', KEYS[1], '` + asyncFieldCallback + `', ARGV[1], '` + asyncFieldToken + `', ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return 1
+`)
+
+	// completeScript stores the first response of the existing asynchronous
+	// session and returns the status code along with the callback URL.
+	completeScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+  return {0, ''}
+end
+if redis.call('HSETNX', KEYS[1], '` + asyncFieldResponse + `', ARGV[1]) == 0 then
+  return {1, ''}
+end
+return {2, redis.call('HGET', KEYS[1], '` + asyncFieldCallback + `')}
+`)
+)
+
+// redisStorage keeps the session records in a Redis compatible server so
+// that the responses received by any replica are handed over, through the
+// Pub/Sub channel of the replica that holds the client connection.
+type redisStorage struct {
+	// local contains the sessions held by this replica.
+	local *storage
+
+	client  *redis.Client
+	prefix  string
+	replica string
+	ttl     time.Duration
+
+	ctx    context.Context
+	logger *zap.SugaredLogger
+}
+
+// sessionResponse is the message published to the replica holding the session.
+type sessionResponse struct {
+	ID    string             `json:"id"`
+	Event *cloudevents.Event `json:"event"`
+}
+
+// newRedisStorage returns the sessions storage backed by the Redis server.
+// Session records expire after the ttl period in case they were not removed
+// by the replica, e.g. if it was terminated.
+func newRedisStorage(ctx context.Context, opts *redis.Options, prefix string, ttl time.Duration, logger *zap.SugaredLogger) (*redisStorage, error) {
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("cannot connect to Redis: %w", err)
+	}
+
+	s := &redisStorage{
+		local: newStorage(),
+
+		client:  client,
+		prefix:  prefix,
+		replica: uuid.NewString(),
+		ttl:     ttl,
+
+		ctx:    ctx,
+		logger: logger,
+	}
+
+	pubsub := client.Subscribe(ctx, s.channel(s.replica))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return nil, fmt.Errorf("cannot subscribe to the replica channel: %w", err)
+	}
+	go s.receive(pubsub)
+
+	return s, nil
+}
+
+// add registers the session held by this replica.
+func (s *redisStorage) add(id string, capacity int) (<-chan *cloudevents.Event, error) {
+	created, err := s.client.SetNX(s.ctx, s.key(id), s.replica, s.ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("cannot store the session: %w", err)
+	}
+	if !created {
+		return nil, errSessionExists
+	}
+
+	c, err := s.local.add(id, capacity)
+	if err != nil {
+		s.client.Del(s.ctx, s.key(id))
+		return nil, err
+	}
+	return c, nil
+}
+
+// delete closes the session and removes its record.
+func (s *redisStorage) delete(id string) {
+	s.local.delete(id)
+	if err := s.client.Del(s.ctx, s.key(id)).Err(); err != nil {
+		s.logger.Errorw("Cannot remove the session", zap.String("session", id), zap.Error(err))
+	}
+}
+
+// respond writes the response to the local session or
+// publishes it to the replica holding the session.
+func (s *redisStorage) respond(id string, event *cloudevents.Event) error {
+	if err := s.local.respond(id, event); err != errSessionNotFound {
+		return err
+	}
+
+	replica, err := s.client.Get(s.ctx, s.key(id)).Result()
+	switch {
+	case err == redis.Nil:
+		return errSessionNotFound
+	case err != nil:
+		return fmt.Errorf("cannot read the session: %w", err)
+	case replica == s.replica:
+		// the record outlived the local session
+		return errSessionClosed
+	}
+
+	msg, err := json.Marshal(sessionResponse{ID: id, Event: event})
+	if err != nil {
+		return fmt.Errorf("cannot encode the response: %w", err)
+	}
+
+	receivers, err := s.client.Publish(s.ctx, s.channel(replica), msg).Result()
+	if err != nil {
+		return fmt.Errorf("cannot publish the response: %w", err)
+	}
+	if receivers == 0 {
+		// the replica is gone
+		return errSessionClosed
+	}
+	return nil
+}
+
+// open registers the asynchronous session.
+func (s *redisStorage) open(id, callback, token string, ttl time.Duration) error {
+	created, err := openScript.Run(s.ctx, s.client, []string{s.asyncKey(id)}, callback, token, ttl.Milliseconds()).Int()
+	if err != nil {
+		return fmt.Errorf("cannot store the session: %w", err)
+	}
+	if created == 0 {
+		return errSessionExists
+	}
+	return nil
+}
+
+// complete stores the response of the asynchronous session.
+func (s *redisStorage) complete(id string, event *cloudevents.Event) (string, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("cannot encode the response: %w", err)
+	}
+
+	res, err := completeScript.Run(s.ctx, s.client, []string{s.asyncKey(id)}, data).Slice()
+	if err != nil {
+		return "", fmt.Errorf("cannot store the response: %w", err)
+	}
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected script result: %v", res)
+	}
+
+	switch code, _ := res[0].(int64); code {
+	case 0:
+		return "", errSessionNotFound
+	case 1:
+		return "", errSessionClosed
+	}
+	callback, _ := res[1].(string)
+	return callback, nil
+}
+
+// result returns the response of the asynchronous session.
+func (s *redisStorage) result(id, token string) (*cloudevents.Event, error) {
+	session, err := s.client.HGetAll(s.ctx, s.asyncKey(id)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the session: %w", err)
+	}
+	if len(session) == 0 || !tokenMatches(session[asyncFieldToken], token) {
+		return nil, errSessionNotFound
+	}
+
+	data, exists := session[asyncFieldResponse]
+	if !exists {
+		return nil, nil
+	}
+
+	event := &cloudevents.Event{}
+	if err := json.Unmarshal([]byte(data), event); err != nil {
+		return nil, fmt.Errorf("cannot decode the response: %w", err)
+	}
+	return event, nil
+}
+
+// receive writes the responses published by other replicas
+// to the local sessions until the context is cancelled.
+func (s *redisStorage) receive(pubsub *redis.PubSub) {
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var resp sessionResponse
+			if err := json.Unmarshal([]byte(msg.Payload), &resp); err != nil {
+				s.logger.Errorw("Cannot decode the response", zap.Error(err))
+				continue
+			}
+			if err := s.local.respond(resp.ID, resp.Event); err != nil {
+				s.logger.Errorw("Unable to forward the response", zap.String("session", resp.ID), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *redisStorage) key(id string) string {
+	return s.prefix + ":sessions:" + id
+}
+
+func (s *redisStorage) asyncKey(id string) string {
+	return s.prefix + ":async:" + id
+}
+
+func (s *redisStorage) channel(replica string) string {
+	return s.prefix + ":replicas:" + replica
+}
@@ -17,35 +17,85 @@ limitations under the License.
 package synchronizer
 
 import (
-	"fmt"
+	"context"
+	"crypto/subtle"
+	"errors"
 	"sync"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 )
 
+var (
+	// errSessionNotFound is returned when the response does not match any session.
+	errSessionNotFound = errors.New("client session does not exist")
+	// errSessionClosed is returned when the session client is not waiting for the response.
+	errSessionClosed = errors.New("client connection is closed")
+	// errSessionExists is returned when the session with the same ID is already open.
+	errSessionExists = errors.New("session already exists")
+)
+
+// sessionStorage keeps the client sessions waiting for the responses.
+type sessionStorage interface {
+	// add registers the session held by this replica and returns the
+	// channel that receives up to capacity of the session responses.
+	add(id string, capacity int) (<-chan *cloudevents.Event, error)
+	// delete closes the communication channel and removes the session.
+	delete(id string)
+	// respond hands the response over to the session,
+	// which may be held by another replica.
+	respond(id string, event *cloudevents.Event) error
+
+	// open registers the asynchronous session which response is
+	// sent to the optional callback URL and kept during the ttl period.
+	// The token authorizes the reads of the session result.
+	open(id, callback, token string, ttl time.Duration) error
+	// complete stores the response of the asynchronous session
+	// and returns the session callback URL.
+	complete(id string, event *cloudevents.Event) (string, error)
+	// result returns the response of the asynchronous session,
+	// nil if the response has not been received yet. Sessions which
+	// token does not match are reported as not found.
+	result(id, token string) (*cloudevents.Event, error)
+}
+
+var _ sessionStorage = (*storage)(nil)
+
 // storage holds the map of open connections and corresponding channels.
 type storage struct {
 	sync.Mutex
 	sessions map[string]chan *cloudevents.Event
+	async    map[string]*asyncSession
+}
+
+// asyncSession is the state of the asynchronous request.
+type asyncSession struct {
+	callback string
+	token    string
+	response *cloudevents.Event
+	expires  time.Time
 }
 
 // newStorage returns an instance of the sessions storage.
 func newStorage() *storage {
 	return &storage{
 		sessions: make(map[string]chan *cloudevents.Event),
+		async:    make(map[string]*asyncSession),
 	}
 }
 
 // add creates the new communication channel and adds it to the session storage.
-func (s *storage) add(id string) (<-chan *cloudevents.Event, error) {
+func (s *storage) add(id string, capacity int) (<-chan *cloudevents.Event, error) {
 	s.Lock()
 	defer s.Unlock()
 
 	if _, exists := s.sessions[id]; exists {
-		return nil, fmt.Errorf("session already exists")
+		return nil, errSessionExists
 	}
 
-	c := make(chan *cloudevents.Event)
+	// buffered channel accepts the responses arriving
+	// before the client starts waiting for them
+	c := make(chan *cloudevents.Event, capacity)
 	s.sessions[id] = c
 	return c, nil
 }
@@ -55,15 +105,116 @@ func (s *storage) delete(id string) {
 	s.Lock()
 	defer s.Unlock()
 
-	close(s.sessions[id])
-	delete(s.sessions, id)
+	if session, exists := s.sessions[id]; exists {
+		close(session)
+		delete(s.sessions, id)
+	}
 }
 
-// open returns the communication channel for the session id.
-func (s *storage) get(id string) (chan<- *cloudevents.Event, bool) {
+// respond writes the response to the session communication channel
+// if the client is waiting for it.
+func (s *storage) respond(id string, event *cloudevents.Event) error {
 	s.Lock()
 	defer s.Unlock()
 
 	session, exists := s.sessions[id]
-	return session, exists
+	if !exists {
+		return errSessionNotFound
+	}
+
+	select {
+	case session <- event:
+		return nil
+	default:
+		return errSessionClosed
+	}
+}
+
+// open registers the asynchronous session.
+func (s *storage) open(id, callback, token string, ttl time.Duration) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if session := s.lookupAsync(id); session != nil {
+		return errSessionExists
+	}
+
+	s.async[id] = &asyncSession{
+		callback: callback,
+		token:    token,
+		expires:  time.Now().Add(ttl),
+	}
+	return nil
+}
+
+// complete stores the response of the asynchronous session.
+func (s *storage) complete(id string, event *cloudevents.Event) (string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	session := s.lookupAsync(id)
+	if session == nil {
+		return "", errSessionNotFound
+	}
+	if session.response != nil {
+		return "", errSessionClosed
+	}
+
+	session.response = event
+	return session.callback, nil
+}
+
+// result returns the response of the asynchronous session.
+func (s *storage) result(id, token string) (*cloudevents.Event, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	session := s.lookupAsync(id)
+	if session == nil || !tokenMatches(session.token, token) {
+		return nil, errSessionNotFound
+	}
+	return session.response, nil
+}
+
+// sweep removes the expired asynchronous sessions.
+func (s *storage) sweep(now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+
+	for k, session := range s.async {
+		if now.After(session.expires) {
+			delete(s.async, k)
+		}
+	}
+}
+
+// runSweep periodically removes the expired asynchronous sessions
+// until the context is cancelled.
+func (s *storage) runSweep(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.sweep(now)
+		}
+	}
+}
+
+// lookupAsync returns unexpired asynchronous session. Must be called under lock.
+func (s *storage) lookupAsync(id string) *asyncSession {
+	session, exists := s.async[id]
+	if !exists || time.Now().After(session.expires) {
+		return nil
+	}
+	return session
+}
+
+// tokenMatches compares the session token with the supplied one in
+// constant time.
+func tokenMatches(expected, actual string) bool {
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) == 1
 }
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageRespond(t *testing.T) {
+	s := newStorage()
+
+	response := cloudevents.NewEvent()
+	response.SetID("response")
+
+	assert.Equal(t, errSessionNotFound, s.respond("foo", &response))
+
+	session, err := s.add("foo", 1)
+	assert.NoError(t, err)
+	_, err = s.add("foo", 1)
+	assert.Error(t, err)
+
+	assert.NoError(t, s.respond("foo", &response))
+	// the session accepts a single response
+	assert.Equal(t, errSessionClosed, s.respond("foo", &response))
+	assert.Equal(t, "response", (<-session).ID())
+
+	s.delete("foo")
+	assert.Equal(t, errSessionNotFound, s.respond("foo", &response))
+	_, open := <-session
+	assert.False(t, open)
+}
+
+func TestStorageAsync(t *testing.T) {
+	s := newStorage()
+
+	response := cloudevents.NewEvent()
+	response.SetID("response")
+
+	_, err := s.complete("foo", &response)
+	assert.Equal(t, errSessionNotFound, err)
+
+	assert.NoError(t, s.open("foo", "http://callback", "secret", time.Minute))
+	assert.Error(t, s.open("foo", "", "", time.Minute))
+
+	_, err = s.result("foo", "guess")
+	assert.Equal(t, errSessionNotFound, err)
+
+	result, err := s.result("foo", "secret")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	callback, err := s.complete("foo", &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://callback", callback)
+	_, err = s.complete("foo", &response)
+	assert.Equal(t, errSessionClosed, err)
+
+	result, err = s.result("foo", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "response", result.ID())
+
+	// expired sessions are not available
+	assert.NoError(t, s.open("bar", "", "", time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	_, err = s.result("bar", "")
+	assert.Equal(t, errSessionNotFound, err)
+
+	// expired sessions are swept
+	s.sweep(time.Now())
+	assert.Len(t, s.async, 1)
+	s.sweep(time.Now().Add(2 * time.Minute))
+	assert.Empty(t, s.async)
+}
@@ -18,12 +18,14 @@ package transformation
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
@@ -44,6 +46,23 @@ type envConfig struct {
 	// Transformation specifications
 	TransformationContext string `envconfig:"TRANSFORMATION_CONTEXT"`
 	TransformationData    string `envconfig:"TRANSFORMATION_DATA"`
+
+	// Pipeline variables storage limits
+	StorageMaxEvents int           `envconfig:"STORAGE_MAX_EVENTS"`
+	StorageTTL       time.Duration `envconfig:"STORAGE_TTL"`
+
+	// Redis compatible server to keep Pipeline variables in
+	RedisAddress    string `envconfig:"REDIS_ADDRESS"`
+	RedisUsername   string `envconfig:"REDIS_USERNAME"`
+	RedisPassword   string `envconfig:"REDIS_PASSWORD"`
+	RedisDatabase   int    `envconfig:"REDIS_DATABASE"`
+	RedisTLSEnabled bool   `envconfig:"REDIS_TLS_ENABLED"`
+
+	// Directory with the mounted lookup tables
+	LookupTablesDir string `envconfig:"LOOKUP_TABLES_DIR"`
+	// Maximum number of values per scope of the state kept in memory,
+	// such as counters or lookup table entries
+	StateMaxValues int `envconfig:"STATE_MAX_VALUES" default:"100000"`
 }
 
 // adapter contains Pipelines for CE transformations and CloudEvents client.
@@ -56,6 +75,11 @@ type adapter struct {
 
 	sink string
 
+	// state contains the values shared between events
+	// and the lookup tables loaded from lookupTablesDir.
+	state           storage.Storage
+	lookupTablesDir string
+
 	client cloudevents.Client
 	logger *zap.SugaredLogger
 }
@@ -94,7 +118,10 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Fatalf("Cannot unmarshal data transformation env variable: %v", err)
 	}
 
-	sharedStorage := storage.New()
+	sharedStorage, err := newStorage(ctx, env, logger)
+	if err != nil {
+		logger.Fatalf("Cannot create variables storage: %v", err)
+	}
 
 	contextPl, err := newPipeline(trnContext, sharedStorage)
 	if err != nil {
@@ -106,6 +133,18 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Fatalf("Cannot create data transformation pipeline: %v", err)
 	}
 
+	state, err := newState(ctx, env, logger)
+	if err != nil {
+		logger.Fatalf("Cannot create state storage: %v", err)
+	}
+	if env.LookupTablesDir != "" {
+		if err := loadLookupTables(env.LookupTablesDir, state); err != nil {
+			logger.Fatalf("Cannot load lookup tables: %v", err)
+		}
+	}
+	contextPl.setState(state)
+	dataPl.setState(state)
+
 	return &adapter{
 		ContextPipeline: contextPl,
 		DataPipeline:    dataPl,
@@ -113,12 +152,55 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		mt: mt,
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 
-		sink:   env.Sink,
+		sink: env.Sink,
+
+		state:           state,
+		lookupTablesDir: env.LookupTablesDir,
+
 		client: ceClient,
 		logger: logger,
 	}
 }
 
+// newStorage returns Redis backed Storage if the server address is set,
+// otherwise the in-memory Storage is used.
+func newStorage(ctx context.Context, env *envConfig, logger *zap.SugaredLogger) (storage.Storage, error) {
+	if env.RedisAddress == "" {
+		return storage.NewMemory(env.StorageMaxEvents, env.StorageTTL), nil
+	}
+
+	return storage.NewRedis(ctx, redisOptions(env), redisPrefix(env, "variables"), env.StorageTTL, logger)
+}
+
+// newState returns the Storage for the values shared between events,
+// such as counters and lookup tables. Unlike Pipeline variables,
+// state does not expire.
+func newState(ctx context.Context, env *envConfig, logger *zap.SugaredLogger) (storage.Storage, error) {
+	if env.RedisAddress == "" {
+		return storage.NewState(env.StateMaxValues), nil
+	}
+	return storage.NewRedis(ctx, redisOptions(env), redisPrefix(env, "state"), 0, logger)
+}
+
+func redisOptions(env *envConfig) *redis.Options {
+	opts := &redis.Options{
+		Addr:     env.RedisAddress,
+		Username: env.RedisUsername,
+		Password: env.RedisPassword,
+		DB:       env.RedisDatabase,
+	}
+	if env.RedisTLSEnabled {
+		opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return opts
+}
+
+// redisPrefix returns the prefix of the Redis keys that belong to
+// the given scope of this Transformation.
+func redisPrefix(env *envConfig, scope string) string {
+	return strings.Join([]string{flow.TransformationResource.String(), env.GetNamespace(), env.GetName(), scope}, ":")
+}
+
 // Start runs CloudEvent receiver and applies transformation Pipeline
 // on incoming events.
 func (t *adapter) Start(ctx context.Context) error {
@@ -133,9 +215,31 @@ func (t *adapter) Start(ctx context.Context) error {
 
 	ctx = pkgadapter.ContextWithMetricTag(ctx, t.mt)
 
+	if t.lookupTablesDir != "" {
+		go t.reloadLookupTables(ctx)
+	}
+
 	return t.client.StartReceiver(ctx, receiver)
 }
 
+// reloadLookupTables periodically reads the lookup tables to pick up
+// the changes of the mounted ConfigMaps.
+func (t *adapter) reloadLookupTables(ctx context.Context) {
+	ticker := time.NewTicker(lookupTablesReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := loadLookupTables(t.lookupTablesDir, t.state); err != nil {
+				t.logger.Errorw("Cannot reload lookup tables", zap.Error(err))
+			}
+		}
+	}
+}
+
 func (t *adapter) receiveAndReply(event cloudevents.Event) (*cloudevents.Event, error) {
 	ceTypeTag := metrics.TagEventType(event.Type())
 	ceSrcTag := metrics.TagEventSource(event.Source())
@@ -210,18 +314,29 @@ func (t *adapter) applyTransformations(event cloudevents.Event) (*cloudevents.Ev
 	// since the storage is shared, flush can be done for one pipeline.
 	defer t.ContextPipeline.Storage.Flush(eventUniqueID)
 
+	// Conditions are evaluated against the incoming event
+	// before any transformation is applied
+	contextMatch, err := t.ContextPipeline.match(&event, localContextBytes)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	dataMatch, err := t.DataPipeline.match(&event, localContextBytes)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
 	// Run init step such as load Pipeline variables first
-	eventContext, err := t.ContextPipeline.apply(eventUniqueID, localContextBytes, init)
+	eventContext, err := t.ContextPipeline.apply(eventUniqueID, localContextBytes, init, contextMatch)
 	if err != nil {
 		errs = append(errs, err)
 	}
-	eventPayload, err := t.DataPipeline.apply(eventUniqueID, event.Data(), init)
+	eventPayload, err := t.DataPipeline.apply(eventUniqueID, event.Data(), init, dataMatch)
 	if err != nil {
 		errs = append(errs, err)
 	}
 
 	// CE Context transformation
-	if eventContext, err = t.ContextPipeline.apply(eventUniqueID, eventContext, !init); err != nil {
+	if eventContext, err = t.ContextPipeline.apply(eventUniqueID, eventContext, !init, contextMatch); err != nil {
 		errs = append(errs, err)
 	}
 
@@ -239,7 +354,7 @@ func (t *adapter) applyTransformations(event cloudevents.Event) (*cloudevents.Ev
 	}
 
 	// CE Data transformation
-	if eventPayload, err = t.DataPipeline.apply(eventUniqueID, eventPayload, !init); err != nil {
+	if eventPayload, err = t.DataPipeline.apply(eventUniqueID, eventPayload, !init, dataMatch); err != nil {
 		errs = append(errs, err)
 	}
 	if err = event.SetData(cloudevents.ApplicationJSON, eventPayload); err != nil {
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -31,6 +33,7 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/lookup"
 )
 
 var availableTransformations = []v1alpha1.Transform{
@@ -460,6 +463,238 @@ func TestReceiveAndTransform(t *testing.T) {
 					},
 				},
 			},
+		}, {
+			name: "Conditional operations",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"kind":"user","name":"John"}`)),
+			expectedEventData: `{"kind":"user","name":"John","source":"test-user"}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "add",
+					Condition: `$context.type.(string) == "test" && $kind.(string) == "user"`,
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "source",
+							Value: "test-user",
+						},
+					},
+				}, {
+					Operation: "delete",
+					Condition: `$kind.(string) == "order"`,
+					Paths: []v1alpha1.Path{
+						{
+							Key: "name",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Templated add",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"first":"John","last":"Doe"}`)),
+			expectedEventData: `{"first":"John","fullname":"Mr. John Doe (test)","last":"Doe"}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "store",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "$first",
+							Value: "first",
+						}, {
+							Key:   "$last",
+							Value: "last",
+						},
+					},
+				}, {
+					Operation: "add",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "fullname",
+							Value: `Mr. {{ .Var "$first" }} {{ .Var "$last" }} ({{ .Var "$source" }})`,
+						},
+					},
+				},
+			},
+			context: []v1alpha1.Transform{
+				{
+					Operation: "store",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "$source",
+							Value: "source",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Array path patterns",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"items":[{"sku":"a","ssn":"1"},{"sku":"b","ssn":"2"}],"user":{"profile":{"ssn":"3"}}}`)),
+			expectedEventData: `{"items":[{"id":"a"},{"id":"b"}],"skus":["a","b"],"user":{"profile":{}}}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "store",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "$skus",
+							Value: "items[*].sku",
+						},
+					},
+				}, {
+					Operation: "delete",
+					Paths: []v1alpha1.Path{
+						{
+							Key: "..ssn",
+						},
+					},
+				}, {
+					Operation: "shift",
+					Paths: []v1alpha1.Path{
+						{
+							Key: "items[*].sku:items[*].id",
+						},
+					},
+				}, {
+					Operation: "add",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "skus",
+							Value: "$skus",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Shift single match of a path pattern",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"items":[{"sku":"a"}]}`)),
+			expectedEventData: `{"items":[{}],"skus":["a"]}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "shift",
+					Paths: []v1alpha1.Path{
+						{
+							Key: "items[*].sku:skus",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Convert operation",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"amount":"12.5","active":"true","count":3,"created":1650000000}`)),
+			expectedEventData: `{"active":true,"amount":12.5,"count":"3","created":"2022-04-15T05:20:00Z"}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "convert",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "amount",
+							Value: "number",
+						}, {
+							Key:   "active",
+							Value: "boolean",
+						}, {
+							Key:   "count",
+							Value: "string",
+						}, {
+							Key:   "created",
+							Value: "timestamp",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Format operation",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"created":"2022-04-15T05:20:00Z","updated":"2022-04-15T05:20:00Z"}`)),
+			expectedEventData: `{"created":"2022-04-15 07:20:00","updated":1650000000}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "format",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "created",
+							Value: "DateTime;Europe/Berlin",
+						}, {
+							Key:   "updated",
+							Value: "Unix",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Hash and encode operations",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"email":"john@example.com","users":[{"ssn":"123"}],"query":"a b&c","token":"secret"}`)),
+			expectedEventData: `{"email":"62f6d956c6a553410a5571d75aaf18a7ceaf78addce3d9999da2e289164e8598","query":"a+b%26c","token":"c2VjcmV0","users":[{"ssn":"a665a45920422f9d417e4867efdc4fb8a04a1f3fff1fa07e998e86f7f7a27ae3"}]}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "store",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "$key",
+							Value: "token",
+						},
+					},
+				}, {
+					Operation: "hash",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "email",
+							Value: "hmac-sha256:$key",
+						}, {
+							Key:   "users[*].ssn",
+							Value: "sha256",
+						},
+					},
+				}, {
+					Operation: "encode",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "query",
+							Value: "url",
+						}, {
+							Key:   "token",
+							Value: "base64",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Hash operation with a missing HMAC key",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"email":"john@example.com"}`)),
+			expectedEventData: `{"email":"john@example.com"}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "hash",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "email",
+							Value: "hmac-sha256:$key",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Split operation",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"tags":"a,b,c","path":["usr","local","bin"]}`)),
+			expectedEventData: `{"path":"usr/local/bin","tags":["a","b","c"]}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "split",
+					Paths: []v1alpha1.Path{
+						{
+							Key: "tags",
+						}, {
+							Key:   "path",
+							Value: "/",
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -575,6 +810,95 @@ func TestConcurrentStore(t *testing.T) {
 	assert.True(t, (len(a.ContextPipeline.Storage.ListEventIDs()) == 0) && (len(a.DataPipeline.Storage.ListEventIDs()) == 0))
 }
 
+func TestStatefulOperations(t *testing.T) {
+	tablesDir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(tablesDir, "regions"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tablesDir, "regions", "42"), []byte("eu-west\n"), 0o644))
+
+	state := storage.NewState(0)
+	assert.NoError(t, loadLookupTables(tablesDir, state))
+
+	sharedStorage := storage.New()
+	dataPipeline, err := newPipeline([]v1alpha1.Transform{
+		{
+			Operation: "store",
+			Paths: []v1alpha1.Path{
+				{
+					Key:   "$customer",
+					Value: "customer.id",
+				},
+			},
+		}, {
+			Operation: "lookup",
+			Paths: []v1alpha1.Path{
+				{
+					Key:   "region",
+					Value: "regions:$customer",
+				},
+			},
+		}, {
+			Operation: "increment",
+			Paths: []v1alpha1.Path{
+				{
+					Key:   "count",
+					Value: "$customer",
+				},
+			},
+		}, {
+			Operation: "latest",
+			Paths: []v1alpha1.Path{
+				{
+					Key:   "address",
+					Value: "$customer",
+				},
+			},
+		},
+	}, sharedStorage)
+	assert.NoError(t, err)
+	contextPipeline, err := newPipeline([]v1alpha1.Transform{}, sharedStorage)
+	assert.NoError(t, err)
+
+	dataPipeline.setState(state)
+
+	a := &adapter{
+		DataPipeline:    dataPipeline,
+		ContextPipeline: contextPipeline,
+		logger:          logtesting.TestLogger(t),
+	}
+
+	testCases := []struct {
+		eventData         string
+		expectedEventData string
+	}{
+		{
+			eventData:         `{"customer":{"id":42},"address":"Berlin"}`,
+			expectedEventData: `{"address":"Berlin","count":1,"customer":{"id":42},"region":"eu-west"}`,
+		}, {
+			eventData:         `{"customer":{"id":42}}`,
+			expectedEventData: `{"address":"Berlin","count":2,"customer":{"id":42},"region":"eu-west"}`,
+		}, {
+			eventData:         `{"customer":{"id":7}}`,
+			expectedEventData: `{"count":1,"customer":{"id":7}}`,
+		}, {
+			// missing variables are not used as literal keys
+			eventData:         `{"order":{"id":1}}`,
+			expectedEventData: `{"order":{"id":1}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		event := cloudevents.NewEvent(cloudevents.VersionV1)
+		assert.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(tc.eventData)))
+		event.SetID(fmt.Sprint(i))
+		event.SetType("mock-event")
+		event.SetSource("stateful-test")
+
+		result, err := a.applyTransformations(event)
+		assert.NoError(t, err)
+		assert.JSONEq(t, tc.expectedEventData, string(result.Data()))
+	}
+}
+
 func BenchmarkStorage(b *testing.B) {
 	sharedStorage := storage.New()
 
@@ -640,3 +964,25 @@ func BenchmarkStorage(b *testing.B) {
 	}
 	wg.Wait()
 }
+
+func TestReloadLookupTables(t *testing.T) {
+	tablesDir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(tablesDir, "regions"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(tablesDir, "regions", "42"), []byte("eu-west"), 0o644))
+
+	state := storage.NewState(1)
+	assert.NoError(t, loadLookupTables(tablesDir, state))
+	assert.Equal(t, "eu-west", state.Get(lookup.Scope("regions"), "42"))
+
+	// removed entries disappear along with the reload
+	assert.NoError(t, os.Remove(filepath.Join(tablesDir, "regions", "42")))
+	assert.NoError(t, os.WriteFile(filepath.Join(tablesDir, "regions", "7"), []byte("us-east"), 0o644))
+	assert.NoError(t, loadLookupTables(tablesDir, state))
+	assert.Nil(t, state.Get(lookup.Scope("regions"), "42"))
+	assert.Equal(t, "us-east", state.Get(lookup.Scope("regions"), "7"))
+
+	// tables beyond the state capacity are rejected and the previous ones are kept
+	assert.NoError(t, os.WriteFile(filepath.Join(tablesDir, "regions", "8"), []byte("us-west"), 0o644))
+	assert.ErrorIs(t, loadLookupTables(tablesDir, state), storage.ErrCapacity)
+	assert.Equal(t, "us-east", state.Get(lookup.Scope("regions"), "7"))
+}
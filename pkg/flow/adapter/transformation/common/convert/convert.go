@@ -17,8 +17,12 @@ limitations under the License.
 package convert
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // SliceToMap converts string slice into map that can be encoded into JSON.
@@ -118,3 +122,87 @@ func MergeJSONWithMap(source, appendix interface{}) interface{} {
 	}
 	return source
 }
+
+// timeLayouts is the list of formats that are tried when string is converted into time.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.ANSIC,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// ToString converts JSON value into a string. Objects and arrays are encoded as JSON.
+func ToString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case nil:
+		return "", nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ToNumber converts JSON value into a number.
+func ToNumber(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("cannot convert %T to number", value)
+}
+
+// ToBool converts JSON value into a boolean.
+func ToBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(strings.TrimSpace(v))
+	case float64:
+		return v != 0, nil
+	}
+	return false, fmt.Errorf("cannot convert %T to boolean", value)
+}
+
+// ToTime converts JSON value into time. Numbers are treated as Unix
+// timestamps in seconds, strings are parsed using the common layouts.
+func ToTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case float64:
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*1e9)).UTC(), nil
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, nil
+			}
+		}
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return ToTime(n)
+		}
+		return time.Time{}, fmt.Errorf("unknown time format %q", v)
+	}
+	return time.Time{}, fmt.Errorf("cannot convert %T to time", value)
+}
@@ -18,8 +18,10 @@ package convert
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -120,3 +122,48 @@ func TestMergeJSONWithMap(t *testing.T) {
 		})
 	}
 }
+
+func TestToTime(t *testing.T) {
+	expected := time.Date(2022, time.April, 15, 5, 20, 0, 0, time.UTC)
+
+	testCases := []interface{}{
+		float64(1650000000),
+		"1650000000",
+		"2022-04-15T05:20:00Z",
+		"2022-04-15T07:20:00+02:00",
+		"Fri, 15 Apr 2022 05:20:00 UTC",
+		"2022-04-15 05:20:00",
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc), func(t *testing.T) {
+			result, err := ToTime(tc)
+			assert.NoError(t, err)
+			assert.True(t, expected.Equal(result), "expected %s, got %s", expected, result)
+		})
+	}
+
+	_, err := ToTime("yesterday")
+	assert.Error(t, err)
+}
+
+func TestToScalar(t *testing.T) {
+	str, err := ToString(map[string]interface{}{"foo": 1.5})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"foo":1.5}`, str)
+
+	num, err := ToNumber(" 42 ")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(42), num)
+
+	_, err = ToNumber([]interface{}{})
+	assert.Error(t, err)
+
+	b, err := ToBool("TRUE")
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	b, err = ToBool(float64(0))
+	assert.NoError(t, err)
+	assert.False(t, b)
+}
@@ -0,0 +1,429 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jsonpath implements path expressions that can address multiple
+// values of the JSON document at once. In addition to the plain object keys
+// and array indexes, path segments support the following syntax:
+//
+//	items[*]     every element of the "items" array
+//	*            every value of the object
+//	items[1:3]   elements of the "items" array in the range [1, 3)
+//	items[-1]    the last element of the "items" array
+//	a..b         the "b" key at any depth below "a" (empty segment)
+//
+// Paths without these expressions are handled by the convert package.
+package jsonpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type segmentKind int
+
+const (
+	keySegment segmentKind = iota
+	indexSegment
+	rangeSegment
+	wildcardSegment
+	descentSegment
+)
+
+// segment is a single step of the Path.
+type segment struct {
+	kind segmentKind
+
+	key   string
+	index int
+	// range boundaries, nil means the beginning or
+	// the end of the array respectively.
+	from, to *int
+}
+
+// Path is a parsed path expression.
+type Path struct {
+	segments []segment
+}
+
+// Action is called for every value matched by the Path. Exists is set to
+// false when the last path key is missing in the matched object.
+// Action returns the new value and whether it should be kept in the document.
+type Action func(value interface{}, exists bool) (interface{}, bool)
+
+// IsPattern returns true if the path expression contains wildcards, index
+// ranges or recursive descent and cannot be resolved as a single location.
+func IsPattern(path, separator string) bool {
+	// separators only path is the root of the document
+	if strings.Trim(path, separator) == "" {
+		return false
+	}
+	if strings.Contains(path, separator+separator) {
+		return true
+	}
+	for _, s := range strings.Split(path, separator) {
+		if s == "*" {
+			return true
+		}
+		for _, b := range brackets(s) {
+			if b == "*" || strings.Contains(b, ":") {
+				return true
+			}
+			if i, err := strconv.Atoi(b); err == nil && i < 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Parse splits the path expression by separator and parses its segments.
+func Parse(path, separator string) Path {
+	var p Path
+	parts := strings.Split(path, separator)
+	for i, s := range parts {
+		if s == "" {
+			// leading and trailing separators are ignored unless
+			// they are doubled, e.g. "..key" or "key..".
+			if !isDescent(parts, i) {
+				continue
+			}
+			if n := len(p.segments); n == 0 || p.segments[n-1].kind != descentSegment {
+				p.segments = append(p.segments, segment{kind: descentSegment})
+			}
+			continue
+		}
+		p.segments = append(p.segments, parseSegment(s)...)
+	}
+	return p
+}
+
+// isDescent checks if the empty path part at the index
+// stands for the recursive descent.
+func isDescent(parts []string, i int) bool {
+	last := len(parts) - 1
+	switch i {
+	case 0:
+		return last > 0 && parts[1] == ""
+	case last:
+		return parts[last-1] == ""
+	}
+	return true
+}
+
+// Parent returns the path without its last segment and the last segment key.
+// Ok is false if the last segment is not an object key.
+func (p Path) Parent() (Path, string, bool) {
+	n := len(p.segments)
+	if n == 0 || p.segments[n-1].kind != keySegment {
+		return p, "", false
+	}
+	return Path{segments: p.segments[:n-1]}, p.segments[n-1].key, true
+}
+
+// Equal returns true if both paths consist of the same segments.
+func (p Path) Equal(o Path) bool {
+	if len(p.segments) != len(o.segments) {
+		return false
+	}
+	for i, s := range p.segments {
+		if !s.equal(o.segments[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns the list of values matched by the Path.
+func (p Path) Get(document interface{}) []interface{} {
+	result := []interface{}{}
+	p.Apply(document, false, func(value interface{}, exists bool) (interface{}, bool) {
+		if exists {
+			result = append(result, value)
+		}
+		return value, exists
+	})
+	return result
+}
+
+// Update replaces every existing value matched by the Path with the result
+// of the function. Values that failed to update remain unchanged.
+func (p Path) Update(document interface{}, fn func(interface{}) (interface{}, error)) (interface{}, error) {
+	var errs []string
+	result := p.Apply(document, false, func(value interface{}, exists bool) (interface{}, bool) {
+		if !exists {
+			return value, false
+		}
+		v, err := fn(value)
+		if err != nil {
+			errs = append(errs, err.Error())
+			return value, true
+		}
+		return v, true
+	})
+	if len(errs) != 0 {
+		return result, fmt.Errorf(strings.Join(errs, ","))
+	}
+	return result, nil
+}
+
+// Apply walks the document and calls the action for every location matched
+// by the Path. If create is true, missing object keys are added to the document.
+// Apply returns the updated document.
+func (p Path) Apply(document interface{}, create bool, action Action) interface{} {
+	if len(p.segments) == 0 {
+		if v, keep := action(document, true); keep {
+			return v
+		}
+		return nil
+	}
+	return apply(document, p.segments, create, action)
+}
+
+func apply(node interface{}, segments []segment, create bool, action Action) interface{} {
+	seg, rest := segments[0], segments[1:]
+
+	switch seg.kind {
+	case descentSegment:
+		// children are processed first so that the values
+		// produced by the action are not walked again.
+		switch n := node.(type) {
+		case map[string]interface{}:
+			for k, v := range n {
+				n[k] = apply(v, segments, create, action)
+			}
+		case []interface{}:
+			for i, v := range n {
+				n[i] = apply(v, segments, create, action)
+			}
+		}
+		// trailing descent matches every node of the document.
+		if len(rest) == 0 {
+			if v, keep := action(node, true); keep {
+				return v
+			}
+			return nil
+		}
+		// descent does not create the key that follows it, only
+		// the objects that already have this key are matched.
+		if rest[0].kind == keySegment {
+			m, ok := node.(map[string]interface{})
+			if !ok {
+				return node
+			}
+			if _, exists := m[rest[0].key]; !exists {
+				return node
+			}
+		}
+		return apply(node, rest, create, action)
+
+	case keySegment:
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			if node != nil || !create {
+				return node
+			}
+			m = make(map[string]interface{})
+		}
+		child, exists := m[seg.key]
+		if len(rest) == 0 {
+			v, keep := action(child, exists)
+			switch {
+			case !keep:
+				delete(m, seg.key)
+			case exists || create:
+				m[seg.key] = v
+			}
+			return m
+		}
+		if !exists && !create {
+			return m
+		}
+		m[seg.key] = apply(child, rest, create, action)
+		return m
+
+	case wildcardSegment:
+		if m, ok := node.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(m))
+			for k := range m {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				if len(rest) != 0 {
+					m[k] = apply(m[k], rest, create, action)
+					continue
+				}
+				if v, keep := action(m[k], true); keep {
+					m[k] = v
+				} else {
+					delete(m, k)
+				}
+			}
+			return m
+		}
+	}
+
+	arr, ok := node.([]interface{})
+	if !ok {
+		return node
+	}
+
+	result := make([]interface{}, 0, len(arr))
+	for i, v := range arr {
+		if !seg.matchIndex(i, len(arr)) {
+			result = append(result, v)
+			continue
+		}
+		if len(rest) != 0 {
+			result = append(result, apply(v, rest, create, action))
+			continue
+		}
+		if v, keep := action(v, true); keep {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func (s segment) matchIndex(i, length int) bool {
+	switch s.kind {
+	case wildcardSegment:
+		return true
+	case indexSegment:
+		return i == normalize(s.index, length)
+	case rangeSegment:
+		from, to := 0, length
+		if s.from != nil {
+			from = normalize(*s.from, length)
+		}
+		if s.to != nil {
+			to = normalize(*s.to, length)
+		}
+		return i >= from && i < to
+	}
+	return false
+}
+
+func (s segment) equal(o segment) bool {
+	if s.kind != o.kind || s.key != o.key || s.index != o.index {
+		return false
+	}
+	return equalBoundary(s.from, o.from) && equalBoundary(s.to, o.to)
+}
+
+func equalBoundary(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// normalize converts negative index into the offset from the array end.
+func normalize(index, length int) int {
+	if index < 0 {
+		return length + index
+	}
+	return index
+}
+
+// parseSegment parses a path segment with optional brackets suffix,
+// e.g. "items[*]" or "matrix[0][1:]". Segments with malformed
+// brackets are considered to be plain object keys.
+func parseSegment(s string) []segment {
+	if s == "*" {
+		return []segment{{kind: wildcardSegment}}
+	}
+
+	i := strings.Index(s, "[")
+	if i == -1 {
+		return []segment{{kind: keySegment, key: s}}
+	}
+
+	var segments []segment
+	if i > 0 {
+		segments = append(segments, segment{kind: keySegment, key: s[:i]})
+	}
+
+	b := brackets(s)
+	if b == nil {
+		return []segment{{kind: keySegment, key: s}}
+	}
+	for _, content := range b {
+		seg, ok := parseBrackets(content)
+		if !ok {
+			return []segment{{kind: keySegment, key: s}}
+		}
+		segments = append(segments, seg)
+	}
+	return segments
+}
+
+func parseBrackets(content string) (segment, bool) {
+	if content == "*" {
+		return segment{kind: wildcardSegment}, true
+	}
+
+	if !strings.Contains(content, ":") {
+		index, err := strconv.Atoi(content)
+		if err != nil {
+			return segment{}, false
+		}
+		return segment{kind: indexSegment, index: index}, true
+	}
+
+	boundaries := strings.SplitN(content, ":", 2)
+	seg := segment{kind: rangeSegment}
+	for i, b := range boundaries {
+		if b == "" {
+			continue
+		}
+		v, err := strconv.Atoi(b)
+		if err != nil {
+			return segment{}, false
+		}
+		if i == 0 {
+			seg.from = &v
+		} else {
+			seg.to = &v
+		}
+	}
+	return seg, true
+}
+
+// brackets returns the list of square brackets contents
+// that follow the segment key or nil if the suffix is malformed.
+func brackets(s string) []string {
+	i := strings.Index(s, "[")
+	if i == -1 {
+		return nil
+	}
+
+	var result []string
+	for s = s[i:]; s != ""; {
+		if s[0] != '[' {
+			return nil
+		}
+		end := strings.Index(s, "]")
+		if end == -1 {
+			return nil
+		}
+		result = append(result, s[1:end])
+		s = s[end+1:]
+	}
+	return result
+}
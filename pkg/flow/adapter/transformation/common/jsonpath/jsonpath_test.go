@@ -0,0 +1,143 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const document = `{"items":[{"id":1,"sku":"a"},{"id":2,"sku":"b"},{"id":3,"sku":"c"}],"meta":{"owner":{"id":"x"}}}`
+
+func TestIsPattern(t *testing.T) {
+	testCases := map[string]bool{
+		"":               false,
+		"foo.bar":        false,
+		"foo[0].bar":     false,
+		"foo.[1]":        false,
+		"foo[*].bar":     true,
+		"foo[1:].bar":    true,
+		"foo[-1]":        true,
+		"foo.*.bar":      true,
+		"foo..bar":       true,
+		"foo[bar].baz":   false,
+		"foo.bar[*]baz":  false,
+		"foo.bar[:2][0]": true,
+	}
+
+	for path, pattern := range testCases {
+		t.Run(path, func(t *testing.T) {
+			assert.Equal(t, pattern, IsPattern(path, "."))
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	testCases := map[string]string{
+		"items[*].id":   `[1,2,3]`,
+		"items[1:].sku": `["b","c"]`,
+		"items[:-1].id": `[1,2]`,
+		"items[-1].sku": `["c"]`,
+		"..id":          `[1,2,3,"x"]`,
+		"meta.*.id":     `["x"]`,
+		"items[5].id":   `[]`,
+		"missing[*]":    `[]`,
+	}
+
+	for path, expected := range testCases {
+		t.Run(path, func(t *testing.T) {
+			var doc interface{}
+			assert.NoError(t, json.Unmarshal([]byte(document), &doc))
+
+			result, err := json.Marshal(Parse(path, ".").Get(doc))
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, unmarshal(t, expected), unmarshal(t, string(result)))
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	testCases := map[string]struct {
+		path     string
+		create   bool
+		action   Action
+		expected string
+	}{
+		"delete every element key": {
+			path: "items[*].sku",
+			action: func(interface{}, bool) (interface{}, bool) {
+				return nil, false
+			},
+			expected: `{"items":[{"id":1},{"id":2},{"id":3}],"meta":{"owner":{"id":"x"}}}`,
+		},
+		"delete array range": {
+			path: "items[0:2]",
+			action: func(interface{}, bool) (interface{}, bool) {
+				return nil, false
+			},
+			expected: `{"items":[{"id":3,"sku":"c"}],"meta":{"owner":{"id":"x"}}}`,
+		},
+		"delete at any depth": {
+			path: "..id",
+			action: func(interface{}, bool) (interface{}, bool) {
+				return nil, false
+			},
+			expected: `{"items":[{"sku":"a"},{"sku":"b"},{"sku":"c"}],"meta":{"owner":{}}}`,
+		},
+		"add to every element": {
+			path:   "items[*].flag",
+			create: true,
+			action: func(interface{}, bool) (interface{}, bool) {
+				return true, true
+			},
+			expected: `{"items":[{"flag":true,"id":1,"sku":"a"},{"flag":true,"id":2,"sku":"b"},{"flag":true,"id":3,"sku":"c"}],"meta":{"owner":{"id":"x"}}}`,
+		},
+		"update last element": {
+			path: "items[-1].id",
+			action: func(interface{}, bool) (interface{}, bool) {
+				return 0, true
+			},
+			expected: `{"items":[{"id":1,"sku":"a"},{"id":2,"sku":"b"},{"id":0,"sku":"c"}],"meta":{"owner":{"id":"x"}}}`,
+		},
+		"missing keys are not created": {
+			path: "items[*].flag",
+			action: func(interface{}, bool) (interface{}, bool) {
+				return true, true
+			},
+			expected: document,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var doc interface{}
+			assert.NoError(t, json.Unmarshal([]byte(document), &doc))
+
+			result, err := json.Marshal(Parse(tc.path, ".").Apply(doc, tc.create, tc.action))
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(result))
+		})
+	}
+}
+
+func unmarshal(t *testing.T, data string) []interface{} {
+	var result []interface{}
+	assert.NoError(t, json.Unmarshal([]byte(data), &result))
+	return result
+}
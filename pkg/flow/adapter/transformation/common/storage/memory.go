@@ -0,0 +1,256 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+var _ Storage = (*Memory)(nil)
+
+// Memory is a simple object that provides thread safe
+// methods to read and write into a map. The number of stored
+// events and their lifetime can be limited. Events are kept until
+// they are flushed or expired, writes of new events that exceed the
+// size limit fail with ErrCapacity.
+type Memory struct {
+	data map[string]*list.Element
+	// order keeps the events sorted by their creation time,
+	// the oldest events expire first.
+	order *list.List
+	mux   sync.RWMutex
+
+	size int
+	ttl  time.Duration
+	// maxVariables limits the number of variables of each event.
+	maxVariables int
+}
+
+// entry contains the variables of a single event.
+type entry struct {
+	eventID   string
+	variables map[string]interface{}
+	expires   time.Time
+}
+
+// New returns an instance of Storage without size and time limits.
+func New() *Memory {
+	return NewMemory(0, 0)
+}
+
+// NewMemory returns an instance of in-memory Storage that keeps
+// the variables of at most size events during the ttl period.
+// Zero values disable the corresponding limit.
+func NewMemory(size int, ttl time.Duration) *Memory {
+	return &Memory{
+		data:  make(map[string]*list.Element),
+		order: list.New(),
+		mux:   sync.RWMutex{},
+
+		size: size,
+		ttl:  ttl,
+	}
+}
+
+// NewState returns an instance of in-memory Storage for the values shared
+// between events, such as counters and lookup tables, that keeps at most
+// maxVariables values per scope. Values do not expire.
+func NewState(maxVariables int) *Memory {
+	s := NewMemory(0, 0)
+	s.maxVariables = maxVariables
+	return s
+}
+
+// Set writes a value interface to a string key.
+func (s *Memory) Set(eventID, key string, value interface{}) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ent, err := s.writableEntry(eventID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkVariables(ent, key); err != nil {
+		return err
+	}
+	ent.variables[key] = value
+	return nil
+}
+
+// Increment atomically adds delta to the numeric value of the key
+// and returns the result.
+func (s *Memory) Increment(eventID, key string, delta float64) (float64, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ent, err := s.writableEntry(eventID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.checkVariables(ent, key); err != nil {
+		return 0, err
+	}
+	value, _ := ent.variables[key].(float64)
+	value += delta
+	ent.variables[key] = value
+	return value, nil
+}
+
+// Get reads value by a key.
+func (s *Memory) Get(eventID string, key string) interface{} {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	ent := s.lookup(eventID)
+	if ent == nil {
+		return nil
+	}
+	return ent.variables[key]
+}
+
+// ListEventVariables returns the slice of variables created for EventID.
+func (s *Memory) ListEventVariables(eventID string) []string {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	variables := []string{}
+	ent := s.lookup(eventID)
+	if ent == nil {
+		return variables
+	}
+	for k := range ent.variables {
+		variables = append(variables, k)
+	}
+	return variables
+}
+
+// ListEventIDs returns the list of stored event IDs.
+func (s *Memory) ListEventIDs() []string {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	ids := []string{}
+	now := time.Now()
+	for k, e := range s.data {
+		if !s.expired(e.Value.(*entry), now) {
+			ids = append(ids, k)
+		}
+	}
+	return ids
+}
+
+// Flush removes variables by their parent event ID.
+func (s *Memory) Flush(eventID string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if e, exists := s.data[eventID]; exists {
+		s.remove(e)
+	}
+}
+
+// Replace atomically replaces all the variables of the event ID.
+func (s *Memory) Replace(eventID string, variables map[string]interface{}) error {
+	if s.maxVariables > 0 && len(variables) > s.maxVariables {
+		return ErrCapacity
+	}
+
+	vars := make(map[string]interface{}, len(variables))
+	for k, v := range variables {
+		vars[k] = v
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	ent, err := s.writableEntry(eventID)
+	if err != nil {
+		return err
+	}
+	ent.variables = vars
+	return nil
+}
+
+// checkVariables returns ErrCapacity if the new key exceeds the maximum
+// number of variables of the entry. Must be called under write lock.
+func (s *Memory) checkVariables(ent *entry, key string) error {
+	if s.maxVariables == 0 {
+		return nil
+	}
+	if _, exists := ent.variables[key]; !exists && len(ent.variables) >= s.maxVariables {
+		return ErrCapacity
+	}
+	return nil
+}
+
+// writableEntry returns the event entry creating it if necessary and
+// evicts the expired entries. Entries in use are never evicted, ErrCapacity
+// is returned instead when the size limit is reached. Must be called under
+// write lock.
+func (s *Memory) writableEntry(eventID string) (*entry, error) {
+	now := time.Now()
+	s.evictExpired(now)
+
+	e, exists := s.data[eventID]
+	if exists && !s.expired(e.Value.(*entry), now) {
+		return e.Value.(*entry), nil
+	}
+	if exists {
+		s.remove(e)
+	}
+
+	if s.size > 0 && s.order.Len() >= s.size {
+		return nil, ErrCapacity
+	}
+
+	ent := &entry{
+		eventID:   eventID,
+		variables: make(map[string]interface{}),
+	}
+	if s.ttl > 0 {
+		ent.expires = now.Add(s.ttl)
+	}
+	s.data[eventID] = s.order.PushBack(ent)
+	return ent, nil
+}
+
+// lookup returns unexpired event entry. Must be called under lock.
+func (s *Memory) lookup(eventID string) *entry {
+	e, exists := s.data[eventID]
+	if !exists {
+		return nil
+	}
+	ent := e.Value.(*entry)
+	if s.expired(ent, time.Now()) {
+		return nil
+	}
+	return ent
+}
+
+// evictExpired removes the expired events. Must be called under write lock.
+func (s *Memory) evictExpired(now time.Time) {
+	if s.ttl == 0 {
+		return
+	}
+	for e := s.order.Front(); e != nil && s.expired(e.Value.(*entry), now); e = s.order.Front() {
+		s.remove(e)
+	}
+}
+
+func (s *Memory) expired(ent *entry, now time.Time) bool {
+	return !ent.expires.IsZero() && now.After(ent.expires)
+}
+
+func (s *Memory) remove(e *list.Element) {
+	s.order.Remove(e)
+	delete(s.data, e.Value.(*entry).eventID)
+}
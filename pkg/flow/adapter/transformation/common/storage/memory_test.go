@@ -0,0 +1,108 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemorySizeLimit(t *testing.T) {
+	s := NewMemory(2, 0)
+
+	require.NoError(t, s.Set("event-1", "$foo", "bar"))
+	require.NoError(t, s.Set("event-2", "$foo", "baz"))
+	require.NoError(t, s.Set("event-1", "$bar", "foo"))
+
+	// events in use are not evicted
+	assert.ErrorIs(t, s.Set("event-3", "$foo", "qux"), ErrCapacity)
+	_, err := s.Increment("event-3", "$foo", 1)
+	assert.ErrorIs(t, err, ErrCapacity)
+
+	assert.ElementsMatch(t, []string{"event-1", "event-2"}, s.ListEventIDs())
+	assert.Equal(t, "bar", s.Get("event-1", "$foo"))
+	assert.Equal(t, "foo", s.Get("event-1", "$bar"))
+	assert.Equal(t, "baz", s.Get("event-2", "$foo"))
+	assert.Nil(t, s.Get("event-3", "$foo"))
+
+	s.Flush("event-2")
+	assert.ElementsMatch(t, []string{"event-1"}, s.ListEventIDs())
+	assert.Empty(t, s.ListEventVariables("event-2"))
+
+	require.NoError(t, s.Set("event-3", "$foo", "qux"))
+	assert.Equal(t, "qux", s.Get("event-3", "$foo"))
+}
+
+func TestMemoryTTL(t *testing.T) {
+	s := NewMemory(0, 10*time.Millisecond)
+
+	require.NoError(t, s.Set("event-1", "$foo", "bar"))
+	assert.Equal(t, "bar", s.Get("event-1", "$foo"))
+	assert.Equal(t, []string{"$foo"}, s.ListEventVariables("event-1"))
+
+	time.Sleep(20 * time.Millisecond)
+
+	assert.Nil(t, s.Get("event-1", "$foo"))
+	assert.Empty(t, s.ListEventIDs())
+
+	// expired event is recreated on write
+	require.NoError(t, s.Set("event-1", "$bar", "baz"))
+	assert.Nil(t, s.Get("event-1", "$foo"))
+	assert.Equal(t, "baz", s.Get("event-1", "$bar"))
+}
+
+func TestMemoryIncrement(t *testing.T) {
+	s := New()
+
+	increment := func(key string, delta float64) float64 {
+		value, err := s.Increment("counters", key, delta)
+		require.NoError(t, err)
+		return value
+	}
+
+	assert.Equal(t, 1.0, increment("foo", 1))
+	assert.Equal(t, 3.5, increment("foo", 2.5))
+	assert.Equal(t, 1.0, increment("bar", 1))
+
+	// non-numeric values are replaced
+	require.NoError(t, s.Set("counters", "baz", "qux"))
+	assert.Equal(t, 1.0, increment("baz", 1))
+	assert.Equal(t, 3.5, s.Get("counters", "foo"))
+}
+
+func TestMemoryReplace(t *testing.T) {
+	s := NewState(2)
+
+	require.NoError(t, s.Set("table", "foo", "bar"))
+	require.NoError(t, s.Replace("table", map[string]interface{}{"baz": "qux", "quux": "corge"}))
+	assert.Nil(t, s.Get("table", "foo"))
+	assert.Equal(t, "qux", s.Get("table", "baz"))
+
+	// the number of values per scope is limited
+	assert.ErrorIs(t, s.Set("table", "grault", "garply"), ErrCapacity)
+	_, err := s.Increment("table", "grault", 1)
+	assert.ErrorIs(t, err, ErrCapacity)
+	assert.ErrorIs(t, s.Replace("table", map[string]interface{}{"a": 1, "b": 2, "c": 3}), ErrCapacity)
+	assert.ElementsMatch(t, []string{"baz", "quux"}, s.ListEventVariables("table"))
+
+	// existing values can be updated
+	require.NoError(t, s.Set("table", "baz", "waldo"))
+	assert.Equal(t, "waldo", s.Get("table", "baz"))
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+var _ Storage = (*Redis)(nil)
+
+// redisScanCount is a hint for the number of keys returned by a single SCAN call.
+const redisScanCount = 100
+
+// Redis is the Storage backed by a Redis compatible server. Variables of
+// each event are kept in a hash with an optional expiration time,
+// values are encoded as JSON.
+type Redis struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+
+	ctx    context.Context
+	logger *zap.SugaredLogger
+}
+
+// NewRedis returns an instance of Storage that keeps variables in the Redis
+// hashes with the given key prefix. Write failures are returned, read
+// failures are logged and treated as missing values.
+func NewRedis(ctx context.Context, opts *redis.Options, prefix string, ttl time.Duration, logger *zap.SugaredLogger) (*Redis, error) {
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("cannot connect to Redis: %w", err)
+	}
+
+	return &Redis{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+
+		ctx:    ctx,
+		logger: logger,
+	}, nil
+}
+
+// Set writes a value interface to a string key.
+func (s *Redis) Set(eventID, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("cannot encode %q variable value: %w", key, err)
+	}
+
+	_, err = s.client.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(s.ctx, s.key(eventID), key, data)
+		if s.ttl > 0 {
+			pipe.Expire(s.ctx, s.key(eventID), s.ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("cannot store %q variable: %w", key, err)
+	}
+	return nil
+}
+
+// Increment atomically adds delta to the numeric value of the key
+// and returns the result.
+func (s *Redis) Increment(eventID, key string, delta float64) (float64, error) {
+	var cmd *redis.FloatCmd
+	_, err := s.client.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+		cmd = pipe.HIncrByFloat(s.ctx, s.key(eventID), key, delta)
+		if s.ttl > 0 {
+			pipe.Expire(s.ctx, s.key(eventID), s.ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("cannot increment %q variable: %w", key, err)
+	}
+	return cmd.Val(), nil
+}
+
+// Get reads value by a key.
+func (s *Redis) Get(eventID string, key string) interface{} {
+	data, err := s.client.HGet(s.ctx, s.key(eventID), key).Bytes()
+	switch {
+	case err == redis.Nil:
+		return nil
+	case err != nil:
+		s.logger.Errorw("Cannot read variable", zap.String("variable", key), zap.Error(err))
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		s.logger.Errorw("Cannot decode variable value", zap.String("variable", key), zap.Error(err))
+		return nil
+	}
+	return value
+}
+
+// ListEventVariables returns the slice of variables created for EventID.
+func (s *Redis) ListEventVariables(eventID string) []string {
+	variables, err := s.client.HKeys(s.ctx, s.key(eventID)).Result()
+	if err != nil {
+		s.logger.Errorw("Cannot list event variables", zap.Error(err))
+		return []string{}
+	}
+	return variables
+}
+
+// ListEventIDs returns the list of stored event IDs.
+func (s *Redis) ListEventIDs() []string {
+	ids := []string{}
+	iter := s.client.Scan(s.ctx, 0, s.key("*"), redisScanCount).Iterator()
+	for iter.Next(s.ctx) {
+		ids = append(ids, strings.TrimPrefix(iter.Val(), s.key("")))
+	}
+	if err := iter.Err(); err != nil {
+		s.logger.Errorw("Cannot list stored events", zap.Error(err))
+	}
+	return ids
+}
+
+// Flush removes variables by their parent event ID.
+func (s *Redis) Flush(eventID string) {
+	if err := s.client.Del(s.ctx, s.key(eventID)).Err(); err != nil {
+		s.logger.Errorw("Cannot remove event variables", zap.Error(err))
+	}
+}
+
+// Replace atomically replaces all the variables of the event ID.
+func (s *Redis) Replace(eventID string, variables map[string]interface{}) error {
+	fields := make([]interface{}, 0, 2*len(variables))
+	for k, v := range variables {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("cannot encode %q variable value: %w", k, err)
+		}
+		fields = append(fields, k, data)
+	}
+
+	// the transaction is executed atomically, readers
+	// never observe the removed hash
+	_, err := s.client.TxPipelined(s.ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(s.ctx, s.key(eventID))
+		if len(fields) != 0 {
+			pipe.HSet(s.ctx, s.key(eventID), fields...)
+			if s.ttl > 0 {
+				pipe.Expire(s.ctx, s.key(eventID), s.ttl)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("cannot replace the variables: %w", err)
+	}
+	return nil
+}
+
+// Close closes the connection to the server.
+func (s *Redis) Close() error {
+	return s.client.Close()
+}
+
+func (s *Redis) key(eventID string) string {
+	return s.prefix + ":" + eventID
+}
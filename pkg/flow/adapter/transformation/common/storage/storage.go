@@ -16,70 +16,28 @@ limitations under the License.
 
 package storage
 
-import (
-	"sync"
-)
-
-// Storage is a simple object that provides thread safe
-// methods to read and write into a map.
-type Storage struct {
-	data map[string]map[string]interface{}
-	mux  sync.RWMutex
-}
-
-// New returns an instance of Storage.
-func New() *Storage {
-	return &Storage{
-		data: make(map[string]map[string]interface{}),
-		mux:  sync.RWMutex{},
-	}
-}
-
-// Set writes a value interface to a string key.
-func (s *Storage) Set(eventID, key string, value interface{}) {
-	s.mux.Lock()
-	defer s.mux.Unlock()
-	if s.data[eventID] == nil {
-		s.data[eventID] = make(map[string]interface{})
-	}
-	s.data[eventID][key] = value
-}
-
-// Get reads value by a key.
-func (s *Storage) Get(eventID string, key string) interface{} {
-	s.mux.RLock()
-	defer s.mux.RUnlock()
-	if s.data[eventID] == nil {
-		return nil
-	}
-	return s.data[eventID][key]
-}
-
-// ListEventVariables returns the slice of variables created for EventID.
-func (s *Storage) ListEventVariables(eventID string) []string {
-	s.mux.RLock()
-	defer s.mux.RUnlock()
-	list := []string{}
-	for k := range s.data[eventID] {
-		list = append(list, k)
-	}
-	return list
-}
-
-// ListEventIDs returns the list of stored event IDs.
-func (s *Storage) ListEventIDs() []string {
-	s.mux.RLock()
-	defer s.mux.RUnlock()
-	list := []string{}
-	for k := range s.data {
-		list = append(list, k)
-	}
-	return list
-}
-
-// Flush removes variables by their parent event ID.
-func (s *Storage) Flush(eventID string) {
-	s.mux.Lock()
-	defer s.mux.Unlock()
-	delete(s.data, eventID)
+import "errors"
+
+// ErrCapacity is returned when a write would store more events, or more
+// variables per event, than the storage is allowed to hold.
+var ErrCapacity = errors.New("storage capacity exceeded")
+
+// Storage keeps the Pipeline variables grouped by the event ID.
+type Storage interface {
+	// Set writes a value interface to a string key.
+	Set(eventID, key string, value interface{}) error
+	// Increment atomically adds delta to the numeric value of the key
+	// and returns the result. Missing and non-numeric values count as zero.
+	Increment(eventID, key string, delta float64) (float64, error)
+	// Get reads value by a key.
+	Get(eventID, key string) interface{}
+	// ListEventVariables returns the slice of variables created for EventID.
+	ListEventVariables(eventID string) []string
+	// ListEventIDs returns the list of stored event IDs.
+	ListEventIDs() []string
+	// Flush removes variables by their parent event ID.
+	Flush(eventID string)
+	// Replace atomically replaces all the variables of the event ID,
+	// readers observe either the previous or the new variables.
+	Replace(eventID string, variables map[string]interface{}) error
 }
@@ -16,6 +16,16 @@ limitations under the License.
 
 package common
 
+import "strings"
+
+// VariablePrefix starts the names of the Pipeline variables.
+const VariablePrefix = "$"
+
+// IsVariable returns whether the operation value is a Pipeline variable name.
+func IsVariable(value string) bool {
+	return strings.HasPrefix(value, VariablePrefix)
+}
+
 // ReadValue returns the source object item located at the requested path.
 func ReadValue(source interface{}, path map[string]interface{}) interface{} {
 	var result interface{}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformation
+
+import (
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/tidwall/gjson"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// contextPathPrefix is used in condition variables to read
+// values from the CE Context instead of CE Data.
+const contextPathPrefix = "context."
+
+// condition is a precompiled guard expression of the Transformation step.
+type condition struct {
+	filter cel.ConditionalFilter
+}
+
+// newCondition compiles CEL expression using the same variables syntax
+// as the Filter router, e.g. '$context.type.(string) == "foo.bar"'.
+func newCondition(expression string) (*condition, error) {
+	filter, err := cel.CompileExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	return &condition{filter: filter}, nil
+}
+
+// eval executes condition expression against the event. Variables with
+// the "context." prefix are read from the encoded CE Context, others from the CE Data.
+func (c *condition) eval(event *cloudevents.Event, eventContext []byte) (bool, error) {
+	return c.filter.EvaluateEvent(event, func(path string) gjson.Result {
+		if strings.HasPrefix(path, contextPathPrefix) {
+			return gjson.GetBytes(eventContext, strings.TrimPrefix(path, contextPathPrefix))
+		}
+		return gjson.GetBytes(event.Data(), path)
+	})
+}
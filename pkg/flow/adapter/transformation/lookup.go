@@ -0,0 +1,78 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transformation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/lookup"
+)
+
+// lookupTablesReloadInterval is the period of reading
+// the lookup tables from the mounted ConfigMaps.
+const lookupTablesReloadInterval = time.Minute
+
+// loadLookupTables reads the lookup tables from the directory that contains
+// a subdirectory per table, e.g. a mounted ConfigMap, where every file
+// is a table entry, and writes the entries into the state storage.
+func loadLookupTables(dir string, state storage.Storage) error {
+	tables, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("cannot read lookup tables directory: %w", err)
+	}
+
+	for _, table := range tables {
+		if !table.IsDir() || hidden(table.Name()) {
+			continue
+		}
+
+		entries, err := os.ReadDir(filepath.Join(dir, table.Name()))
+		if err != nil {
+			return fmt.Errorf("cannot read %q lookup table: %w", table.Name(), err)
+		}
+
+		// the table is swapped at once so that the lookups
+		// never observe a partially loaded table
+		values := make(map[string]interface{}, len(entries))
+		for _, entry := range entries {
+			// ConfigMap volumes keep their data in the hidden
+			// directories, entries are symlinks to these files.
+			if entry.IsDir() || hidden(entry.Name()) {
+				continue
+			}
+			value, err := os.ReadFile(filepath.Join(dir, table.Name(), entry.Name()))
+			if err != nil {
+				return fmt.Errorf("cannot read %q entry of %q lookup table: %w", entry.Name(), table.Name(), err)
+			}
+			values[entry.Name()] = strings.TrimSpace(string(value))
+		}
+
+		if err := state.Replace(lookup.Scope(table.Name()), values); err != nil {
+			return fmt.Errorf("cannot store %q lookup table: %w", table.Name(), err)
+		}
+	}
+	return nil
+}
+
+func hidden(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
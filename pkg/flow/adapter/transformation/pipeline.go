@@ -20,13 +20,23 @@ import (
 	"fmt"
 	"strings"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
 	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/add"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/convert"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/delete"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/encode"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/format"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/hash"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/increment"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/latest"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/lookup"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/parse"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/shift"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/split"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/store"
 )
 
@@ -38,7 +48,11 @@ const (
 // sequentially applied to JSON data.
 type Pipeline struct {
 	Transformers []transformer.Transformer
-	Storage      *storage.Storage
+	Storage      storage.Storage
+
+	// conditions contains optional guard expressions
+	// of the Transformers with the same index.
+	conditions []*condition
 }
 
 // register loads available Transformation into a named map.
@@ -50,20 +64,38 @@ func register() map[string]transformer.Transformer {
 	shift.Register(transformations)
 	store.Register(transformations)
 	parse.Register(transformations)
+	convert.Register(transformations)
+	format.Register(transformations)
+	hash.Register(transformations)
+	encode.Register(transformations)
+	split.Register(transformations)
+	lookup.Register(transformations)
+	increment.Register(transformations)
+	latest.Register(transformations)
 
 	return transformations
 }
 
 // newPipeline loads available Transformations and creates a Pipeline.
-func newPipeline(transformations []v1alpha1.Transform, storage *storage.Storage) (*Pipeline, error) {
+func newPipeline(transformations []v1alpha1.Transform, storage storage.Storage) (*Pipeline, error) {
 	availableTransformers := register()
 	pipeline := []transformer.Transformer{}
+	conditions := []*condition{}
 
 	for _, transformation := range transformations {
 		operation, exist := availableTransformers[transformation.Operation]
 		if !exist {
 			return nil, fmt.Errorf("transformation %q not found", transformation.Operation)
 		}
+
+		var cond *condition
+		if transformation.Condition != "" {
+			var err error
+			if cond, err = newCondition(transformation.Condition); err != nil {
+				return nil, fmt.Errorf("cannot compile %q transformation condition: %w", transformation.Operation, err)
+			}
+		}
+
 		for _, kv := range transformation.Paths {
 			separator := defaultEventPathSeparator
 			if kv.Separator != "" {
@@ -72,20 +104,66 @@ func newPipeline(transformations []v1alpha1.Transform, storage *storage.Storage)
 			transformer := operation.New(kv.Key, kv.Value, separator)
 			transformer.SetStorage(storage)
 			pipeline = append(pipeline, transformer)
+			conditions = append(conditions, cond)
 		}
 	}
 
 	return &Pipeline{
 		Transformers: pipeline,
 		Storage:      storage,
+
+		conditions: conditions,
 	}, nil
 }
 
+// setState sets the Storage with the values shared between events
+// to the stateful Transformers.
+func (p *Pipeline) setState(state storage.Storage) {
+	for _, t := range p.Transformers {
+		if st, ok := t.(transformer.Stateful); ok {
+			st.SetState(state)
+		}
+	}
+}
+
+// match evaluates Pipeline conditions against the event and returns the
+// list of flags that indicate whether Transformers must be applied.
+func (p *Pipeline) match(event *cloudevents.Event, eventContext []byte) ([]bool, error) {
+	var errs []string
+	matched := make([]bool, len(p.Transformers))
+	results := make(map[*condition]bool)
+
+	for i := range p.Transformers {
+		if i >= len(p.conditions) || p.conditions[i] == nil {
+			matched[i] = true
+			continue
+		}
+		cond := p.conditions[i]
+		result, evaluated := results[cond]
+		if !evaluated {
+			var err error
+			if result, err = cond.eval(event, eventContext); err != nil {
+				errs = append(errs, fmt.Sprintf("condition evaluation failed: %v", err))
+			}
+			results[cond] = result
+		}
+		matched[i] = result
+	}
+
+	if len(errs) != 0 {
+		return matched, fmt.Errorf(strings.Join(errs, ","))
+	}
+	return matched, nil
+}
+
 // Apply applies Pipeline transformations.
-func (p *Pipeline) apply(eventID string, data []byte, init bool) ([]byte, error) {
+func (p *Pipeline) apply(eventID string, data []byte, init bool, matched []bool) ([]byte, error) {
 	var err error
 	var errs []string
-	for _, v := range p.Transformers {
+	for i, v := range p.Transformers {
+		if !matched[i] {
+			continue
+		}
 		if init == v.InitStep() {
 			if data, err = v.Apply(eventID, data); err != nil {
 				errs = append(errs, err.Error())
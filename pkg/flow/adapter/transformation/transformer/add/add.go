@@ -17,11 +17,14 @@ limitations under the License.
 package add
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"text/template"
 
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
 )
@@ -34,7 +37,25 @@ type Add struct {
 	Value     string
 	Separator string
 
-	variables *storage.Storage
+	template    *template.Template
+	templateErr error
+	variables   storage.Storage
+}
+
+// templateData is passed to the value template to
+// expose Pipeline variables of the current event.
+type templateData struct {
+	eventID   string
+	variables storage.Storage
+}
+
+// Var returns the value of the Pipeline variable or an empty
+// string if the variable is not defined.
+func (d templateData) Var(key string) interface{} {
+	if value := d.variables.Get(d.eventID, key); value != nil {
+		return value
+	}
+	return ""
 }
 
 // InitStep is used to figure out if this operation should
@@ -52,7 +73,7 @@ func Register(m map[string]transformer.Transformer) {
 }
 
 // SetStorage sets a shared Storage with Pipeline variables.
-func (a *Add) SetStorage(storage *storage.Storage) {
+func (a *Add) SetStorage(storage storage.Storage) {
 	a.variables = storage
 }
 
@@ -63,26 +84,45 @@ func (a *Add) InitStep() bool {
 }
 
 // New returns a new instance of Add object.
+// Values containing "{{" are parsed as Go templates, e.g.
+// '{{ .Var "$first" }} {{ .Var "$last" }}'.
 func (a *Add) New(key, value, separator string) transformer.Transformer {
-	return &Add{
+	add := &Add{
 		Path:      key,
 		Value:     value,
 		Separator: separator,
 
 		variables: a.variables,
 	}
+	if strings.Contains(value, "{{") {
+		add.template, add.templateErr = template.New(key).Parse(value)
+	}
+	return add
 }
 
 // Apply is a main method of Transformation that adds any type of
 // variables into existing JSON.
 func (a *Add) Apply(eventID string, data []byte) ([]byte, error) {
-	input := convert.SliceToMap(strings.Split(a.Path, a.Separator), a.composeValue(eventID))
+	value, err := a.value(eventID)
+	if err != nil {
+		return data, err
+	}
+
 	var event interface{}
 	if err := json.Unmarshal(data, &event); err != nil {
 		return data, err
 	}
 
-	result := convert.MergeJSONWithMap(event, input)
+	var result interface{}
+	if jsonpath.IsPattern(a.Path, a.Separator) {
+		result = jsonpath.Parse(a.Path, a.Separator).Apply(event, true, func(interface{}, bool) (interface{}, bool) {
+			return value, true
+		})
+	} else {
+		input := convert.SliceToMap(strings.Split(a.Path, a.Separator), value)
+		result = convert.MergeJSONWithMap(event, input)
+	}
+
 	output, err := json.Marshal(result)
 	if err != nil {
 		return data, err
@@ -91,6 +131,21 @@ func (a *Add) Apply(eventID string, data []byte) ([]byte, error) {
 	return output, nil
 }
 
+func (a *Add) value(eventID string) (interface{}, error) {
+	if a.templateErr != nil {
+		return nil, fmt.Errorf("cannot parse value template: %w", a.templateErr)
+	}
+	if a.template == nil {
+		return a.composeValue(eventID), nil
+	}
+
+	var buf bytes.Buffer
+	if err := a.template.Execute(&buf, templateData{eventID: eventID, variables: a.variables}); err != nil {
+		return nil, fmt.Errorf("cannot execute value template: %w", err)
+	}
+	return buf.String(), nil
+}
+
 func (a *Add) retrieveVariable(eventID, key string) interface{} {
 	if value := a.variables.Get(eventID, key); value != nil {
 		return value
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Convert)(nil)
+
+// Convert object implements Transformer interface.
+type Convert struct {
+	Path      string
+	Value     string
+	Separator string
+
+	variables storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "convert"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Convert{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (c *Convert) SetStorage(storage storage.Storage) {
+	c.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (c *Convert) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Convert object.
+func (c *Convert) New(key, value, separator string) transformer.Transformer {
+	return &Convert{
+		Path:      key,
+		Value:     value,
+		Separator: separator,
+
+		variables: c.variables,
+	}
+}
+
+// Apply is a main method of Transformation that converts JSON values
+// into the requested type.
+func (c *Convert) Apply(eventID string, data []byte) ([]byte, error) {
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	result, err := jsonpath.Parse(c.Path, c.Separator).Update(event, c.convert)
+	if err != nil {
+		return data, err
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+// convert casts the value to one of the supported types: "string",
+// "number", "boolean" or "timestamp" (RFC3339 formatted string).
+func (c *Convert) convert(value interface{}) (interface{}, error) {
+	switch strings.ToLower(c.Value) {
+	case "string":
+		return convert.ToString(value)
+	case "number":
+		return convert.ToNumber(value)
+	case "boolean", "bool":
+		return convert.ToBool(value)
+	case "timestamp":
+		t, err := convert.ToTime(value)
+		if err != nil {
+			return nil, err
+		}
+		return t.Format(time.RFC3339Nano), nil
+	}
+	return nil, fmt.Errorf("convert operation does not support %q type", c.Value)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
 )
@@ -34,7 +35,7 @@ type Delete struct {
 	Type      string
 	Separator string
 
-	variables *storage.Storage
+	variables storage.Storage
 }
 
 // InitStep is used to figure out if this operation should
@@ -52,7 +53,7 @@ func Register(m map[string]transformer.Transformer) {
 }
 
 // SetStorage sets a shared Storage with Pipeline variables.
-func (d *Delete) SetStorage(storage *storage.Storage) {
+func (d *Delete) SetStorage(storage storage.Storage) {
 	d.variables = storage
 }
 
@@ -78,7 +79,13 @@ func (d *Delete) New(key, value, separator string) transformer.Transformer {
 func (d *Delete) Apply(eventID string, data []byte) ([]byte, error) {
 	d.Value = d.retrieveString(eventID, d.Value)
 
-	result, err := d.parse(data, "", "")
+	var result interface{}
+	var err error
+	if jsonpath.IsPattern(d.Path, d.Separator) {
+		result, err = d.deletePattern(data)
+	} else {
+		result, err = d.parse(data, "", "")
+	}
 	if err != nil {
 		return data, err
 	}
@@ -100,6 +107,22 @@ func (d *Delete) retrieveString(eventID, key string) string {
 	return key
 }
 
+// deletePattern removes the values matched by the path pattern.
+// If the Value is set, only the matching values are removed.
+func (d *Delete) deletePattern(data []byte) (interface{}, error) {
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+
+	return jsonpath.Parse(d.Path, d.Separator).Apply(event, false, func(value interface{}, exists bool) (interface{}, bool) {
+		if exists && d.Value != "" && !d.filterValue(value) {
+			return value, true
+		}
+		return nil, false
+	}), nil
+}
+
 func (d *Delete) parse(data interface{}, key, path string) (interface{}, error) {
 	output := make(map[string]interface{})
 	// TODO: keep only one filter call
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encode
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Encode)(nil)
+
+// Encode object implements Transformer interface.
+type Encode struct {
+	Path      string
+	Value     string
+	Separator string
+
+	variables storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "encode"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Encode{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (e *Encode) SetStorage(storage storage.Storage) {
+	e.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (e *Encode) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Encode object.
+func (e *Encode) New(key, value, separator string) transformer.Transformer {
+	return &Encode{
+		Path:      key,
+		Value:     value,
+		Separator: separator,
+
+		variables: e.variables,
+	}
+}
+
+// Apply is a main method of Transformation that encodes JSON values
+// using the "base64", "base64url", "url" or "hex" encoding.
+func (e *Encode) Apply(eventID string, data []byte) ([]byte, error) {
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	result, err := jsonpath.Parse(e.Path, e.Separator).Update(event, e.encode)
+	if err != nil {
+		return data, err
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+func (e *Encode) encode(value interface{}) (interface{}, error) {
+	str, err := convert.ToString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(e.Value) {
+	case "base64":
+		return base64.StdEncoding.EncodeToString([]byte(str)), nil
+	case "base64url":
+		return base64.URLEncoding.EncodeToString([]byte(str)), nil
+	case "url":
+		return url.QueryEscape(str), nil
+	case "hex":
+		return hex.EncodeToString([]byte(str)), nil
+	}
+	return nil, fmt.Errorf("encode operation does not support %q encoding", e.Value)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	// embedded timezone database for the minimal container images
+	_ "time/tzdata"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Format)(nil)
+
+// Format object implements Transformer interface.
+type Format struct {
+	Path      string
+	Value     string
+	Separator string
+
+	variables storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "format"
+
+// timezoneDelimiter separates the layout and the timezone in the operation value.
+const timezoneDelimiter = ";"
+
+// layouts contains the named time formats that can be used
+// instead of Go layout strings.
+var layouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    "2006-01-02 15:04:05",
+	"DateOnly":    "2006-01-02",
+	"TimeOnly":    "15:04:05",
+}
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Format{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (f *Format) SetStorage(storage storage.Storage) {
+	f.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (f *Format) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Format object.
+func (f *Format) New(key, value, separator string) transformer.Transformer {
+	return &Format{
+		Path:      key,
+		Value:     value,
+		Separator: separator,
+
+		variables: f.variables,
+	}
+}
+
+// Apply is a main method of Transformation that reformats date and time
+// values. Value contains the output layout and an optional timezone,
+// e.g. "RFC1123;Europe/Berlin" or "2006-01-02 15:04;UTC".
+func (f *Format) Apply(eventID string, data []byte) ([]byte, error) {
+	layout, location, err := f.parseValue()
+	if err != nil {
+		return data, err
+	}
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	result, err := jsonpath.Parse(f.Path, f.Separator).Update(event, func(value interface{}) (interface{}, error) {
+		t, err := convert.ToTime(value)
+		if err != nil {
+			return nil, err
+		}
+		if location != nil {
+			t = t.In(location)
+		}
+		switch layout {
+		case "Unix":
+			return float64(t.Unix()), nil
+		case "UnixMilli":
+			return float64(t.UnixNano() / int64(time.Millisecond)), nil
+		}
+		return t.Format(layout), nil
+	})
+	if err != nil {
+		return data, err
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+func (f *Format) parseValue() (string, *time.Location, error) {
+	parts := strings.SplitN(f.Value, timezoneDelimiter, 2)
+
+	layout := parts[0]
+	if l, ok := layouts[layout]; ok {
+		layout = l
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	if len(parts) == 1 || parts[1] == "" {
+		return layout, nil, nil
+	}
+
+	location, err := time.LoadLocation(parts[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot load %q timezone: %w", parts[1], err)
+	}
+	return layout, location, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hash
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Hash)(nil)
+
+// Hash object implements Transformer interface.
+type Hash struct {
+	Path      string
+	Value     string
+	Separator string
+
+	variables storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "hash"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Hash{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (h *Hash) SetStorage(storage storage.Storage) {
+	h.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (h *Hash) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Hash object.
+func (h *Hash) New(key, value, separator string) transformer.Transformer {
+	return &Hash{
+		Path:      key,
+		Value:     value,
+		Separator: separator,
+
+		variables: h.variables,
+	}
+}
+
+// Apply is a main method of Transformation that replaces JSON values
+// with their hex encoded digests. Value is the name of the algorithm,
+// e.g. "sha256", or HMAC algorithm with the key, e.g. "hmac-sha256:$secret".
+func (h *Hash) Apply(eventID string, data []byte) ([]byte, error) {
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	result, err := jsonpath.Parse(h.Path, h.Separator).Update(event, func(value interface{}) (interface{}, error) {
+		return h.hash(eventID, value)
+	})
+	if err != nil {
+		return data, err
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+// algorithms contains supported hash functions.
+var algorithms = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// hmacPrefix indicates that the keyed hash must be calculated.
+const hmacPrefix = "hmac-"
+
+func (h *Hash) hash(eventID string, value interface{}) (interface{}, error) {
+	str, err := convert.ToString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	algorithm, key := h.Value, ""
+	if strings.HasPrefix(algorithm, hmacPrefix) {
+		parts := strings.SplitN(strings.TrimPrefix(algorithm, hmacPrefix), ":", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("HMAC key is missing in %q", h.Value)
+		}
+		algorithm = parts[0]
+		if key, err = h.retrieveString(eventID, parts[1]); err != nil {
+			return nil, fmt.Errorf("cannot read the HMAC key: %w", err)
+		}
+	}
+
+	newHash, ok := algorithms[strings.ToLower(algorithm)]
+	if !ok {
+		return nil, fmt.Errorf("hash operation does not support %q algorithm", algorithm)
+	}
+
+	var digest hash.Hash
+	if key != "" {
+		digest = hmac.New(newHash, []byte(key))
+	} else {
+		digest = newHash()
+	}
+	if _, err := digest.Write([]byte(str)); err != nil {
+		return nil, err
+	}
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
+
+// retrieveString returns the value of the Pipeline variable, or the key
+// itself if it is not a variable name. Variables used as HMAC keys must
+// be set to non-empty strings.
+func (h *Hash) retrieveString(eventID, key string) (string, error) {
+	if !common.IsVariable(key) {
+		return key, nil
+	}
+	value := h.variables.Get(eventID, key)
+	if value == nil {
+		return "", fmt.Errorf("variable %q is not set", key)
+	}
+	str, ok := value.(string)
+	if !ok || str == "" {
+		return "", fmt.Errorf("variable %q is not a non-empty string", key)
+	}
+	return str, nil
+}
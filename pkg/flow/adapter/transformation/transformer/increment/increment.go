@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package increment
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var (
+	_ transformer.Transformer = (*Increment)(nil)
+	_ transformer.Stateful    = (*Increment)(nil)
+)
+
+// Increment object implements Transformer interface.
+type Increment struct {
+	Path      string
+	Value     string
+	Separator string
+
+	variables storage.Storage
+	state     storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "increment"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Increment{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (i *Increment) SetStorage(storage storage.Storage) {
+	i.variables = storage
+}
+
+// SetState sets a Storage with the values shared between events.
+func (i *Increment) SetState(state storage.Storage) {
+	i.state = state
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (i *Increment) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Increment object.
+func (i *Increment) New(key, value, separator string) transformer.Transformer {
+	return &Increment{
+		Path:      key,
+		Value:     value,
+		Separator: separator,
+
+		variables: i.variables,
+		state:     i.state,
+	}
+}
+
+// Apply is a main method of Transformation that increments the counter
+// named by Value, which can be a Pipeline variable, e.g. "$deviceID".
+// The updated counter is written to the Path if it is not empty.
+func (i *Increment) Apply(eventID string, data []byte) ([]byte, error) {
+	if i.state == nil {
+		return data, fmt.Errorf("increment operation requires the state storage")
+	}
+
+	key, err := i.retrieveString(eventID, i.Value)
+	if err != nil {
+		return data, fmt.Errorf("cannot read the counter name: %w", err)
+	}
+
+	count, err := i.state.Increment(operationName, key, 1)
+	if err != nil {
+		return data, fmt.Errorf("cannot increment the counter: %w", err)
+	}
+	if i.Path == "" {
+		return data, nil
+	}
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	var result interface{}
+	if jsonpath.IsPattern(i.Path, i.Separator) {
+		result = jsonpath.Parse(i.Path, i.Separator).Apply(event, true, func(interface{}, bool) (interface{}, bool) {
+			return count, true
+		})
+	} else {
+		input := convert.SliceToMap(strings.Split(i.Path, i.Separator), count)
+		result = convert.MergeJSONWithMap(event, input)
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+// retrieveString returns the value of the Pipeline variable, or the key
+// itself if it is not a variable name.
+func (i *Increment) retrieveString(eventID, key string) (string, error) {
+	if value := i.variables.Get(eventID, key); value != nil {
+		return fmt.Sprint(value), nil
+	}
+	if common.IsVariable(key) {
+		return "", fmt.Errorf("variable %q is not set", key)
+	}
+	return key, nil
+}
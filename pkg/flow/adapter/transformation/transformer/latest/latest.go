@@ -0,0 +1,148 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var (
+	_ transformer.Transformer = (*Latest)(nil)
+	_ transformer.Stateful    = (*Latest)(nil)
+)
+
+// Latest object implements Transformer interface.
+type Latest struct {
+	Path      string
+	Value     string
+	Separator string
+
+	variables storage.Storage
+	state     storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "latest"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Latest{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (l *Latest) SetStorage(storage storage.Storage) {
+	l.variables = storage
+}
+
+// SetState sets a Storage with the values shared between events.
+func (l *Latest) SetState(state storage.Storage) {
+	l.state = state
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (l *Latest) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Latest object.
+func (l *Latest) New(key, value, separator string) transformer.Transformer {
+	return &Latest{
+		Path:      key,
+		Value:     value,
+		Separator: separator,
+
+		variables: l.variables,
+		state:     l.state,
+	}
+}
+
+// Apply is a main method of Transformation that keeps the last seen
+// value of the Path under the key from Value, which can be a Pipeline
+// variable, e.g. "$deviceID". Events without the Path are completed
+// with the last seen value.
+func (l *Latest) Apply(eventID string, data []byte) ([]byte, error) {
+	if l.state == nil {
+		return data, fmt.Errorf("latest operation requires the state storage")
+	}
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	key, err := l.retrieveString(eventID, l.Value)
+	if err != nil {
+		return data, fmt.Errorf("cannot read the key of the latest value: %w", err)
+	}
+	path := jsonpath.Parse(l.Path, l.Separator)
+
+	if values := path.Get(event); len(values) != 0 {
+		if err := l.state.Set(operationName, key, values[len(values)-1]); err != nil {
+			return data, fmt.Errorf("cannot store the latest value: %w", err)
+		}
+		return data, nil
+	}
+
+	value := l.state.Get(operationName, key)
+	if value == nil {
+		return data, nil
+	}
+
+	var result interface{}
+	if jsonpath.IsPattern(l.Path, l.Separator) {
+		result = path.Apply(event, true, func(interface{}, bool) (interface{}, bool) {
+			return value, true
+		})
+	} else {
+		input := convert.SliceToMap(strings.Split(l.Path, l.Separator), value)
+		result = convert.MergeJSONWithMap(event, input)
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+// retrieveString returns the value of the Pipeline variable, or the key
+// itself if it is not a variable name.
+func (l *Latest) retrieveString(eventID, key string) (string, error) {
+	if value := l.variables.Get(eventID, key); value != nil {
+		return fmt.Sprint(value), nil
+	}
+	if common.IsVariable(key) {
+		return "", fmt.Errorf("variable %q is not set", key)
+	}
+	return key, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var (
+	_ transformer.Transformer = (*Lookup)(nil)
+	_ transformer.Stateful    = (*Lookup)(nil)
+)
+
+// Lookup object implements Transformer interface.
+type Lookup struct {
+	Path      string
+	Value     string
+	Separator string
+
+	variables storage.Storage
+	state     storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "lookup"
+
+// tableDelimiter separates the table name and the lookup key in the operation value.
+const tableDelimiter = ":"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Lookup{}
+}
+
+// Scope returns the name of the state scope that contains
+// the entries of the lookup table.
+func Scope(table string) string {
+	return operationName + ":" + table
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (l *Lookup) SetStorage(storage storage.Storage) {
+	l.variables = storage
+}
+
+// SetState sets a Storage with the values shared between events.
+func (l *Lookup) SetState(state storage.Storage) {
+	l.state = state
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (l *Lookup) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Lookup object.
+func (l *Lookup) New(key, value, separator string) transformer.Transformer {
+	return &Lookup{
+		Path:      key,
+		Value:     value,
+		Separator: separator,
+
+		variables: l.variables,
+		state:     l.state,
+	}
+}
+
+// Apply is a main method of Transformation that enriches JSON with the
+// entry of the lookup table. Value contains the table name and the entry
+// key which can be a Pipeline variable, e.g. "regions:$customer".
+// Events are not modified if the table has no such entry.
+func (l *Lookup) Apply(eventID string, data []byte) ([]byte, error) {
+	if l.state == nil {
+		return data, fmt.Errorf("lookup operation requires the state storage")
+	}
+
+	parts := strings.SplitN(l.Value, tableDelimiter, 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return data, fmt.Errorf("lookup value %q must be in the \"table:key\" format", l.Value)
+	}
+
+	key, err := l.retrieveString(eventID, parts[1])
+	if err != nil {
+		return data, fmt.Errorf("cannot read the lookup key: %w", err)
+	}
+
+	value := l.state.Get(Scope(parts[0]), key)
+	if value == nil {
+		return data, nil
+	}
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	var result interface{}
+	if jsonpath.IsPattern(l.Path, l.Separator) {
+		result = jsonpath.Parse(l.Path, l.Separator).Apply(event, true, func(interface{}, bool) (interface{}, bool) {
+			return value, true
+		})
+	} else {
+		input := convert.SliceToMap(strings.Split(l.Path, l.Separator), value)
+		result = convert.MergeJSONWithMap(event, input)
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+// retrieveString returns the value of the Pipeline variable, or the key
+// itself if it is not a variable name.
+func (l *Lookup) retrieveString(eventID, key string) (string, error) {
+	if value := l.variables.Get(eventID, key); value != nil {
+		return fmt.Sprint(value), nil
+	}
+	if common.IsVariable(key) {
+		return "", fmt.Errorf("variable %q is not set", key)
+	}
+	return key, nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
 )
@@ -35,7 +36,7 @@ type Parse struct {
 	Value     string
 	Separator string
 
-	variables *storage.Storage
+	variables storage.Storage
 }
 
 // InitStep is used to figure out if this operation should
@@ -53,7 +54,7 @@ func Register(m map[string]transformer.Transformer) {
 }
 
 // SetStorage sets a shared Storage with Pipeline variables.
-func (p *Parse) SetStorage(storage *storage.Storage) {
+func (p *Parse) SetStorage(storage storage.Storage) {
 	p.variables = storage
 }
 
@@ -85,6 +86,9 @@ func (p *Parse) Apply(eventID string, data []byte) ([]byte, error) {
 		if err := json.Unmarshal(data, &event); err != nil {
 			return data, err
 		}
+		if jsonpath.IsPattern(p.Path, p.Separator) {
+			return p.parsePattern(data, event)
+		}
 		jsonValue, err := parseJSON(common.ReadValue(event, path))
 		if err != nil {
 			return data, err
@@ -96,6 +100,15 @@ func (p *Parse) Apply(eventID string, data []byte) ([]byte, error) {
 	}
 }
 
+// parsePattern parses every value matched by the path pattern.
+func (p *Parse) parsePattern(data []byte, event interface{}) ([]byte, error) {
+	result, err := jsonpath.Parse(p.Path, p.Separator).Update(event, parseJSON)
+	if err != nil {
+		return data, err
+	}
+	return json.Marshal(result)
+}
+
 func parseJSON(data interface{}) (interface{}, error) {
 	str, ok := data.(string)
 	if !ok {
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
 )
@@ -34,7 +35,7 @@ type Shift struct {
 	Value     string
 	Separator string
 
-	variables *storage.Storage
+	variables storage.Storage
 }
 
 const delimeter string = ":"
@@ -54,7 +55,7 @@ func Register(m map[string]transformer.Transformer) {
 }
 
 // SetStorage sets a shared Storage with Pipeline variables.
-func (s *Shift) SetStorage(storage *storage.Storage) {
+func (s *Shift) SetStorage(storage storage.Storage) {
 	s.variables = storage
 }
 
@@ -91,6 +92,10 @@ func (s *Shift) Apply(eventID string, data []byte) ([]byte, error) {
 		return data, err
 	}
 
+	if jsonpath.IsPattern(s.Path, s.Separator) || jsonpath.IsPattern(s.NewPath, s.Separator) {
+		return json.Marshal(s.shiftPattern(eventID, event))
+	}
+
 	newEvent, value := extractValue(event, oldPath)
 	if s.Value != "" {
 		if !equal(s.retrieveInterface(eventID, s.Value), value) {
@@ -111,6 +116,63 @@ func (s *Shift) Apply(eventID string, data []byte) ([]byte, error) {
 	return output, nil
 }
 
+// shiftPattern moves the values matched by the path pattern. If both paths
+// have the same parent, keys are renamed inside of every matched object,
+// otherwise matched values are removed and added to the new path as an
+// array.
+func (s *Shift) shiftPattern(eventID string, event interface{}) interface{} {
+	oldPath := jsonpath.Parse(s.Path, s.Separator)
+	newPath := jsonpath.Parse(s.NewPath, s.Separator)
+
+	matches := func(value interface{}) bool {
+		return s.Value == "" || equal(s.retrieveInterface(eventID, s.Value), value)
+	}
+
+	oldParent, oldKey, oldOk := oldPath.Parent()
+	newParent, newKey, newOk := newPath.Parent()
+	if oldOk && newOk && oldParent.Equal(newParent) {
+		return oldParent.Apply(event, false, func(parent interface{}, exists bool) (interface{}, bool) {
+			if m, ok := parent.(map[string]interface{}); ok {
+				if value, ok := m[oldKey]; ok && matches(value) {
+					delete(m, oldKey)
+					m[newKey] = value
+				}
+			}
+			return parent, exists
+		})
+	}
+
+	values := []interface{}{}
+	event = oldPath.Apply(event, false, func(value interface{}, exists bool) (interface{}, bool) {
+		if !exists {
+			return value, false
+		}
+		if !matches(value) {
+			return value, true
+		}
+		values = append(values, value)
+		return nil, false
+	})
+
+	if len(values) == 0 {
+		return event
+	}
+
+	// values matched by a pattern are always moved as an array, regardless
+	// of how many values the pattern matched in this event
+	var value interface{} = values
+	if !jsonpath.IsPattern(s.Path, s.Separator) {
+		value = values[0]
+	}
+
+	if jsonpath.IsPattern(s.NewPath, s.Separator) {
+		return newPath.Apply(event, true, func(interface{}, bool) (interface{}, bool) {
+			return value, true
+		})
+	}
+	return convert.MergeJSONWithMap(event, convert.SliceToMap(strings.Split(s.NewPath, s.Separator), value))
+}
+
 func (s *Shift) retrieveInterface(eventID, key string) interface{} {
 	if value := s.variables.Get(eventID, key); value != nil {
 		return value
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package split
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Split)(nil)
+
+// Split object implements Transformer interface.
+type Split struct {
+	Path      string
+	Value     string
+	Separator string
+
+	variables storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "split"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Split{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (s *Split) SetStorage(storage storage.Storage) {
+	s.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (s *Split) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Split object.
+func (s *Split) New(key, value, separator string) transformer.Transformer {
+	return &Split{
+		Path:      key,
+		Value:     value,
+		Separator: separator,
+
+		variables: s.variables,
+	}
+}
+
+// Apply is a main method of Transformation that splits string values
+// into arrays and joins arrays into strings using Value as a delimiter.
+func (s *Split) Apply(eventID string, data []byte) ([]byte, error) {
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	result, err := jsonpath.Parse(s.Path, s.Separator).Update(event, s.split)
+	if err != nil {
+		return data, err
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+// defaultDelimiter is used if the operation value is empty.
+const defaultDelimiter = ","
+
+func (s *Split) split(value interface{}) (interface{}, error) {
+	delimiter := s.Value
+	if delimiter == "" {
+		delimiter = defaultDelimiter
+	}
+
+	switch v := value.(type) {
+	case string:
+		result := []interface{}{}
+		for _, item := range strings.Split(v, delimiter) {
+			result = append(result, item)
+		}
+		return result, nil
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			str, err := convert.ToString(item)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, str)
+		}
+		return strings.Join(items, delimiter), nil
+	}
+	return value, nil
+}
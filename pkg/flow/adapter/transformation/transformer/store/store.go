@@ -18,10 +18,12 @@ package store
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/jsonpath"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
 )
@@ -34,7 +36,7 @@ type Store struct {
 	Value     string
 	Separator string
 
-	variables *storage.Storage
+	variables storage.Storage
 }
 
 // InitStep is used to figure out if this operation should
@@ -52,7 +54,7 @@ func Register(m map[string]transformer.Transformer) {
 }
 
 // SetStorage sets a shared Storage with Pipeline variables.
-func (s *Store) SetStorage(storage *storage.Storage) {
+func (s *Store) SetStorage(storage storage.Storage) {
 	s.variables = storage
 }
 
@@ -75,17 +77,24 @@ func (s *Store) New(key, value, separator string) transformer.Transformer {
 
 // Apply is a main method of Transformation that stores JSON values
 // into variables that can be used by other Transformations in a pipeline.
+// Path patterns that match multiple values are stored as a list.
 func (s *Store) Apply(eventID string, data []byte) ([]byte, error) {
-	path := convert.SliceToMap(strings.Split(s.Value, s.Separator), "")
-
 	var event interface{}
 	if err := json.Unmarshal(data, &event); err != nil {
 		return data, err
 	}
 
-	value := common.ReadValue(event, path)
+	var value interface{}
+	if jsonpath.IsPattern(s.Value, s.Separator) {
+		value = jsonpath.Parse(s.Value, s.Separator).Get(event)
+	} else {
+		path := convert.SliceToMap(strings.Split(s.Value, s.Separator), "")
+		value = common.ReadValue(event, path)
+	}
 
-	s.variables.Set(eventID, s.Path, value)
+	if err := s.variables.Set(eventID, s.Path, value); err != nil {
+		return data, fmt.Errorf("cannot store %q variable: %w", s.Path, err)
+	}
 
 	return data, nil
 }
@@ -25,6 +25,12 @@ import (
 type Transformer interface {
 	New(key, value, separator string) Transformer
 	Apply(eventID string, data []byte) ([]byte, error)
-	SetStorage(*storage.Storage)
+	SetStorage(storage.Storage)
 	InitStep() bool
 }
+
+// Stateful is implemented by Transformers that keep the values
+// shared between events, e.g. counters and lookup tables.
+type Stateful interface {
+	SetState(storage.Storage)
+}
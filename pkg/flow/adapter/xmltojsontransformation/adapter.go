@@ -21,7 +21,6 @@ import (
 	"context"
 	"encoding/xml"
 	"errors"
-	"io"
 
 	"go.uber.org/zap"
 
@@ -34,6 +33,7 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/apis/flow"
 	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/flow/xmljson"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
@@ -50,6 +50,9 @@ type envAccessor struct {
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 	// CloudEvents responses parametrization
 	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"error"`
+	// JSON encoded conventions used to represent XML documents as JSON.
+	// Documents are converted as in previous versions when not set.
+	Conventions string `envconfig:"XMLJSON_CONVENTIONS"`
 	// Sink defines the target sink for the events. If no Sink is defined the
 	// events are replied back to the sender.
 	Sink string `envconfig:"K_SINK"`
@@ -77,7 +80,18 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
+	var conv converter = legacyConverter{}
+	if env.Conventions != "" {
+		var c xmljson.Conventions
+		if err := c.Decode(env.Conventions); err != nil {
+			logger.Panicf("Error parsing XML/JSON conventions: %v", err)
+		}
+		conv = xmljson.New(c)
+	}
+
 	return &Adapter{
+		converter: conv,
+
 		sink:     env.Sink,
 		replier:  replier,
 		ceClient: ceClient,
@@ -91,6 +105,8 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 var _ pkgadapter.Adapter = (*Adapter)(nil)
 
 type Adapter struct {
+	converter converter
+
 	sink     string
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
@@ -114,24 +130,18 @@ func (a *Adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloud
 			errors.New("invalid XML"), nil)
 	}
 
-	xml := bytes.NewReader(event.Data())
-	jsn, err := xj.Convert(xml)
-	if err != nil {
-		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
-	}
-
-	readBuf, err := io.ReadAll(jsn)
+	jsn, err := a.converter.ToJSON(event.Data())
 	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
-	if err := event.SetData(cloudevents.ApplicationJSON, readBuf); err != nil {
+	if err := event.SetData(cloudevents.ApplicationJSON, jsn); err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
 	if a.sink != "" {
 		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
-			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, nil)
 		}
 		return nil, cloudevents.ResultACK
 	}
@@ -139,6 +149,24 @@ func (a *Adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloud
 	return &event, cloudevents.ResultACK
 }
 
+// converter converts XML documents to JSON.
+type converter interface {
+	ToJSON(data []byte) ([]byte, error)
+}
+
+// legacyConverter converts XML documents to JSON the way this component did
+// before conventions were introduced, which remains the default output.
+type legacyConverter struct{}
+
+// ToJSON implements converter.
+func (legacyConverter) ToJSON(data []byte) ([]byte, error) {
+	jsn, err := xj.Convert(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return jsn.Bytes(), nil
+}
+
 func isValidXML(data []byte) bool {
 	return xml.Unmarshal(data, new(interface{})) == nil
 }
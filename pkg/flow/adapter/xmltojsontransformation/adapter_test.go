@@ -31,6 +31,7 @@ import (
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	logtesting "knative.dev/pkg/logging/testing"
 
+	"github.com/triggermesh/triggermesh/pkg/flow/xmljson"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	metricstesting "github.com/triggermesh/triggermesh/pkg/metrics/testing"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
@@ -44,6 +45,9 @@ const (
 	tXML1        = `<note><to>Tove</to></note>`
 	tJSONOutput1 = `{"note": {"to": "Tove"}}` + "\n"
 
+	tXML2        = `<order id="1"><item sku="a">2</item></order>`
+	tJSONOutput2 = `{"@id": "1", "item": [{"$": "2", "@sku": "a"}]}` + "\n"
+
 	tFalseXML         = `"this is not xml"`
 	tFalseXMLResponse = `{"Code":"request-validation","Description":"invalid XML","Details":null}`
 )
@@ -73,6 +77,8 @@ func TestSink(t *testing.T) {
 			mt := &adapter.MetricTag{}
 
 			a := &Adapter{
+				converter: legacyConverter{},
+
 				sink:     "http://fake",
 				replier:  replier,
 				ceClient: ceClient,
@@ -97,6 +103,7 @@ func TestSink(t *testing.T) {
 
 func TestReplier(t *testing.T) {
 	testCases := map[string]struct {
+		conventions *xmljson.Conventions
 		inEvent     cloudevents.Event
 		expectEvent cloudevents.Event
 	}{
@@ -104,6 +111,16 @@ func TestReplier(t *testing.T) {
 			inEvent:     newCloudEvent(t, tXML1, cloudevents.ApplicationXML),
 			expectEvent: newCloudEvent(t, tJSONOutput1, cloudevents.ApplicationJSON),
 		},
+		"transform with conventions ok": {
+			conventions: &xmljson.Conventions{
+				AttributePrefix: "@",
+				TextKey:         "$",
+				ArrayElements:   []string{"item"},
+				RootElement:     "order",
+			},
+			inEvent:     newCloudEvent(t, tXML2, cloudevents.ApplicationXML),
+			expectEvent: newCloudEvent(t, tJSONOutput2, cloudevents.ApplicationJSON),
+		},
 		"transform error": {
 			inEvent:     newCloudEvent(t, tFalseXML, cloudevents.ApplicationXML),
 			expectEvent: newCloudEvent(t, tFalseXMLResponse, cloudevents.ApplicationXML),
@@ -123,7 +140,14 @@ func TestReplier(t *testing.T) {
 
 			mt := &adapter.MetricTag{}
 
+			var conv converter = legacyConverter{}
+			if tc.conventions != nil {
+				conv = xmljson.New(*tc.conventions)
+			}
+
 			a := &Adapter{
+				converter: conv,
+
 				replier:  replier,
 				ceClient: ceClient,
 				logger:   logger,
@@ -156,6 +180,52 @@ func TestReplier(t *testing.T) {
 	}
 }
 
+// TestLegacyConversion asserts that documents are converted as in previous
+// versions when no conventions are set. Expected documents are the output of
+// the previous converter, which does not order the keys of objects.
+func TestLegacyConversion(t *testing.T) {
+	testCases := map[string]struct {
+		xml        string
+		expectJSON string
+	}{
+		"elements": {
+			xml:        `<note><to>Tove</to></note>`,
+			expectJSON: `{"note": {"to": "Tove"}}` + "\n",
+		},
+		"attributes and repeated elements": {
+			xml: `<order id="1"><item sku="a">2</item><item sku="b">3</item></order>`,
+			expectJSON: `{"order": {"-id": "1", "item": [{"#content": "2", "-sku": "a"}, ` +
+				`{"#content": "3", "-sku": "b"}]}}` + "\n",
+		},
+		"namespaces": {
+			xml: `<p:root xmlns:p="urn:p" xmlns="urn:d"><p:child p:attr="v">x</p:child><child/></p:root>`,
+			expectJSON: `{"root": {"child": [{"#content": "x", "-attr": "v"}, ""], ` +
+				`"-p": "urn:p", "-xmlns": "urn:d"}}` + "\n",
+		},
+		"mixed content": {
+			xml:        `<p>Hello <b>world</b> and <i>all</i>!</p>`,
+			expectJSON: `{"p": {"#content": "!", "b": "world", "i": "all"}}` + "\n",
+		},
+		"text values": {
+			xml:        `<data><n>1</n><f>1.5</f><t>true</t><e></e><s>  spaced  </s></data>`,
+			expectJSON: `{"data": {"n": "1", "f": "1.5", "t": "true", "e": "", "s": "spaced"}}` + "\n",
+		},
+		"character data": {
+			xml:        `<root><![CDATA[<raw>]]></root>`,
+			expectJSON: `{"root": "\u003craw\u003e"}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			jsn, err := legacyConverter{}.ToJSON([]byte(tc.xml))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expectJSON, string(jsn))
+		})
+	}
+}
+
 func newCloudEvent(t *testing.T, data, contentType string) cloudevents.Event {
 	t.Helper()
 
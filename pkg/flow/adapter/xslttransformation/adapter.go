@@ -22,12 +22,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"runtime"
 
 	xslt "github.com/wamuir/go-xslt"
 	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
@@ -39,9 +39,14 @@ import (
 
 var _ pkgadapter.Adapter = (*xsltTransformAdapter)(nil)
 
+// extensionResultHref is the CloudEvent extension that contains the href
+// of the xsl:result-document an event was emitted from.
+const extensionResultHref = "xsltresulthref"
+
 type xsltTransformAdapter struct {
-	defaultXSLT  *xslt.Stylesheet
+	defaultXSLT  *stylesheet
 	xsltOverride bool
+	parameters   []v1alpha1.XSLTParameter
 
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
@@ -81,6 +86,7 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	adapter := &xsltTransformAdapter{
 		xsltOverride: env.AllowXSLTOverride,
+		parameters:   env.Parameters,
 
 		replier:  replier,
 		ceClient: ceClient,
@@ -92,12 +98,14 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 	}
 
 	if env.XSLT != "" {
-		adapter.defaultXSLT, err = xslt.NewStylesheet([]byte(env.XSLT))
+		adapter.defaultXSLT, err = newStylesheet([]byte(env.XSLT))
 		if err != nil {
 			logger.Panicf("XSLT validation error: %v", err)
 		}
 
-		runtime.SetFinalizer(adapter.defaultXSLT, (*xslt.Stylesheet).Close)
+		if adapter.defaultXSLT.resultDocuments && env.Sink == "" {
+			logger.Panic("A sink is required to emit multiple events")
+		}
 	}
 
 	return adapter
@@ -120,7 +128,7 @@ func (a *xsltTransformAdapter) dispatch(ctx context.Context, event cloudevents.E
 
 	isXML := event.DataMediaType() == cloudevents.ApplicationXML
 
-	var style *xslt.Stylesheet
+	var style *stylesheet
 	var xmlin []byte
 	var err error
 
@@ -132,11 +140,11 @@ func (a *xsltTransformAdapter) dispatch(ctx context.Context, event cloudevents.E
 		}
 
 		xmlin = []byte(req.XML)
-		style, err = xslt.NewStylesheet([]byte(req.XSLT))
+		style, err = newStylesheet([]byte(req.XSLT))
 		if err != nil {
 			return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 		}
-		defer style.Close()
+		defer style.close()
 
 	case isXML:
 		xmlin = event.DataEncoded
@@ -147,23 +155,97 @@ func (a *xsltTransformAdapter) dispatch(ctx context.Context, event cloudevents.E
 			errors.New("unexpected type or media-type for the incoming event"), nil)
 	}
 
-	res, err := style.Transform(xmlin)
+	docs, err := style.transform(xmlin, a.eventParameters(&event)...)
 	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
 			fmt.Errorf("error processing XML with XSLT: %v", err), nil)
 	}
 
 	if a.sink != "" {
-		event.SetType(event.Type() + ".response")
-		if err := event.SetData(cloudevents.ApplicationXML, res); err != nil {
+		events, err := responseEvents(event, docs)
+		if err != nil {
 			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 		}
 
-		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
-			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, "sending the cloudevent to the sink")
+		for _, e := range events {
+			if result := a.ceClient.Send(ctx, e); !cloudevents.IsACK(result) {
+				return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+			}
 		}
 		return nil, cloudevents.ResultACK
 	}
 
-	return a.replier.Ok(&event, res, targetce.ResponseWithDataContentType(cloudevents.ApplicationXML))
+	if len(docs) > 1 {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
+			errors.New("a sink is required to emit multiple result documents"), nil)
+	}
+
+	return a.replier.Ok(&event, docs[0].data, targetce.ResponseWithDataContentType(contentType(docs[0].method)))
+}
+
+// eventParameters returns the stylesheet parameters bound to the attributes
+// of the event. Parameters whose attribute is not set are omitted.
+func (a *xsltTransformAdapter) eventParameters(event *cloudevents.Event) []xslt.Parameter {
+	if len(a.parameters) == 0 {
+		return nil
+	}
+
+	params := make([]xslt.Parameter, 0, len(a.parameters))
+	for _, p := range a.parameters {
+		if val, ok := attributeValue(event, p.ValueFromAttribute); ok {
+			params = append(params, xslt.StringParameter{Name: p.Name, Value: val})
+		}
+	}
+	return params
+}
+
+// attributeValue returns the value of the CloudEvent context attribute or extension.
+func attributeValue(event *cloudevents.Event, name string) (string, bool) {
+	switch name {
+	case "id":
+		return event.ID(), true
+	case "source":
+		return event.Source(), true
+	case "type":
+		return event.Type(), true
+	case "subject":
+		return event.Subject(), event.Subject() != ""
+	case "datacontenttype":
+		return event.DataContentType(), event.DataContentType() != ""
+	case "dataschema":
+		return event.DataSchema(), event.DataSchema() != ""
+	}
+	val, exists := event.Extensions()[name]
+	if !exists {
+		return "", false
+	}
+	str, err := types.ToString(val)
+	return str, err == nil
+}
+
+// responseEvents returns the events sent to the sink for the results of the
+// transformation. The principal result keeps the ID of the incoming event,
+// result documents are identified by their position.
+func responseEvents(event cloudevents.Event, docs []document) ([]cloudevents.Event, error) {
+	events := make([]cloudevents.Event, 0, len(docs))
+
+	i := 0
+	for _, doc := range docs {
+		e := event.Clone()
+		e.SetType(event.Type() + ".response")
+
+		if !doc.principal {
+			e.SetID(fmt.Sprintf("%s-%d", event.ID(), i))
+			if doc.href != "" {
+				e.SetExtension(extensionResultHref, doc.href)
+			}
+			i++
+		}
+
+		if err := e.SetData(contentType(doc.method), doc.data); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
 }
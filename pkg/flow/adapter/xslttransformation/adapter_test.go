@@ -21,7 +21,6 @@ package xslttransformation
 import (
 	"context"
 	"encoding/json"
-	"runtime"
 	"testing"
 	"time"
 
@@ -35,8 +34,6 @@ import (
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	logtesting "knative.dev/pkg/logging/testing"
 
-	xslt "github.com/wamuir/go-xslt"
-
 	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	metricstesting "github.com/triggermesh/triggermesh/pkg/metrics/testing"
@@ -124,6 +121,41 @@ const (
 
 	tAlternativeOutXML = `<?xml version="1.0"?>
 <alt><item>A1</item><item>B2</item><item>C3</item></alt>
+`
+
+	tParamsXSLT = `
+<xsl:stylesheet version="1.0"	xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:param name="source" select="'none'"/>
+  <xsl:param name="region" select="'none'"/>
+  <xsl:template match="tests">
+    <output source="{$source}" region="{$region}"/>
+  </xsl:template>
+</xsl:stylesheet>
+`
+
+	tParamsOutXML = `<?xml version="1.0"?>
+<output source="ce.test.source" region="none"/>
+`
+
+	tJSONXSLT = `
+<xsl:stylesheet version="1.0"	xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:output method="json"/>
+  <xsl:template match="tests">{"count":<xsl:value-of select="count(test)"/>}</xsl:template>
+</xsl:stylesheet>
+`
+
+	tOutJSON = `{"count":3}`
+
+	tResultDocumentsXSLT = `
+<xsl:stylesheet version="2.0"	xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:template match="tests">
+    <xsl:for-each select="test">
+      <xsl:result-document href="{data/el1}.xml">
+        <item><xsl:value-of select="data/el2"/></item>
+      </xsl:result-document>
+    </xsl:for-each>
+  </xsl:template>
+</xsl:stylesheet>
 `
 )
 
@@ -177,6 +209,7 @@ func TestXSLTTransformationEvents(t *testing.T) {
 	testCases := map[string]struct {
 		allowXSLTOverride bool
 		xslt              string
+		parameters        []v1alpha1.XSLTParameter
 
 		inEvent cloudevents.Event
 
@@ -230,6 +263,48 @@ func TestXSLTTransformationEvents(t *testing.T) {
 				cloudevents.ApplicationJSON),
 			expectCategory: tErrorAttribute,
 		},
+		"transform with parameters, ok": {
+			xslt: tParamsXSLT,
+			parameters: []v1alpha1.XSLTParameter{
+				{Name: "source", ValueFromAttribute: "source"},
+				{Name: "region", ValueFromAttribute: "region"},
+			},
+			inEvent: newCloudEvent(tXML, cloudevents.ApplicationXML),
+
+			expectEvent:    newCloudEvent(tParamsOutXML, cloudevents.ApplicationXML),
+			expectCategory: tSuccessAttribute,
+		},
+		"transform with parameters from extension, ok": {
+			xslt: tParamsXSLT,
+			parameters: []v1alpha1.XSLTParameter{
+				{Name: "region", ValueFromAttribute: "region"},
+			},
+			inEvent: newCloudEvent(tXML, cloudevents.ApplicationXML, cloudEventWithExtension("region", "eu")),
+
+			expectEvent: newCloudEvent(`<?xml version="1.0"?>
+<output source="none" region="eu"/>
+`, cloudevents.ApplicationXML),
+			expectCategory: tSuccessAttribute,
+		},
+		"transform with JSON output method, ok": {
+			xslt:    tJSONXSLT,
+			inEvent: newCloudEvent(tXML, cloudevents.ApplicationXML),
+
+			expectEvent:    newCloudEvent(tOutJSON, cloudevents.ApplicationJSON),
+			expectCategory: tSuccessAttribute,
+		},
+		"transform with result documents at event without sink": {
+			allowXSLTOverride: true,
+			inEvent: newCloudEvent(
+				createStructuredRequest(tXML, tResultDocumentsXSLT),
+				cloudevents.ApplicationJSON,
+				cloudEventWithEventType(v1alpha1.EventTypeXSLTTransformation)),
+
+			expectEvent: newCloudEvent(
+				createErrorResponse(targetce.ErrorCodeRequestValidation, "a sink is required to emit multiple result documents"),
+				cloudevents.ApplicationJSON),
+			expectCategory: tErrorAttribute,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -249,6 +324,7 @@ func TestXSLTTransformationEvents(t *testing.T) {
 
 			a := &xsltTransformAdapter{
 				xsltOverride: tc.allowXSLTOverride,
+				parameters:   tc.parameters,
 
 				replier:  replier,
 				ceClient: ceClient,
@@ -259,9 +335,8 @@ func TestXSLTTransformationEvents(t *testing.T) {
 			}
 
 			if v := tc.xslt; v != "" {
-				a.defaultXSLT, err = xslt.NewStylesheet([]byte(v))
+				a.defaultXSLT, err = newStylesheet([]byte(v))
 				require.NoError(t, err)
-				runtime.SetFinalizer(a.defaultXSLT, (*xslt.Stylesheet).Close)
 			}
 
 			ctx, cancel := context.WithCancel(context.Background())
@@ -283,6 +358,7 @@ func TestXSLTTransformationEvents(t *testing.T) {
 				assert.NotEmpty(t, event.Event.Extensions()["statefulbridge"])
 
 				assert.Equal(t, string(tc.expectEvent.DataEncoded), string(event.Event.DataEncoded))
+				assert.Equal(t, tc.expectEvent.DataContentType(), event.Event.DataContentType())
 
 			case <-time.After(15 * time.Second):
 				assert.Fail(t, "expected cloud event response was not received")
@@ -294,20 +370,40 @@ func TestXSLTTransformationEvents(t *testing.T) {
 
 func TestXSLTTransformationToSink(t *testing.T) {
 	testCases := map[string]struct {
-		xslt          string
-		inEvent       cloudevents.Event
-		expectedEvent cloudevents.Event
+		xslt           string
+		inEvent        cloudevents.Event
+		expectedEvents []cloudevents.Event
 	}{
 		"transform ok": {
-			xslt:          tXSLT,
-			inEvent:       newCloudEvent(tXML, cloudevents.ApplicationXML),
-			expectedEvent: newCloudEvent(tOutXML, cloudevents.ApplicationXML, cloudEventWithEventType(tCloudEventResponseType)),
+			xslt:    tXSLT,
+			inEvent: newCloudEvent(tXML, cloudevents.ApplicationXML),
+			expectedEvents: []cloudevents.Event{
+				newCloudEvent(tOutXML, cloudevents.ApplicationXML, cloudEventWithEventType(tCloudEventResponseType)),
+			},
+		},
+		"transform result documents ok": {
+			xslt:    tResultDocumentsXSLT,
+			inEvent: newCloudEvent(tXML, cloudevents.ApplicationXML),
+			expectedEvents: []cloudevents.Event{
+				newCloudEvent("<?xml version=\"1.0\"?>\n<item>1</item>\n", cloudevents.ApplicationXML,
+					cloudEventWithEventType(tCloudEventResponseType),
+					cloudEventWithID(tCloudEventID+"-0"),
+					cloudEventWithExtension(extensionResultHref, "A.xml")),
+				newCloudEvent("<?xml version=\"1.0\"?>\n<item>2</item>\n", cloudevents.ApplicationXML,
+					cloudEventWithEventType(tCloudEventResponseType),
+					cloudEventWithID(tCloudEventID+"-1"),
+					cloudEventWithExtension(extensionResultHref, "B.xml")),
+				newCloudEvent("<?xml version=\"1.0\"?>\n<item>3</item>\n", cloudevents.ApplicationXML,
+					cloudEventWithEventType(tCloudEventResponseType),
+					cloudEventWithID(tCloudEventID+"-2"),
+					cloudEventWithExtension(extensionResultHref, "C.xml")),
+			},
 		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			ceClient := adaptertest.NewTestClient()
-			style, err := xslt.NewStylesheet([]byte(tc.xslt))
+			style, err := newStylesheet([]byte(tc.xslt))
 			assert.NoError(t, err)
 
 			a := &xsltTransformAdapter{
@@ -326,9 +422,10 @@ func TestXSLTTransformationToSink(t *testing.T) {
 			assert.Equal(t, cloudevents.ResultACK, r)
 
 			events := ceClient.Sent()
-			require.Equal(t, 1, len(events))
-			t.Log(string(events[0].Data()))
-			assert.Equal(t, tc.expectedEvent, events[0])
+			require.Equal(t, len(tc.expectedEvents), len(events))
+			for i := range events {
+				assert.Equal(t, tc.expectedEvents[i], events[i])
+			}
 		})
 	}
 }
@@ -360,6 +457,18 @@ func cloudEventWithEventType(t string) cloudEventOptions {
 	}
 }
 
+func cloudEventWithID(id string) cloudEventOptions {
+	return func(ce *cloudevents.Event) {
+		ce.SetID(id)
+	}
+}
+
+func cloudEventWithExtension(name, value string) cloudEventOptions {
+	return func(ce *cloudevents.Event) {
+		ce.SetExtension(name, value)
+	}
+}
+
 func createStructuredRequest(xml, xslt string) string {
 	sr := XSLTTransformationStructuredRequest{
 		XML:  xml,
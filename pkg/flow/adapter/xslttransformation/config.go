@@ -19,9 +19,12 @@ limitations under the License.
 package xslttransformation
 
 import (
+	"encoding/json"
 	"errors"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 )
 
 // EnvAccessorCtor for configuration parameters
@@ -36,6 +39,8 @@ type envAccessor struct {
 	// If set to true, enables consuming structured CloudEvents that include
 	// fields for the XML and XSLT field.
 	AllowXSLTOverride bool `envconfig:"XSLTTRANSFORMATION_ALLOW_XSLT_OVERRIDE" required:"true"`
+	// Stylesheet parameters bound from CloudEvent attributes.
+	Parameters Parameters `envconfig:"XSLTTRANSFORMATION_PARAMETERS"`
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 	// Sink defines the target sink for the events. If no Sink is defined the
@@ -43,6 +48,14 @@ type envAccessor struct {
 	Sink string `envconfig:"K_SINK"`
 }
 
+// Parameters is the list of stylesheet parameters.
+type Parameters []v1alpha1.XSLTParameter
+
+// Decode a JSON array of stylesheet parameters.
+func (p *Parameters) Decode(value string) error {
+	return json.Unmarshal([]byte(value), p)
+}
+
 func (e *envAccessor) validate() error {
 	if !e.AllowXSLTOverride && e.XSLT == "" {
 		return errors.New("if XSLT cannot be overriden by CloudEvent payloads, configured XSLT cannot be empty")
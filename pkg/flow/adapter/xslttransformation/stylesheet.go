@@ -0,0 +1,326 @@
+//go:build !noclibs
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xslttransformation
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+
+	xslt "github.com/wamuir/go-xslt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+const (
+	xslNamespace = "http://www.w3.org/1999/XSL/Transform"
+
+	// libxslt only implements XSLT 1.0, xsl:result-document instructions
+	// are rewritten into literal result elements under this namespace and
+	// extracted from the transformation output.
+	resultDocumentPrefix    = "tmresult"
+	resultDocumentNamespace = "urn:triggermesh:xslt:result-document"
+
+	xmlDeclaration = "<?xml version=\"1.0\"?>\n"
+)
+
+// XSLT output methods.
+const (
+	outputMethodXML  = "xml"
+	outputMethodHTML = "html"
+	outputMethodText = "text"
+	outputMethodJSON = "json"
+)
+
+// rewriteXSLT adapts stylesheets to what libxslt supports. It replaces the
+// method of xsl:output elements with the "method" parameter and turns
+// xsl:result-document instructions into literal result elements.
+const rewriteXSLT = `<xsl:stylesheet version="1.0"
+    xmlns:xsl="` + xslNamespace + `"
+    xmlns:` + resultDocumentPrefix + `="` + resultDocumentNamespace + `">
+  <xsl:param name="method"/>
+
+  <xsl:template match="@*|node()">
+    <xsl:copy>
+      <xsl:apply-templates select="@*|node()"/>
+    </xsl:copy>
+  </xsl:template>
+
+  <xsl:template match="xsl:output/@method">
+    <xsl:attribute name="method"><xsl:value-of select="$method"/></xsl:attribute>
+  </xsl:template>
+
+  <xsl:template match="xsl:result-document">
+    <` + resultDocumentPrefix + `:result-document>
+      <xsl:apply-templates select="@*|node()"/>
+    </` + resultDocumentPrefix + `:result-document>
+  </xsl:template>
+</xsl:stylesheet>`
+
+// stylesheet is a compiled XSLT document along with the output properties
+// that libxslt does not handle by itself.
+type stylesheet struct {
+	xsl *xslt.Stylesheet
+
+	// method declared by the xsl:output element.
+	method string
+	// whether the stylesheet contains xsl:result-document instructions.
+	resultDocuments bool
+}
+
+// document is one of the results of a transformation.
+type document struct {
+	// whether the document is the principal result or a result document.
+	principal bool
+	// href of the xsl:result-document.
+	href string
+
+	method string
+	data   []byte
+}
+
+// newStylesheet compiles the XSLT document. The returned stylesheet is
+// released when garbage collected.
+func newStylesheet(src []byte) (*stylesheet, error) {
+	s := &stylesheet{
+		method: outputMethodXML,
+	}
+	if err := s.inspect(src); err != nil {
+		return nil, fmt.Errorf("failed to parse xsl: %w", err)
+	}
+
+	var method string
+	switch {
+	case s.resultDocuments:
+		// result documents are extracted from the XML output and then
+		// serialized using their own output method.
+		method = outputMethodXML
+	case s.method == outputMethodJSON:
+		// libxslt does not know about the JSON output method, the
+		// stylesheet is expected to write JSON as text.
+		method = outputMethodText
+	}
+
+	if method != "" {
+		var err error
+		if src, err = rewrite(src, method); err != nil {
+			return nil, err
+		}
+	}
+
+	xsl, err := xslt.NewStylesheet(src)
+	if err != nil {
+		return nil, err
+	}
+	s.xsl = xsl
+	runtime.SetFinalizer(s, (*stylesheet).close)
+
+	return s, nil
+}
+
+// rewrite applies the rewriteXSLT stylesheet to the XSLT document.
+func rewrite(src []byte, method string) ([]byte, error) {
+	rw, err := xslt.NewStylesheet([]byte(rewriteXSLT))
+	if err != nil {
+		return nil, fmt.Errorf("compiling the rewrite stylesheet: %w", err)
+	}
+	defer rw.Close()
+
+	out, err := rw.Transform(src, xslt.StringParameter{Name: "method", Value: method})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse xsl: %w", err)
+	}
+	return out, nil
+}
+
+// inspect looks for the output method and result documents declared in
+// the XSLT document.
+func (s *stylesheet) inspect(src []byte) error {
+	dec := xml.NewDecoder(bytes.NewReader(src))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		se, ok := tok.(xml.StartElement)
+		if !ok || se.Name.Space != xslNamespace {
+			continue
+		}
+
+		switch se.Name.Local {
+		case "output":
+			if m := attr(se.Attr, "method"); m != "" {
+				s.method = m
+			}
+		case "result-document":
+			s.resultDocuments = true
+		}
+	}
+}
+
+// close releases the compiled stylesheet.
+func (s *stylesheet) close() {
+	s.xsl.Close()
+}
+
+// transform applies the stylesheet to the XML document and returns the
+// principal result followed by the result documents.
+func (s *stylesheet) transform(xmlin []byte, params ...xslt.Parameter) ([]document, error) {
+	out, err := s.xsl.Transform(xmlin, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.resultDocuments {
+		return []document{{principal: true, method: s.method, data: out}}, nil
+	}
+
+	return splitResultDocuments(out, s.method)
+}
+
+// splitResultDocuments extracts the result documents from the XML output
+// of a rewritten stylesheet. The principal result is only returned when it
+// is not empty, or when there are no result documents.
+func splitResultDocuments(out []byte, method string) ([]document, error) {
+	var docs []document
+
+	principal := &bytes.Buffer{}
+	principalText := &bytes.Buffer{}
+	hasElement := false
+
+	var current *document
+	var currentText *bytes.Buffer
+	var depth, contentStart int
+	last := 0
+
+	dec := xml.NewDecoder(bytes.NewReader(out))
+	for {
+		offset := int(dec.InputOffset())
+
+		tok, err := dec.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing the transformation output: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if current != nil {
+				depth++
+				break
+			}
+			if t.Name.Space != resultDocumentPrefix || t.Name.Local != "result-document" {
+				hasElement = true
+				break
+			}
+
+			principal.Write(out[last:offset])
+			current = &document{
+				href:   attr(t.Attr, "href"),
+				method: attr(t.Attr, "method"),
+			}
+			if current.method == "" {
+				current.method = method
+			}
+			currentText = &bytes.Buffer{}
+			contentStart = int(dec.InputOffset())
+
+		case xml.EndElement:
+			if current == nil {
+				break
+			}
+			if depth > 0 {
+				depth--
+				break
+			}
+
+			if current.method == outputMethodXML || current.method == outputMethodHTML {
+				current.data = append([]byte(xmlDeclaration),
+					bytes.TrimSpace(out[contentStart:offset])...)
+				current.data = append(current.data, '\n')
+			} else {
+				current.data = currentText.Bytes()
+			}
+			docs = append(docs, *current)
+			current = nil
+			last = int(dec.InputOffset())
+
+		case xml.CharData:
+			if current != nil {
+				currentText.Write(t)
+			} else {
+				principalText.Write(t)
+			}
+		}
+	}
+	principal.Write(out[last:])
+
+	var data []byte
+	switch method {
+	case outputMethodXML, outputMethodHTML:
+		if hasElement {
+			data = principal.Bytes()
+		}
+	default:
+		if len(bytes.TrimSpace(principalText.Bytes())) != 0 {
+			// drop the line breaks written around the XML declaration
+			data = bytes.TrimPrefix(principalText.Bytes(), []byte("\n"))
+			data = bytes.TrimSuffix(data, []byte("\n"))
+		}
+	}
+
+	if data == nil && len(docs) != 0 {
+		return docs, nil
+	}
+
+	return append([]document{{principal: true, method: method, data: data}}, docs...), nil
+}
+
+// contentType returns the media type of the documents serialized with the
+// given XSLT output method.
+func contentType(method string) string {
+	switch method {
+	case outputMethodText:
+		return cloudevents.TextPlain
+	case outputMethodJSON:
+		return cloudevents.ApplicationJSON
+	case outputMethodHTML:
+		return "text/html"
+	default:
+		return cloudevents.ApplicationXML
+	}
+}
+
+func attr(attrs []xml.Attr, name string) string {
+	for _, a := range attrs {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,115 @@
+//go:build !noclibs
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xslttransformation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	xslt "github.com/wamuir/go-xslt"
+)
+
+func TestStylesheetTransform(t *testing.T) {
+	const xmlin = `<items><item id="1"/><item id="2"/></items>`
+
+	testCases := map[string]struct {
+		xslt   string
+		params []xslt.Parameter
+
+		expectDocs []document
+	}{
+		"default output method": {
+			xslt: `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:template match="/"><count><xsl:value-of select="count(//item)"/></count></xsl:template>
+</xsl:stylesheet>`,
+			expectDocs: []document{
+				{principal: true, method: "xml", data: []byte("<?xml version=\"1.0\"?>\n<count>2</count>\n")},
+			},
+		},
+		"text output method with parameter": {
+			xslt: `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:output method="text"/>
+  <xsl:param name="label" select="'default'"/>
+  <xsl:template match="/"><xsl:value-of select="$label"/>: <xsl:value-of select="count(//item)"/></xsl:template>
+</xsl:stylesheet>`,
+			params: []xslt.Parameter{xslt.StringParameter{Name: "label", Value: "items"}},
+			expectDocs: []document{
+				{principal: true, method: "text", data: []byte("items: 2")},
+			},
+		},
+		"json output method": {
+			xslt: `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:output method="json"/>
+  <xsl:template match="/">{"count":<xsl:value-of select="count(//item)"/>}</xsl:template>
+</xsl:stylesheet>`,
+			expectDocs: []document{
+				{principal: true, method: "json", data: []byte(`{"count":2}`)},
+			},
+		},
+		"result documents with principal result": {
+			xslt: `<xsl:stylesheet version="2.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:output method="text"/>
+  <xsl:template match="/">count: <xsl:value-of select="count(//item)"/>
+    <xsl:for-each select="//item">
+      <xsl:result-document href="{@id}.json" method="json">{"id":<xsl:value-of select="@id"/>}</xsl:result-document>
+    </xsl:for-each>
+  </xsl:template>
+</xsl:stylesheet>`,
+			expectDocs: []document{
+				{principal: true, method: "text", data: []byte("count: 2")},
+				{href: "1.json", method: "json", data: []byte(`{"id":1}`)},
+				{href: "2.json", method: "json", data: []byte(`{"id":2}`)},
+			},
+		},
+		"result documents without principal result": {
+			xslt: `<xsl:stylesheet version="2.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:template match="/">
+    <xsl:for-each select="//item">
+      <xsl:result-document href="{@id}.xml"><item><xsl:value-of select="@id"/></item></xsl:result-document>
+    </xsl:for-each>
+  </xsl:template>
+</xsl:stylesheet>`,
+			expectDocs: []document{
+				{href: "1.xml", method: "xml", data: []byte("<?xml version=\"1.0\"?>\n<item>1</item>\n")},
+				{href: "2.xml", method: "xml", data: []byte("<?xml version=\"1.0\"?>\n<item>2</item>\n")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s, err := newStylesheet([]byte(tc.xslt))
+			require.NoError(t, err)
+
+			docs, err := s.transform([]byte(xmlin), tc.params...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectDocs, docs)
+		})
+	}
+}
+
+func TestContentType(t *testing.T) {
+	assert.Equal(t, "application/xml", contentType(outputMethodXML))
+	assert.Equal(t, "text/html", contentType(outputMethodHTML))
+	assert.Equal(t, "text/plain", contentType(outputMethodText))
+	assert.Equal(t, "application/json", contentType(outputMethodJSON))
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package jqtransformation
 
 import (
+	"strconv"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -32,6 +34,12 @@ import (
 const (
 	envQuery               = "JQ_QUERY"
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+	envOutputSplit         = "OUTPUT_SPLIT"
+	envOutputType          = "OUTPUT_TYPE"
+	envOutputSource        = "OUTPUT_SOURCE"
+	envOutputSubject       = "OUTPUT_SUBJECT"
+	envBatchWindow         = "BATCH_WINDOW"
+	envBatchSize           = "BATCH_SIZE"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -78,5 +86,43 @@ func MakeAppEnv(o *v1alpha1.JQTransformation) []corev1.EnvVar {
 		})
 	}
 
+	if out := o.Spec.Output; out != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envOutputSplit,
+			Value: strconv.FormatBool(out.Split),
+		})
+		if out.Type != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envOutputType,
+				Value: *out.Type,
+			})
+		}
+		if out.Source != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envOutputSource,
+				Value: *out.Source,
+			})
+		}
+		if out.Subject != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envOutputSubject,
+				Value: *out.Subject,
+			})
+		}
+	}
+
+	if b := o.Spec.Batch; b != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envBatchWindow,
+			Value: b.Window.String(),
+		})
+		if b.Size != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envBatchSize,
+				Value: strconv.Itoa(*b.Size),
+			})
+		}
+	}
+
 	return env
 }
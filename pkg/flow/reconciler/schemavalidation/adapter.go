@@ -0,0 +1,109 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidation
+
+import (
+	"encoding/json"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+)
+
+const (
+	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+	envSchemas             = "SCHEMAVALIDATION_SCHEMAS"
+	envSchemaPrefix        = "SCHEMAVALIDATION_SCHEMA_"
+	envDeadLetterSink      = "SCHEMAVALIDATION_DEAD_LETTER_SINK"
+)
+
+// adapterConfig contains properties used to configure the component's adapter.
+// Public fields are automatically populated by envconfig.
+type adapterConfig struct {
+	// Configuration accessor for logging/metrics/tracing
+	obsConfig source.ConfigAccessor
+	// Container image
+	Image string `default:"gcr.io/triggermesh/schemavalidation-adapter"`
+}
+
+// Verify that Reconciler implements common.AdapterBuilder.
+var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
+
+// BuildAdapter implements common.AdapterBuilder.
+func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
+	typedTrg := trg.(*v1alpha1.SchemaValidation)
+
+	return common.NewAdapterKnService(trg, sinkURI,
+		resource.Image(r.adapterCfg.Image),
+		resource.EnvVars(MakeAppEnv(typedTrg)...),
+		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
+	), nil
+}
+
+// MakeAppEnv extracts environment variables from the object.
+// Exported to be used in external tools for local test environments.
+//
+// The selectors of the schemas are passed as a JSON list, while each schema
+// document is passed in its own variable, suffixed by its index in the list,
+// so that it can be sourced from a ConfigMap or a Secret.
+func MakeAppEnv(o *v1alpha1.SchemaValidation) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{
+			Name:  common.EnvBridgeID,
+			Value: common.GetStatefulBridgeID(o),
+		},
+	}
+
+	selectors := make([]v1alpha1.EventSchema, 0, len(o.Spec.Schemas))
+	for i, s := range o.Spec.Schemas {
+		selectors = append(selectors, v1alpha1.EventSchema{
+			Language:   s.Language,
+			EventTypes: s.EventTypes,
+		})
+		env = append(env, *s.Schema.ToEnvironmentVariable(envSchemaPrefix + strconv.Itoa(i)))
+	}
+	if b, err := json.Marshal(selectors); err == nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envSchemas,
+			Value: string(b),
+		})
+	}
+
+	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envEventsPayloadPolicy,
+			Value: string(*o.Spec.EventOptions.PayloadPolicy),
+		})
+	}
+
+	if dls := o.Status.DeadLetterSinkURI; dls != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envDeadLetterSink,
+			Value: dls.String(),
+		})
+	}
+
+	return env
+}
@@ -18,6 +18,7 @@ package synchronizer
 
 import (
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -79,5 +80,152 @@ func MakeAppEnv(o *v1alpha1.Synchronizer) []corev1.EnvVar {
 		})
 	}
 
+	if src := o.Spec.CorrelationKey.Source; src != nil {
+		env = append(env, makeCorrelationSourceEnv("", src)...)
+	}
+
+	if src := o.Spec.CorrelationKey.ResponseSource; src != nil {
+		env = append(env, makeCorrelationSourceEnv("RESPONSE_", src)...)
+	}
+
+	if async := o.Spec.Response.Async; async != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "ASYNC_ENABLED",
+			Value: "true",
+		})
+		if async.CallbackAttribute != "" {
+			env = append(env, corev1.EnvVar{
+				Name:  "ASYNC_CALLBACK_ATTRIBUTE",
+				Value: async.CallbackAttribute,
+			})
+		}
+		if len(async.AllowedCallbackURLs) != 0 {
+			env = append(env, corev1.EnvVar{
+				Name:  "ASYNC_ALLOWED_CALLBACK_URLS",
+				Value: strings.Join(async.AllowedCallbackURLs, ","),
+			})
+		}
+		if async.TTL != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  "ASYNC_TTL",
+				Value: async.TTL.String(),
+			})
+		}
+	}
+
+	if aggr := o.Spec.Response.Aggregation; aggr != nil {
+		env = append(env, makeAggregationEnv(aggr)...)
+	}
+
+	if o.Spec.Sessions != nil && o.Spec.Sessions.Redis != nil {
+		env = append(env, makeRedisEnv(o.Spec.Sessions.Redis)...)
+	}
+
+	return env
+}
+
+// makeCorrelationSourceEnv returns environment variables that configure
+// a source of correlation keys, their names are prefixed with the given prefix.
+func makeCorrelationSourceEnv(prefix string, src *v1alpha1.CorrelationSource) []corev1.EnvVar {
+	var env []corev1.EnvVar
+
+	if src.Path != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  prefix + "CORRELATION_KEY_PATH",
+			Value: src.Path,
+		})
+	}
+	if src.Header != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  prefix + "CORRELATION_KEY_HEADER",
+			Value: src.Header,
+		})
+	}
+	if src.ContextAttribute != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  prefix + "CORRELATION_KEY_CONTEXT_ATTRIBUTE",
+			Value: src.ContextAttribute,
+		})
+	}
+	if src.Expression != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  prefix + "CORRELATION_KEY_EXPRESSION",
+			Value: src.Expression,
+		})
+	}
+
+	return env
+}
+
+// makeAggregationEnv returns environment variables that configure
+// the responses aggregation.
+func makeAggregationEnv(a *v1alpha1.ResponseAggregation) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{
+			Name:  "AGGREGATION_ENABLED",
+			Value: "true",
+		},
+	}
+
+	if a.Count != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "AGGREGATION_COUNT",
+			Value: strconv.Itoa(*a.Count),
+		})
+	}
+	if a.Completion != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  "AGGREGATION_COMPLETION",
+			Value: a.Completion,
+		})
+	}
+	if a.Merge != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  "AGGREGATION_MERGE",
+			Value: a.Merge,
+		})
+	}
+	if a.KeyAttribute != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  "AGGREGATION_KEY_ATTRIBUTE",
+			Value: a.KeyAttribute,
+		})
+	}
+
+	return env
+}
+
+// makeRedisEnv returns environment variables that configure
+// the connection to the sessions storage.
+func makeRedisEnv(r *v1alpha1.RedisConnection) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{
+			Name:  "REDIS_ADDRESS",
+			Value: r.Address,
+		},
+	}
+
+	if r.Username != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "REDIS_USERNAME",
+			Value: *r.Username,
+		})
+	}
+	if r.Password != nil {
+		env = common.MaybeAppendValueFromEnvVar(env, "REDIS_PASSWORD", *r.Password)
+	}
+	if r.Database != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "REDIS_DATABASE",
+			Value: strconv.Itoa(*r.Database),
+		})
+	}
+	if r.TLSEnabled != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "REDIS_TLS_ENABLED",
+			Value: strconv.FormatBool(*r.TLSEnabled),
+		})
+	}
+
 	return env
 }
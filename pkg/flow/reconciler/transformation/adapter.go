@@ -18,6 +18,8 @@ package transformation
 
 import (
 	"encoding/json"
+	"path/filepath"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -34,8 +36,23 @@ import (
 const (
 	envTransformationCtx  = "TRANSFORMATION_CONTEXT"
 	envTransformationData = "TRANSFORMATION_DATA"
+
+	envStorageMaxEvents = "STORAGE_MAX_EVENTS"
+	envStorageTTL       = "STORAGE_TTL"
+
+	envRedisAddress    = "REDIS_ADDRESS"
+	envRedisUsername   = "REDIS_USERNAME"
+	envRedisPassword   = "REDIS_PASSWORD"
+	envRedisDatabase   = "REDIS_DATABASE"
+	envRedisTLSEnabled = "REDIS_TLS_ENABLED"
+
+	envLookupTablesDir = "LOOKUP_TABLES_DIR"
 )
 
+// lookupTablesDir is the directory where the ConfigMaps
+// of the lookup tables are mounted.
+const lookupTablesDir = "/etc/triggermesh/lookup"
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -52,10 +69,14 @@ var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
 	typedTrg := trg.(*v1alpha1.Transformation)
 
+	volumes, volumeMounts := makeLookupTableVolumes(typedTrg.Spec.LookupTables)
+
 	return common.NewAdapterKnService(trg, sinkURI,
 		resource.Image(r.adapterCfg.Image),
 		resource.EnvVars(MakeAppEnv(typedTrg)...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
+		resource.Volumes(volumes...),
+		resource.VolumeMounts(volumeMounts...),
 	), nil
 }
 
@@ -72,7 +93,7 @@ func MakeAppEnv(o *v1alpha1.Transformation) []corev1.EnvVar {
 		trnData = string(b)
 	}
 
-	return []corev1.EnvVar{
+	env := []corev1.EnvVar{
 		{
 			Name:  envTransformationCtx,
 			Value: trnContext,
@@ -82,4 +103,94 @@ func MakeAppEnv(o *v1alpha1.Transformation) []corev1.EnvVar {
 			Value: trnData,
 		},
 	}
+
+	if s := o.Spec.Storage; s != nil {
+		env = append(env, makeStorageEnv(s)...)
+	}
+
+	if len(o.Spec.LookupTables) != 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  envLookupTablesDir,
+			Value: lookupTablesDir,
+		})
+	}
+
+	return env
+}
+
+// makeStorageEnv returns environment variables that configure
+// the storage of the Pipeline variables.
+func makeStorageEnv(s *v1alpha1.TransformationStorage) []corev1.EnvVar {
+	var env []corev1.EnvVar
+
+	if s.MaxEvents != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envStorageMaxEvents,
+			Value: strconv.Itoa(*s.MaxEvents),
+		})
+	}
+
+	if s.TTL != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envStorageTTL,
+			Value: s.TTL.String(),
+		})
+	}
+
+	if r := s.Redis; r != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envRedisAddress,
+			Value: r.Address,
+		})
+		if r.Username != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envRedisUsername,
+				Value: *r.Username,
+			})
+		}
+		if r.Password != nil {
+			env = common.MaybeAppendValueFromEnvVar(env, envRedisPassword, *r.Password)
+		}
+		if r.Database != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envRedisDatabase,
+				Value: strconv.Itoa(*r.Database),
+			})
+		}
+		if r.TLSEnabled != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envRedisTLSEnabled,
+				Value: strconv.FormatBool(*r.TLSEnabled),
+			})
+		}
+	}
+
+	return env
+}
+
+// makeLookupTableVolumes returns the ConfigMap-based volumes of the lookup
+// tables and the corresponding mounts, one directory per table.
+func makeLookupTableVolumes(tables []v1alpha1.LookupTable) ([]corev1.Volume, []corev1.VolumeMount) {
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+
+	for i, t := range tables {
+		name := "lookup-table-" + strconv.Itoa(i)
+
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: t.ConfigMap,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      name,
+			ReadOnly:  true,
+			MountPath: filepath.Join(lookupTablesDir, t.Name),
+		})
+	}
+
+	return volumes, volumeMounts
 }
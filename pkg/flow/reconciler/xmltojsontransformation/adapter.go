@@ -17,6 +17,8 @@ limitations under the License.
 package xmltojsontransformation
 
 import (
+	"encoding/json"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -31,6 +33,7 @@ import (
 
 const (
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+	envConventions         = "XMLJSON_CONVENTIONS"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -73,5 +76,14 @@ func MakeAppEnv(o *v1alpha1.XMLToJSONTransformation) []corev1.EnvVar {
 		})
 	}
 
+	if o.Spec.Conventions != nil {
+		if b, err := json.Marshal(o.Spec.Conventions); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envConventions,
+				Value: string(b),
+			})
+		}
+	}
+
 	return env
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package xslttransformation
 
 import (
+	"encoding/json"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
@@ -34,6 +35,7 @@ import (
 const (
 	envXSLT              = "XSLTTRANSFORMATION_XSLT"
 	envAllowXSLTOverride = "XSLTTRANSFORMATION_ALLOW_XSLT_OVERRIDE"
+	envParameters        = "XSLTTRANSFORMATION_PARAMETERS"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -77,5 +79,14 @@ func MakeAppEnv(o *v1alpha1.XSLTTransformation) []corev1.EnvVar {
 		})
 	}
 
+	if len(o.Spec.Parameters) != 0 {
+		if b, err := json.Marshal(o.Spec.Parameters); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envParameters,
+				Value: string(b),
+			})
+		}
+	}
+
 	return env
 }
@@ -0,0 +1,211 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xmljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+)
+
+// ToJSON converts an XML document to JSON.
+//
+// Elements that only contain text are converted to JSON strings, other
+// elements to JSON objects whose members are, in this order, the text of the
+// element, its attributes and its children. Repeated child elements are
+// grouped in JSON arrays.
+func (c *Converter) ToJSON(data []byte) ([]byte, error) {
+	root, err := c.parseXML(data)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if c.rootElement != "" {
+		c.writeJSONValue(buf, root)
+	} else {
+		buf.WriteByte('{')
+		writeJSONKey(buf, root.name)
+		c.writeJSONValue(buf, root)
+		buf.WriteByte('}')
+	}
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+// parseXML returns the root element of the XML document.
+func (c *Converter) parseXML(data []byte) (*element, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	// convert the charset of non UTF-8 documents
+	dec.CharsetReader = charset.NewReaderLabel
+
+	nextToken := dec.Token
+	if c.preserveNS {
+		// raw tokens contain the namespace prefixes as written
+		nextToken = dec.RawToken
+	}
+
+	var root *element
+	var stack []*element
+
+	for {
+		tok, err := nextToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing XML: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			e := &element{name: c.xmlName(t.Name)}
+			for _, a := range t.Attr {
+				if !c.preserveNS && isNamespaceDeclaration(a.Name) {
+					continue
+				}
+				e.attrs = append(e.attrs, attribute{name: c.xmlName(a.Name), value: a.Value})
+			}
+
+			switch {
+			case len(stack) != 0:
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, e)
+			case root != nil:
+				return nil, errors.New("parsing XML: multiple root elements")
+			default:
+				root = e
+			}
+			stack = append(stack, e)
+
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, errors.New("parsing XML: unexpected end element")
+			}
+			e := stack[len(stack)-1]
+			e.text = strings.TrimSpace(e.text)
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			if len(stack) != 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("parsing XML: missing root element")
+	}
+	if len(stack) != 0 {
+		return nil, errors.New("parsing XML: unexpected end of document")
+	}
+
+	return root, nil
+}
+
+// xmlName returns the name used for the XML element or attribute.
+func (c *Converter) xmlName(n xml.Name) string {
+	if c.preserveNS && n.Space != "" {
+		return n.Space + ":" + n.Local
+	}
+	return n.Local
+}
+
+// isNamespaceDeclaration returns whether the name of an attribute declares
+// a namespace.
+func isNamespaceDeclaration(n xml.Name) bool {
+	return n.Space == "xmlns" || n.Space == "" && n.Local == "xmlns"
+}
+
+func (c *Converter) writeJSONValue(buf *bytes.Buffer, e *element) {
+	if len(e.attrs) == 0 && len(e.children) == 0 {
+		writeJSONString(buf, e.text)
+		return
+	}
+
+	buf.WriteByte('{')
+
+	first := true
+	next := func() {
+		if !first {
+			buf.WriteString(", ")
+		}
+		first = false
+	}
+
+	if e.text != "" {
+		next()
+		writeJSONKey(buf, c.textKey)
+		writeJSONString(buf, e.text)
+	}
+
+	for _, a := range e.attrs {
+		next()
+		writeJSONKey(buf, c.attrPrefix+a.name)
+		writeJSONString(buf, a.value)
+	}
+
+	// group children by name, in order of first occurrence
+	var names []string
+	groups := make(map[string][]*element)
+	for _, child := range e.children {
+		if _, exists := groups[child.name]; !exists {
+			names = append(names, child.name)
+		}
+		groups[child.name] = append(groups[child.name], child)
+	}
+
+	for _, name := range names {
+		next()
+		writeJSONKey(buf, name)
+
+		group := groups[name]
+		if _, isArray := c.arrays[name]; len(group) == 1 && !isArray {
+			c.writeJSONValue(buf, group[0])
+			continue
+		}
+
+		buf.WriteByte('[')
+		for i, child := range group {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			c.writeJSONValue(buf, child)
+		}
+		buf.WriteByte(']')
+	}
+
+	buf.WriteByte('}')
+}
+
+func writeJSONKey(buf *bytes.Buffer, key string) {
+	writeJSONString(buf, key)
+	buf.WriteString(": ")
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	// marshaling a string never fails
+	b, _ := json.Marshal(s)
+	buf.Write(b)
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xmljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ToXML converts a JSON document to XML, reversing the conversion done by
+// ToJSON.
+//
+// Unless the name of the root element is set in the conventions, the JSON
+// document must be an object with a single member, that becomes the root
+// element. JSON arrays are converted to repeated elements.
+func (c *Converter) ToXML(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	v, err := decodeJSON(dec)
+	if err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("parsing JSON: unexpected data after the document")
+	}
+
+	var root *element
+
+	if c.rootElement != "" {
+		root = &element{name: c.rootElement}
+		if err := c.fill(root, v); err != nil {
+			return nil, err
+		}
+	} else {
+		obj, ok := v.(*object)
+		if !ok || len(obj.keys) != 1 {
+			return nil, errors.New("the JSON document must be an object with a single member " +
+				"when the name of the root element is not set")
+		}
+		if _, isArray := obj.values[0].([]interface{}); isArray {
+			return nil, errors.New("the root element cannot be an array")
+		}
+		if !isXMLName(obj.keys[0]) {
+			return nil, fmt.Errorf("invalid element name %q", obj.keys[0])
+		}
+
+		root = &element{name: obj.keys[0]}
+		if err := c.fill(root, obj.values[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(c.namespaces) != 0 {
+		root.attrs = append(append([]attribute{}, c.namespaces...), root.attrs...)
+	}
+
+	buf := bytes.NewBufferString(xml.Header)
+	writeXMLElement(buf, root)
+
+	return buf.Bytes(), nil
+}
+
+// fill sets the content of the element from the JSON value.
+func (c *Converter) fill(e *element, v interface{}) error {
+	switch val := v.(type) {
+	case *object:
+		for i, key := range val.keys {
+			if err := c.fillMember(e, key, val.values[i]); err != nil {
+				return err
+			}
+		}
+
+	case []interface{}:
+		return fmt.Errorf("element %q: arrays must be members of an object", e.name)
+
+	default:
+		e.text, _ = scalarText(val)
+	}
+
+	return nil
+}
+
+// fillMember adds the member of a JSON object to the element as its text,
+// an attribute or child elements.
+func (c *Converter) fillMember(e *element, key string, v interface{}) error {
+	switch {
+	case key == c.textKey:
+		text, ok := scalarText(v)
+		if !ok {
+			return fmt.Errorf("element %q: the text must be a scalar value", e.name)
+		}
+		e.text = text
+
+	case strings.HasPrefix(key, c.attrPrefix):
+		name := strings.TrimPrefix(key, c.attrPrefix)
+		if !isXMLName(name) {
+			return fmt.Errorf("element %q: invalid attribute name %q", e.name, name)
+		}
+		value, ok := scalarText(v)
+		if !ok {
+			return fmt.Errorf("element %q: attribute %q must be a scalar value", e.name, name)
+		}
+		e.attrs = append(e.attrs, attribute{name: name, value: value})
+
+	default:
+		if !isXMLName(key) {
+			return fmt.Errorf("element %q: invalid element name %q", e.name, key)
+		}
+
+		items, isArray := v.([]interface{})
+		if !isArray {
+			items = []interface{}{v}
+		}
+
+		for _, item := range items {
+			if _, nested := item.([]interface{}); nested {
+				return fmt.Errorf("element %q: nested arrays cannot be represented in XML", key)
+			}
+			child := &element{name: key}
+			if err := c.fill(child, item); err != nil {
+				return err
+			}
+			e.children = append(e.children, child)
+		}
+	}
+
+	return nil
+}
+
+// scalarText returns the text representation of a JSON scalar value.
+func scalarText(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case nil:
+		return "", true
+	case string:
+		return val, true
+	case json.Number:
+		return val.String(), true
+	case bool:
+		return strconv.FormatBool(val), true
+	default:
+		return "", false
+	}
+}
+
+func writeXMLElement(buf *bytes.Buffer, e *element) {
+	buf.WriteByte('<')
+	buf.WriteString(e.name)
+	for _, a := range e.attrs {
+		buf.WriteByte(' ')
+		buf.WriteString(a.name)
+		buf.WriteString(`="`)
+		// writing to a bytes.Buffer never fails
+		_ = xml.EscapeText(buf, []byte(a.value))
+		buf.WriteByte('"')
+	}
+
+	if e.text == "" && len(e.children) == 0 {
+		buf.WriteString("/>")
+		return
+	}
+
+	buf.WriteByte('>')
+	_ = xml.EscapeText(buf, []byte(e.text))
+	for _, child := range e.children {
+		writeXMLElement(buf, child)
+	}
+	buf.WriteString("</")
+	buf.WriteString(e.name)
+	buf.WriteByte('>')
+}
+
+// isXMLName returns whether the string is a valid XML name, optionally
+// prefixed with a namespace.
+func isXMLName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case unicode.IsLetter(r), r == '_', r == ':':
+		case i != 0 && (unicode.IsDigit(r) || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// object is a JSON object that keeps the order of its members.
+type object struct {
+	keys   []string
+	values []interface{}
+}
+
+// decodeJSON decodes the next JSON value from the decoder.
+func decodeJSON(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := &object{}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			val, err := decodeJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			// object keys are always strings
+			obj.keys = append(obj.keys, keyTok.(string))
+			obj.values = append(obj.values, val)
+		}
+		// consume the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+
+	case json.Delim('['):
+		arr := []interface{}{}
+		for dec.More() {
+			val, err := decodeJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, val)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+
+	default:
+		return tok, nil
+	}
+}
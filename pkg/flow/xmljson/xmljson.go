@@ -0,0 +1,116 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package xmljson converts XML documents to JSON and back following a set
+// of conventions, so that converting a document in both directions
+// preserves its structure.
+package xmljson
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Default conventions.
+const (
+	DefaultAttributePrefix = "-"
+	DefaultTextKey         = "#content"
+)
+
+// Conventions set how XML documents are represented as JSON.
+type Conventions struct {
+	// Prefix of the JSON keys that represent XML attributes.
+	AttributePrefix string `json:"attributePrefix,omitempty"`
+	// JSON key that holds the text of XML elements which also contain
+	// attributes or child elements.
+	TextKey string `json:"textKey,omitempty"`
+	// Names of the XML elements that are always represented as JSON
+	// arrays, even when they occur once.
+	ArrayElements []string `json:"arrayElements,omitempty"`
+	// Whether XML names keep their namespace prefix, along with the
+	// namespace declarations. Otherwise only local names are used.
+	PreserveNamespaces bool `json:"preserveNamespaces,omitempty"`
+	// Namespaces declared on the root element of the XML documents, by
+	// prefix. The empty prefix declares the default namespace.
+	Namespaces map[string]string `json:"namespaces,omitempty"`
+	// Name of the XML root element. When set, the root element is not
+	// represented in JSON documents.
+	RootElement string `json:"rootElement,omitempty"`
+}
+
+// Decode implements envconfig.Decoder for JSON encoded conventions.
+func (c *Conventions) Decode(value string) error {
+	return json.Unmarshal([]byte(value), c)
+}
+
+// Converter converts documents between XML and JSON.
+type Converter struct {
+	attrPrefix  string
+	textKey     string
+	arrays      map[string]struct{}
+	preserveNS  bool
+	namespaces  []attribute
+	rootElement string
+}
+
+// New returns a Converter for the given conventions. Empty values are
+// replaced with the default ones.
+func New(c Conventions) *Converter {
+	conv := &Converter{
+		attrPrefix:  c.AttributePrefix,
+		textKey:     c.TextKey,
+		arrays:      make(map[string]struct{}, len(c.ArrayElements)),
+		preserveNS:  c.PreserveNamespaces,
+		rootElement: c.RootElement,
+	}
+
+	if conv.attrPrefix == "" {
+		conv.attrPrefix = DefaultAttributePrefix
+	}
+	if conv.textKey == "" {
+		conv.textKey = DefaultTextKey
+	}
+
+	for _, e := range c.ArrayElements {
+		conv.arrays[e] = struct{}{}
+	}
+
+	for prefix, uri := range c.Namespaces {
+		name := "xmlns"
+		if prefix != "" {
+			name += ":" + prefix
+		}
+		conv.namespaces = append(conv.namespaces, attribute{name: name, value: uri})
+	}
+	sort.Slice(conv.namespaces, func(i, j int) bool {
+		return conv.namespaces[i].name < conv.namespaces[j].name
+	})
+
+	return conv
+}
+
+// element is the representation of XML elements shared by both conversions.
+type element struct {
+	name     string
+	attrs    []attribute
+	text     string
+	children []*element
+}
+
+type attribute struct {
+	name  string
+	value string
+}
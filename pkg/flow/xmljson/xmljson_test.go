@@ -0,0 +1,194 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xmljson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tSOAP = `<?xml version="1.0" encoding="UTF-8"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="urn:orders">` +
+	`<soap:Body><m:Order id="1" status="new"><m:Item sku="a">2</m:Item></m:Order></soap:Body>` +
+	`</soap:Envelope>`
+
+func TestToJSON(t *testing.T) {
+	testCases := map[string]struct {
+		conventions Conventions
+		xml         string
+		expectJSON  string
+		expectError bool
+	}{
+		"default conventions": {
+			xml:        `<note><to>Tove</to></note>`,
+			expectJSON: `{"note": {"to": "Tove"}}` + "\n",
+		},
+		"attributes, text and repeated elements": {
+			xml: `<order id="1"><item sku="a">2</item><item sku="b">1</item><note>ok</note></order>`,
+			expectJSON: `{"order": {"-id": "1", "item": [{"#content": "2", "-sku": "a"}, ` +
+				`{"#content": "1", "-sku": "b"}], "note": "ok"}}` + "\n",
+		},
+		"custom prefix, text key and arrays": {
+			conventions: Conventions{
+				AttributePrefix: "@",
+				TextKey:         "$",
+				ArrayElements:   []string{"item"},
+			},
+			xml:        `<order id="1"><item sku="a">2</item></order>`,
+			expectJSON: `{"order": {"@id": "1", "item": [{"$": "2", "@sku": "a"}]}}` + "\n",
+		},
+		"root element": {
+			conventions: Conventions{RootElement: "order"},
+			xml:         `<order><id>1</id></order>`,
+			expectJSON:  `{"id": "1"}` + "\n",
+		},
+		"stripped namespaces": {
+			xml:        tSOAP,
+			expectJSON: `{"Envelope": {"Body": {"Order": {"-id": "1", "-status": "new", "Item": {"#content": "2", "-sku": "a"}}}}}` + "\n",
+		},
+		"preserved namespaces": {
+			conventions: Conventions{PreserveNamespaces: true},
+			xml:         tSOAP,
+			expectJSON: `{"soap:Envelope": {"-xmlns:soap": "http://schemas.xmlsoap.org/soap/envelope/", "-xmlns:m": "urn:orders", ` +
+				`"soap:Body": {"m:Order": {"-id": "1", "-status": "new", "m:Item": {"#content": "2", "-sku": "a"}}}}}` + "\n",
+		},
+		"escaped text": {
+			xml:        `<a>&lt;&quot;b&quot;&gt;</a>`,
+			expectJSON: `{"a": "\u003c\"b\"\u003e"}` + "\n",
+		},
+		"invalid XML": {
+			xml:         `<a><b></a>`,
+			expectError: true,
+		},
+		"not XML": {
+			xml:         `this is not XML`,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			out, err := New(tc.conventions).ToJSON([]byte(tc.xml))
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectJSON, string(out))
+		})
+	}
+}
+
+func TestToXML(t *testing.T) {
+	const header = `<?xml version="1.0" encoding="UTF-8"?>` + "\n"
+
+	testCases := map[string]struct {
+		conventions Conventions
+		json        string
+		expectXML   string
+		expectError bool
+	}{
+		"default conventions": {
+			json:      `{"note": {"to": "Tove", "from": "Jani"}}`,
+			expectXML: header + `<note><to>Tove</to><from>Jani</from></note>`,
+		},
+		"attributes, text and arrays": {
+			json: `{"order": {"-id": 1, "item": [{"#content": "2", "-sku": "a"}, {"-sku": "b"}], "paid": true, "note": null}}`,
+			expectXML: header + `<order id="1"><item sku="a">2</item><item sku="b"/>` +
+				`<paid>true</paid><note/></order>`,
+		},
+		"custom prefix and text key": {
+			conventions: Conventions{AttributePrefix: "@", TextKey: "$"},
+			json:        `{"item": {"@sku": "a", "$": 2}}`,
+			expectXML:   header + `<item sku="a">2</item>`,
+		},
+		"root element and namespaces": {
+			conventions: Conventions{
+				RootElement: "m:Order",
+				Namespaces: map[string]string{
+					"m": "urn:orders",
+					"":  "urn:default",
+				},
+			},
+			json:      `{"m:Item": ["a", "b"]}`,
+			expectXML: header + `<m:Order xmlns="urn:default" xmlns:m="urn:orders"><m:Item>a</m:Item><m:Item>b</m:Item></m:Order>`,
+		},
+		"escaped values": {
+			json:      `{"a": {"-q": "\"x\"", "#content": "<b> & c"}}`,
+			expectXML: header + `<a q="&#34;x&#34;">&lt;b&gt; &amp; c</a>`,
+		},
+		"multiple root members": {
+			json:        `{"a": 1, "b": 2}`,
+			expectError: true,
+		},
+		"root array": {
+			json:        `{"a": [1, 2]}`,
+			expectError: true,
+		},
+		"nested arrays": {
+			json:        `{"a": {"b": [[1], [2]]}}`,
+			expectError: true,
+		},
+		"non scalar attribute": {
+			json:        `{"a": {"-b": {"c": 1}}}`,
+			expectError: true,
+		},
+		"invalid element name": {
+			json:        `{"a": {"1b": 1}}`,
+			expectError: true,
+		},
+		"invalid JSON": {
+			json:        `{"a": `,
+			expectError: true,
+		},
+		"trailing data": {
+			json:        `{"a": 1} {"b": 2}`,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			out, err := New(tc.conventions).ToXML([]byte(tc.json))
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectXML, string(out))
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	c := New(Conventions{
+		PreserveNamespaces: true,
+		ArrayElements:      []string{"m:Item"},
+	})
+
+	json, err := c.ToJSON([]byte(tSOAP))
+	require.NoError(t, err)
+
+	xml, err := c.ToXML(json)
+	require.NoError(t, err)
+
+	assert.Equal(t, tSOAP, string(xml))
+}
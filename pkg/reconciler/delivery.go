@@ -0,0 +1,57 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/resolver"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// ResolveDeadLetterSink resolves the URL of the dead letter sink defined in
+// the delivery spec of a component instance. A nil URL is returned when the
+// delivery spec does not define a dead letter sink.
+func ResolveDeadLetterSink(ctx context.Context, r *resolver.URIResolver,
+	delivery *eventingduckv1.DeliverySpec) (*apis.URL, error) {
+
+	if delivery == nil || delivery.DeadLetterSink == nil {
+		return nil, nil
+	}
+
+	rcl := v1alpha1.ReconcilableFromContext(ctx)
+
+	dls := delivery.DeadLetterSink.DeepCopy()
+	if dlsRef := dls.Ref; dlsRef != nil && dlsRef.Namespace == "" {
+		dlsRef.Namespace = rcl.GetNamespace()
+	}
+
+	uri, err := r.URIFromDestinationV1(ctx, *dls, rcl)
+	if err != nil {
+		return nil, controller.NewPermanentError(reconciler.NewEvent(corev1.EventTypeWarning,
+			ReasonBadSinkURI, "Could not resolve dead letter sink URI: %s", err))
+	}
+
+	return uri, nil
+}
@@ -134,6 +134,16 @@ func (l *Listers) GetJQTransformationLister() flowlistersv1alpha1.JQTransformati
 	return flowlistersv1alpha1.NewJQTransformationLister(l.IndexerFor(&flowv1alpha1.JQTransformation{}))
 }
 
+// GetJSONToXMLTransformationLister returns a Lister for JSONToXMLTransformation objects.
+func (l *Listers) GetJSONToXMLTransformationLister() flowlistersv1alpha1.JSONToXMLTransformationLister {
+	return flowlistersv1alpha1.NewJSONToXMLTransformationLister(l.IndexerFor(&flowv1alpha1.JSONToXMLTransformation{}))
+}
+
+// GetSchemaValidationLister returns a Lister for SchemaValidation objects.
+func (l *Listers) GetSchemaValidationLister() flowlistersv1alpha1.SchemaValidationLister {
+	return flowlistersv1alpha1.NewSchemaValidationLister(l.IndexerFor(&flowv1alpha1.SchemaValidation{}))
+}
+
 // GetSynchronizerLister returns a Lister for Synchronizer objects.
 func (l *Listers) GetSynchronizerLister() flowlistersv1alpha1.SynchronizerLister {
 	return flowlistersv1alpha1.NewSynchronizerLister(l.IndexerFor(&flowv1alpha1.Synchronizer{}))
@@ -0,0 +1,289 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"go.uber.org/zap"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/aggregator"
+	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
+)
+
+const serverPort int = 8080
+
+// expirationInterval is the period of the checks of the groups timeouts.
+const expirationInterval = time.Second
+
+// emitRetryInterval is the delay before emitting again an expired group
+// which combined event could not be delivered.
+const emitRetryInterval = 10 * time.Second
+
+// maxGroups is the maximum number of groups kept in memory. Events which
+// would create more groups are rejected.
+const maxGroups = 10000
+
+// Handler parses Cloud Events, collects the correlated events, and sends
+// the combined events to a subscriber.
+type Handler struct {
+	// receiver receives incoming HTTP requests
+	receiver *kncloudevents.HTTPMessageReceiver
+	// forwarder sends requests to downstream services
+	forwarder *forward.Forwarder
+
+	aggregatorLister routinglisters.AggregatorNamespaceLister
+	logger           *zap.SugaredLogger
+
+	// aggregations is the map of aggregator refs with compiled specs
+	aggregations *aggregationStorage
+	// groups keeps the collected events until their groups are complete
+	groups groupStore
+}
+
+// NewEnvConfig satisfies env.ConfigConstructor.
+func NewEnvConfig() env.ConfigAccessor {
+	return &env.Config{}
+}
+
+// NewAdapter returns a constructor for the source's adapter.
+func NewAdapter(string) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, _ pkgadapter.EnvConfigAccessor, _ cloudevents.Client) pkgadapter.Adapter {
+		logger := logging.FromContext(ctx)
+
+		sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+		if err != nil {
+			logger.Panicf("failed to create message sender: %v", err)
+		}
+
+		informer := informerv1alpha1.Get(ctx)
+		ns := injection.GetNamespaceScope(ctx)
+
+		return &Handler{
+			receiver:         kncloudevents.NewHTTPMessageReceiver(serverPort),
+			forwarder:        forward.New(delivery.NewSender(sender), logger),
+			aggregatorLister: informer.Lister().Aggregators(ns),
+			logger:           logger,
+
+			aggregations: newAggregationStorage(),
+			groups:       newMemoryStore(maxGroups),
+		}
+	}
+}
+
+// Start begins to receive messages for the handler.
+//
+// HTTP POST requests to the root path (/) are accepted.
+//
+// This method will block until ctx is done.
+func (h *Handler) Start(ctx context.Context) error {
+	go h.runExpiration(ctx)
+	return h.receiver.StartListen(ctx, h)
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	aggregator, err := forward.ParseRequestURI(request.URL.Path)
+	if err != nil {
+		h.logger.Errorw("Unable to parse path as aggregator", zap.Error(err), zap.String("path", request.RequestURI))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := request.Context()
+
+	message := cehttp.NewMessageFromHttpRequest(request)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		h.logger.Errorw("Failed to extract event from request", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Debug("Received message", zap.Any("aggregator", aggregator))
+
+	a, err := h.aggregatorLister.Get(aggregator)
+	if err != nil {
+		h.logger.Errorw("Unable to get the Aggregator", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	agg, err := h.aggregation(a)
+	if err != nil {
+		h.logger.Errorw("Failed to compile the Aggregator spec", zap.Error(err), zap.Any("aggregator", aggregator))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	correlation, ok := agg.correlation(event)
+	if !ok {
+		h.logger.Errorw("Event does not have the correlation attribute",
+			zap.String("attribute", agg.key), zap.String("id", event.ID()))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	key := groupKey{aggregator: a.UID, name: a.Name, correlation: correlation}
+	g, err := h.groups.add(key, event, agg.timeout)
+	if err != nil {
+		h.logger.Errorw("Failed to store the event", zap.Error(err))
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !agg.completed(g) {
+		writer.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	// the group may have been completed by a concurrent request
+	if g, err = h.groups.remove(key); err != nil || g == nil {
+		if err != nil {
+			h.logger.Errorw("Failed to remove the group", zap.Error(err))
+		}
+		writer.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if err := h.emit(ctx, a, agg, g); err != nil {
+		h.logger.Errorw("Failed to emit the combined event", zap.Error(err), zap.String("correlation", correlation))
+		// keep the events so that a redelivery of the request completes the group again
+		if err := h.groups.restore(g); err != nil {
+			h.logger.Errorw("Failed to restore the group", zap.Error(err), zap.String("correlation", correlation))
+		}
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// aggregation returns the compiled spec of the Aggregator.
+func (h *Handler) aggregation(a *v1alpha1.Aggregator) (*aggregation, error) {
+	agg, exists := h.aggregations.get(a.UID, a.Generation)
+	if exists {
+		return agg, nil
+	}
+
+	agg, err := newAggregation(&a.Spec)
+	if err != nil {
+		return nil, err
+	}
+	h.aggregations.set(a.UID, a.Generation, agg)
+	return agg, nil
+}
+
+// emit combines the events of the group and sends the result to the sink
+// of the Aggregator.
+func (h *Handler) emit(ctx context.Context, a *v1alpha1.Aggregator, agg *aggregation, g *group) error {
+	combined, err := agg.combine(g)
+	if err != nil {
+		return err
+	}
+
+	opts := delivery.Options{
+		Spec:           a.Spec.Delivery,
+		DeadLetterSink: a.Status.DeadLetterSinkURI,
+	}
+
+	if err := h.forwarder.Deliver(ctx, nil, a.Status.SinkURI.String(), combined, opts); err != nil {
+		return err
+	}
+
+	h.logger.Debugw("Sent combined event", zap.String("id", combined.ID()), zap.Int("events", len(g.events)))
+	return nil
+}
+
+// runExpiration periodically emits the groups which timeout is over.
+func (h *Handler) runExpiration(ctx context.Context) {
+	ticker := time.NewTicker(expirationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			h.expire(ctx, now)
+		}
+	}
+}
+
+// expire emits the groups which timeout is over.
+func (h *Handler) expire(ctx context.Context, now time.Time) {
+	groups, err := h.groups.expired(now)
+	if err != nil {
+		h.logger.Errorw("Failed to read expired groups", zap.Error(err))
+		return
+	}
+
+	for _, g := range groups {
+		a, err := h.aggregatorLister.Get(g.key.name)
+		if err != nil {
+			h.logger.Errorw("Unable to get the Aggregator of an expired group", zap.Error(err),
+				zap.String("aggregator", g.key.name))
+			continue
+		}
+		if a.UID != g.key.aggregator {
+			h.logger.Errorw("Dropping an expired group of a deleted Aggregator",
+				zap.String("aggregator", g.key.name), zap.String("correlation", g.key.correlation))
+			continue
+		}
+
+		agg, err := h.aggregation(a)
+		if err != nil {
+			h.logger.Errorw("Failed to compile the Aggregator spec", zap.Error(err),
+				zap.String("aggregator", g.key.name))
+			continue
+		}
+
+		if err := h.emit(ctx, a, agg, g); err != nil {
+			h.logger.Errorw("Failed to emit the combined event of an expired group", zap.Error(err),
+				zap.String("correlation", g.key.correlation))
+
+			g.expires = now.Add(emitRetryInterval)
+			if err := h.groups.restore(g); err != nil {
+				h.logger.Errorw("Failed to restore the expired group", zap.Error(err),
+					zap.String("correlation", g.key.correlation))
+			}
+		}
+	}
+}
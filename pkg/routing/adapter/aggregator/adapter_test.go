@@ -0,0 +1,242 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/rest"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	common "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
+	fakeinformer "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/aggregator/fake"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
+)
+
+const (
+	tNS         = "test-namespace"
+	tAggregator = "aggregator"
+)
+
+func TestAdapter(t *testing.T) {
+	ip, port := testServerSocket(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	received, failing, sinkURL := setupSink(t)
+	ctx = setupFakeInformers(t, ctx, sinkURL)
+
+	h := newHandler(t, ctx, port)
+	go func() {
+		if err := h.Start(ctx); err != nil {
+			assert.FailNow(t, "could not start test adapter", err)
+		}
+	}()
+	<-h.receiver.Ready
+
+	addr := fmt.Sprintf("http://%s:%d/%s/%s", ip, port, tNS, tAggregator)
+
+	t.Run("split events are reassembled", func(t *testing.T) {
+		status := sendCE(t, newEvent(t, "p1-1", `{"id":2}`, map[string]interface{}{"parentid": "p1", "index": 1, "total": 2}), addr)
+		assert.Equal(t, http.StatusAccepted, status)
+		assert.Empty(t, received)
+
+		status = sendCE(t, newEvent(t, "p1-0", `{"id":1}`, map[string]interface{}{"parentid": "p1", "index": 0, "total": 2}), addr)
+		assert.Equal(t, http.StatusOK, status)
+
+		require.Len(t, received, 1)
+		assert.JSONEq(t, `[{"id":1},{"id":2}]`, <-received)
+	})
+
+	t.Run("incomplete group is emitted after the timeout", func(t *testing.T) {
+		status := sendCE(t, newEvent(t, "p2-0", `{"id":1}`, map[string]interface{}{"parentid": "p2", "index": 0, "total": 2}), addr)
+		assert.Equal(t, http.StatusAccepted, status)
+
+		h.expire(ctx, time.Now().Add(2*time.Minute))
+
+		require.Len(t, received, 1)
+		assert.JSONEq(t, `[{"id":1}]`, <-received)
+	})
+
+	t.Run("undelivered group is kept until the request is redelivered", func(t *testing.T) {
+		status := sendCE(t, newEvent(t, "p3-0", `{"id":1}`, map[string]interface{}{"parentid": "p3", "index": 0, "total": 2}), addr)
+		assert.Equal(t, http.StatusAccepted, status)
+
+		atomic.StoreInt32(failing, 1)
+		last := newEvent(t, "p3-1", `{"id":2}`, map[string]interface{}{"parentid": "p3", "index": 1, "total": 2})
+		status = sendCE(t, last, addr)
+		assert.Equal(t, http.StatusInternalServerError, status)
+		assert.Empty(t, received)
+
+		atomic.StoreInt32(failing, 0)
+		status = sendCE(t, last, addr)
+		assert.Equal(t, http.StatusOK, status)
+
+		require.Len(t, received, 1)
+		assert.JSONEq(t, `[{"id":1},{"id":2}]`, <-received)
+	})
+
+	t.Run("undelivered expired group is emitted again", func(t *testing.T) {
+		status := sendCE(t, newEvent(t, "p4-0", `{"id":1}`, map[string]interface{}{"parentid": "p4", "index": 0, "total": 2}), addr)
+		assert.Equal(t, http.StatusAccepted, status)
+
+		now := time.Now().Add(2 * time.Minute)
+
+		atomic.StoreInt32(failing, 1)
+		h.expire(ctx, now)
+		assert.Empty(t, received)
+
+		atomic.StoreInt32(failing, 0)
+		h.expire(ctx, now)
+		assert.Empty(t, received, "group emitted again before the retry interval")
+
+		h.expire(ctx, now.Add(2*emitRetryInterval))
+		require.Len(t, received, 1)
+		assert.JSONEq(t, `[{"id":1}]`, <-received)
+	})
+
+	t.Run("event without correlation attribute", func(t *testing.T) {
+		status := sendCE(t, newEvent(t, "x", `{}`, nil), addr)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+}
+
+// sendCE sends the event and returns the HTTP status of the response.
+func sendCE(t *testing.T, event *cloudevents.Event, sink string) int {
+	ctx := cloudevents.ContextWithTarget(context.Background(), sink)
+	c, err := cloudevents.NewClientHTTP()
+	require.NoError(t, err)
+
+	result := c.Send(ctx, *event)
+
+	var httpResult *cehttp.Result
+	require.True(t, cloudevents.ResultAs(result, &httpResult), "Unexpected result: %v", result)
+	return httpResult.StatusCode
+}
+
+func setupFakeInformers(t *testing.T, ctx context.Context, sinkURI *apis.URL) context.Context {
+	a := &v1alpha1.Aggregator{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  tNS,
+			Name:       tAggregator,
+			UID:        uuid.NewUUID(),
+			Generation: 1,
+		},
+		Status: v1alpha1.AggregatorStatus{
+			Status: common.Status{
+				SourceStatus: duckv1.SourceStatus{
+					SinkURI: sinkURI,
+				},
+			},
+		},
+	}
+	a.SetDefaults(ctx)
+
+	injection.Fake.RegisterClient(func(ctx context.Context, _ *rest.Config) context.Context {
+		ctx, _ = fakeinjectionclient.With(ctx, a)
+		return ctx
+	})
+
+	var infs []controller.Informer
+	ctx, infs = injection.Fake.SetupInformers(ctx, &rest.Config{})
+	err := controller.StartInformers(ctx.Done(), infs...)
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func newHandler(t *testing.T, ctx context.Context, port int) *Handler {
+	sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+	assert.NoError(t, err)
+
+	return &Handler{
+		receiver:         kncloudevents.NewHTTPMessageReceiver(port),
+		forwarder:        forward.New(delivery.NewSender(sender), logtesting.TestLogger(t)),
+		aggregatorLister: fakeinformer.Get(ctx).Lister().Aggregators(tNS),
+		logger:           logtesting.TestLogger(t),
+
+		aggregations: newAggregationStorage(),
+		groups:       newMemoryStore(0),
+	}
+}
+
+// setupSink returns a channel that receives the payloads of the
+// combined events. The aggregator replies after the delivery is done.
+// The sink rejects the events while the returned flag is set to 1.
+func setupSink(t *testing.T) (chan string, *int32, *apis.URL) {
+	c := make(chan string, 4)
+	var failing int32
+
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		c <- string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(sink.Close)
+
+	u, err := apis.ParseURL(sink.URL)
+	require.NoError(t, err)
+	return c, &failing, u
+}
+
+// testServerSocket returns a local IP and port where HTTP server can safely listen on.
+func testServerSocket(t *testing.T) (net.IP, int) {
+	t.Helper()
+
+	// hack: Create a net.Listener on a random port, close it, and return its address.
+	// This guarantees that the address:port combination is free on the local host.
+	// See http/httptest.newLocalListener()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		if l, err = net.Listen("tcp6", "[::1]:0"); err != nil {
+			t.Fatalf("failed to listen on a port: %v", err)
+		}
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).IP, l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/tidwall/gjson"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventsplit"
+)
+
+// aggregation is the compiled spec of an Aggregator.
+type aggregation struct {
+	key string
+
+	count      int
+	size       int
+	timeout    time.Duration
+	completion *cel.ConditionalFilter
+
+	merge     v1alpha1.AggregatorMergeStrategy
+	ceContext *v1alpha1.AggregatedEventContext
+}
+
+// newAggregation compiles the spec of the Aggregator.
+func newAggregation(spec *v1alpha1.AggregatorSpec) (*aggregation, error) {
+	a := &aggregation{
+		key:       spec.CorrelationKey,
+		timeout:   v1alpha1.DefaultAggregatorTimeout,
+		merge:     spec.Merge,
+		ceContext: spec.CEContext,
+	}
+
+	if a.key == "" {
+		a.key = v1alpha1.DefaultAggregatorCorrelationKey
+	}
+	if spec.Count != nil {
+		a.count = *spec.Count
+	}
+	if spec.Size != nil {
+		a.size = *spec.Size
+	}
+	if spec.Timeout != nil {
+		a.timeout = time.Duration(*spec.Timeout)
+	}
+
+	if spec.Completion != "" {
+		filter, err := cel.CompileExpression(spec.Completion)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compile completion expression: %w", err)
+		}
+		a.completion = &filter
+	}
+
+	return a, nil
+}
+
+// correlation returns the value of the correlation attribute of the event.
+func (a *aggregation) correlation(event *cloudevents.Event) (string, bool) {
+	switch a.key {
+	case "id":
+		return event.ID(), true
+	case "source":
+		return event.Source(), true
+	case "type":
+		return event.Type(), true
+	case "subject":
+		return event.Subject(), event.Subject() != ""
+	}
+	val, exists := event.Extensions()[a.key]
+	if !exists {
+		return "", false
+	}
+	str, err := types.ToString(val)
+	return str, err == nil && str != ""
+}
+
+// completed returns true if the collected events complete the group.
+// When the count is not set, split events are collected until their
+// "total" extension is reached.
+func (a *aggregation) completed(g *group) bool {
+	if len(g.events) == 0 {
+		return false
+	}
+
+	count := a.count
+	if count == 0 {
+		if total, err := types.ToInteger(g.events[0].Extensions()[eventsplit.ExtensionTotal]); err == nil {
+			count = int(total)
+		}
+	}
+	if count > 0 && len(g.events) >= count {
+		return true
+	}
+
+	if a.size > 0 && g.size >= a.size {
+		return true
+	}
+
+	if a.completion == nil {
+		return false
+	}
+	last := g.events[len(g.events)-1]
+	done, err := a.completion.EvaluateEvent(last, func(path string) gjson.Result {
+		return gjson.GetBytes(last.Data(), path)
+	})
+	return err == nil && done
+}
+
+// combine merges the events of the group into a single event. Events are
+// ordered by their "index" extension when they have one. The ID of the
+// combined event is the correlation value, e.g. the ID of the split event.
+func (a *aggregation) combine(g *group) (*cloudevents.Event, error) {
+	if len(g.events) == 0 {
+		return nil, fmt.Errorf("no events to combine")
+	}
+
+	events := append([]*cloudevents.Event(nil), g.events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		return index(events[i]) < index(events[j])
+	})
+
+	data := make([]interface{}, 0, len(events))
+	for _, e := range events {
+		p := payload(e)
+		if a.merge == v1alpha1.AggregatorMergeConcat {
+			var items []json.RawMessage
+			if raw, ok := p.(json.RawMessage); ok && json.Unmarshal(raw, &items) == nil {
+				for _, item := range items {
+					data = append(data, item)
+				}
+				continue
+			}
+		}
+		data = append(data, p)
+	}
+
+	combined := events[0].Clone()
+	combined.SetID(g.key.correlation)
+	for _, ext := range []string{a.key, eventsplit.ExtensionIndex, eventsplit.ExtensionTotal} {
+		if _, exists := combined.Extensions()[ext]; exists {
+			combined.SetExtension(ext, nil)
+		}
+	}
+
+	if c := a.ceContext; c != nil {
+		if c.Type != "" {
+			combined.SetType(c.Type)
+		}
+		if c.Source != "" {
+			combined.SetSource(c.Source)
+		}
+		for k, v := range c.Extensions {
+			combined.SetExtension(k, v)
+		}
+	}
+
+	if err := combined.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return nil, fmt.Errorf("cannot encode combined payload: %w", err)
+	}
+	combined.DataBase64 = false
+
+	return &combined, nil
+}
+
+// index returns the position of a split event, or -1 if the event
+// was not emitted by a Splitter, which keeps the arrival order.
+func index(event *cloudevents.Event) int32 {
+	i, err := types.ToInteger(event.Extensions()[eventsplit.ExtensionIndex])
+	if err != nil {
+		return -1
+	}
+	return i
+}
+
+// payload returns the JSON payload of the event as is,
+// other payloads are returned as strings.
+func payload(event *cloudevents.Event) interface{} {
+	if json.Valid(event.Data()) {
+		return json.RawMessage(event.Data())
+	}
+	return string(event.Data())
+}
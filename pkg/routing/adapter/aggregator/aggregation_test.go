@@ -0,0 +1,148 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+func TestCompleted(t *testing.T) {
+	two := 2
+	ten := 10
+
+	testCases := map[string]struct {
+		spec   v1alpha1.AggregatorSpec
+		events []*cloudevents.Event
+		expect bool
+	}{
+		"count reached": {
+			spec:   v1alpha1.AggregatorSpec{Count: &two},
+			events: []*cloudevents.Event{newEvent(t, "1", `1`, nil), newEvent(t, "2", `2`, nil)},
+			expect: true,
+		},
+		"count not reached": {
+			spec:   v1alpha1.AggregatorSpec{Count: &two},
+			events: []*cloudevents.Event{newEvent(t, "1", `1`, nil)},
+		},
+		"total extension reached": {
+			events: []*cloudevents.Event{
+				newEvent(t, "1", `1`, map[string]interface{}{"index": 0, "total": 2}),
+				newEvent(t, "2", `2`, map[string]interface{}{"index": 1, "total": 2}),
+			},
+			expect: true,
+		},
+		"total extension not reached": {
+			events: []*cloudevents.Event{
+				newEvent(t, "1", `1`, map[string]interface{}{"index": 0, "total": 2}),
+			},
+		},
+		"size reached": {
+			spec:   v1alpha1.AggregatorSpec{Size: &ten},
+			events: []*cloudevents.Event{newEvent(t, "1", `"abcdef"`, nil), newEvent(t, "2", `"ghijkl"`, nil)},
+			expect: true,
+		},
+		"completion expression matched": {
+			spec:   v1alpha1.AggregatorSpec{Completion: `data.last == true`},
+			events: []*cloudevents.Event{newEvent(t, "1", `{"last":false}`, nil), newEvent(t, "2", `{"last":true}`, nil)},
+			expect: true,
+		},
+		"completion expression not matched": {
+			spec:   v1alpha1.AggregatorSpec{Completion: `data.last == true`},
+			events: []*cloudevents.Event{newEvent(t, "1", `{"last":false}`, nil)},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			agg, err := newAggregation(&tc.spec)
+			require.NoError(t, err)
+
+			g := &group{events: tc.events}
+			for _, e := range tc.events {
+				g.size += len(e.Data())
+			}
+			assert.Equal(t, tc.expect, agg.completed(g))
+		})
+	}
+}
+
+func TestCombine(t *testing.T) {
+	testCases := map[string]struct {
+		merge      v1alpha1.AggregatorMergeStrategy
+		events     []*cloudevents.Event
+		expectData string
+	}{
+		"array in index order": {
+			merge: v1alpha1.AggregatorMergeArray,
+			events: []*cloudevents.Event{
+				newEvent(t, "p-1", `{"id":2}`, map[string]interface{}{"parentid": "p", "index": 1, "total": 2}),
+				newEvent(t, "p-0", `{"id":1}`, map[string]interface{}{"parentid": "p", "index": 0, "total": 2}),
+			},
+			expectData: `[{"id":1},{"id":2}]`,
+		},
+		"concatenated chunks": {
+			merge: v1alpha1.AggregatorMergeConcat,
+			events: []*cloudevents.Event{
+				newEvent(t, "p-0", `[1,2]`, map[string]interface{}{"parentid": "p", "index": 0, "total": 2}),
+				newEvent(t, "p-1", `[3]`, map[string]interface{}{"parentid": "p", "index": 1, "total": 2}),
+			},
+			expectData: `[1,2,3]`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			agg, err := newAggregation(&v1alpha1.AggregatorSpec{
+				Merge:     tc.merge,
+				CEContext: &v1alpha1.AggregatedEventContext{Type: "orders.batch"},
+			})
+			require.NoError(t, err)
+
+			combined, err := agg.combine(&group{
+				key:    groupKey{aggregator: "test", correlation: "p"},
+				events: tc.events,
+			})
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tc.expectData, string(combined.Data()))
+			assert.Equal(t, "p", combined.ID())
+			assert.Equal(t, "orders.batch", combined.Type())
+			assert.Empty(t, combined.Extensions())
+		})
+	}
+}
+
+func newEvent(t *testing.T, id, data string, extensions map[string]interface{}) *cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetType("ce.test.type")
+	event.SetSource("ce.test.source")
+	for k, v := range extensions {
+		event.SetExtension(k, v)
+	}
+	require.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(data)))
+	return &event
+}
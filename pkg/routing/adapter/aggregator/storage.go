@@ -0,0 +1,61 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type aggregatorGenerations map[int64]*aggregation
+type aggregatorUIDs map[types.UID]aggregatorGenerations
+
+type aggregationStorage struct {
+	*sync.RWMutex
+	aggregatorUIDs
+}
+
+func newAggregationStorage() *aggregationStorage {
+	return &aggregationStorage{
+		RWMutex:        &sync.RWMutex{},
+		aggregatorUIDs: make(aggregatorUIDs),
+	}
+}
+
+func (a *aggregationStorage) get(uid types.UID, generation int64) (*aggregation, bool) {
+	a.RLock()
+	defer a.RUnlock()
+
+	aggregatorGens, exist := a.aggregatorUIDs[uid]
+	if !exist {
+		return nil, false
+	}
+
+	agg, exist := aggregatorGens[generation]
+	return agg, exist
+}
+
+// set method overrides previous generations of compiled aggregations
+func (a *aggregationStorage) set(uid types.UID, generation int64, agg *aggregation) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.aggregatorUIDs[uid] = aggregatorGenerations{
+		generation: agg,
+	}
+}
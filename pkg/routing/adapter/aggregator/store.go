@@ -0,0 +1,182 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// groupKey identifies a group of correlated events.
+type groupKey struct {
+	// aggregator is the UID of the Aggregator that collects the group, so
+	// that an Aggregator recreated with the same name starts with no groups.
+	aggregator types.UID
+	// name is the name of the Aggregator, used to read its spec.
+	name string
+	// correlation is the value of the correlation attribute of the events.
+	correlation string
+}
+
+// group is a set of correlated events.
+type group struct {
+	key     groupKey
+	events  []*cloudevents.Event
+	size    int
+	expires time.Time
+}
+
+// groupStore keeps the groups of events until they are complete.
+// Implementations must be safe for concurrent use.
+type groupStore interface {
+	// add appends the event to its group, which is created with the given
+	// lifetime if it does not exist, and returns a copy of the group.
+	// Events with the ID of an event of the group are ignored.
+	add(key groupKey, event *cloudevents.Event, ttl time.Duration) (*group, error)
+	// remove deletes the group and returns it, nil if the group does not exist.
+	remove(key groupKey) (*group, error)
+	// expired deletes the groups which lifetime is over and returns them.
+	expired(now time.Time) ([]*group, error)
+	// restore puts back a removed group which could not be emitted. Its
+	// events are merged into the group that may have been created with the
+	// same key in the meantime.
+	restore(g *group) error
+}
+
+// errStoreFull is returned when an event would create a group beyond the
+// capacity of the store.
+var errStoreFull = errors.New("maximum number of groups reached")
+
+var _ groupStore = (*memoryStore)(nil)
+
+// memoryStore keeps the groups in the adapter memory. The groups do not
+// survive restarts of the adapter, and are not shared between replicas,
+// which is why the adapter runs as a single replica.
+type memoryStore struct {
+	sync.Mutex
+	groups map[groupKey]*group
+	// maxGroups is the maximum number of groups, unlimited if zero.
+	maxGroups int
+}
+
+// newMemoryStore returns an empty in-memory group store which holds up to
+// maxGroups groups.
+func newMemoryStore(maxGroups int) *memoryStore {
+	return &memoryStore{
+		groups:    make(map[groupKey]*group),
+		maxGroups: maxGroups,
+	}
+}
+
+// add implements groupStore.
+func (s *memoryStore) add(key groupKey, event *cloudevents.Event, ttl time.Duration) (*group, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	g, exists := s.groups[key]
+	if !exists {
+		if s.maxGroups > 0 && len(s.groups) >= s.maxGroups {
+			return nil, errStoreFull
+		}
+		g = &group{
+			key:     key,
+			expires: time.Now().Add(ttl),
+		}
+		s.groups[key] = g
+	}
+
+	if !g.contains(event.ID()) {
+		g.events = append(g.events, event)
+		g.size += len(event.Data())
+	}
+
+	return g.copy(), nil
+}
+
+// remove implements groupStore.
+func (s *memoryStore) remove(key groupKey) (*group, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	g, exists := s.groups[key]
+	if !exists {
+		return nil, nil
+	}
+	delete(s.groups, key)
+	return g, nil
+}
+
+// expired implements groupStore.
+func (s *memoryStore) expired(now time.Time) ([]*group, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	var groups []*group
+	for k, g := range s.groups {
+		if now.After(g.expires) {
+			groups = append(groups, g)
+			delete(s.groups, k)
+		}
+	}
+	return groups, nil
+}
+
+// restore implements groupStore.
+func (s *memoryStore) restore(g *group) error {
+	s.Lock()
+	defer s.Unlock()
+
+	current, exists := s.groups[g.key]
+	if !exists {
+		s.groups[g.key] = g
+		return nil
+	}
+
+	for _, e := range current.events {
+		if !g.contains(e.ID()) {
+			g.events = append(g.events, e)
+			g.size += len(e.Data())
+		}
+	}
+	if current.expires.Before(g.expires) {
+		g.expires = current.expires
+	}
+	s.groups[g.key] = g
+	return nil
+}
+
+// contains returns whether the group has an event with the given ID.
+func (g *group) contains(id string) bool {
+	for _, e := range g.events {
+		if e.ID() == id {
+			return true
+		}
+	}
+	return false
+}
+
+// copy returns a shallow copy of the group.
+func (g *group) copy() *group {
+	c := *g
+	c.events = append([]*cloudevents.Event(nil), g.events...)
+	return &c
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	s := newMemoryStore(0)
+	key := groupKey{aggregator: "test", correlation: "p"}
+
+	g, err := s.add(key, newEvent(t, "1", `1`, nil), time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, g.events, 1)
+
+	// redelivered events are ignored
+	g, err = s.add(key, newEvent(t, "1", `1`, nil), time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, g.events, 1)
+
+	g, err = s.add(key, newEvent(t, "2", `22`, nil), time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, g.events, 2)
+	assert.Equal(t, 3, g.size)
+
+	other := groupKey{aggregator: "test", correlation: "q"}
+	_, err = s.add(other, newEvent(t, "3", `3`, nil), time.Millisecond)
+	require.NoError(t, err)
+
+	expired, err := s.expired(time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, other, expired[0].key)
+
+	g, err = s.remove(key)
+	require.NoError(t, err)
+	require.NotNil(t, g)
+	assert.Len(t, g.events, 2)
+
+	g, err = s.remove(key)
+	require.NoError(t, err)
+	assert.Nil(t, g)
+}
+
+func TestMemoryStoreRecreatedAggregator(t *testing.T) {
+	s := newMemoryStore(0)
+
+	_, err := s.add(groupKey{aggregator: "uid-1", name: "test", correlation: "p"}, newEvent(t, "1", `1`, nil), time.Minute)
+	require.NoError(t, err)
+
+	// the Aggregator was recreated with the same name
+	g, err := s.add(groupKey{aggregator: "uid-2", name: "test", correlation: "p"}, newEvent(t, "2", `2`, nil), time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, g.events, 1)
+}
+
+func TestMemoryStoreRestore(t *testing.T) {
+	s := newMemoryStore(1)
+	key := groupKey{aggregator: "test", correlation: "p"}
+
+	_, err := s.add(key, newEvent(t, "1", `1`, nil), time.Minute)
+	require.NoError(t, err)
+
+	_, err = s.add(groupKey{aggregator: "test", correlation: "q"}, newEvent(t, "2", `2`, nil), time.Minute)
+	assert.ErrorIs(t, err, errStoreFull)
+
+	removed, err := s.remove(key)
+	require.NoError(t, err)
+
+	// a new group was created while the removed one was being emitted
+	_, err = s.add(key, newEvent(t, "3", `3`, nil), time.Hour)
+	require.NoError(t, err)
+	_, err = s.add(key, newEvent(t, "1", `1`, nil), time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, s.restore(removed))
+
+	g, err := s.remove(key)
+	require.NoError(t, err)
+	require.NotNil(t, g)
+	assert.Len(t, g.events, 2)
+	assert.Equal(t, 2, g.size)
+	assert.Equal(t, removed.expires, g.expires)
+}
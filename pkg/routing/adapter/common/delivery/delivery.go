@@ -0,0 +1,149 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package delivery sends events to the sinks of routing components
+// according to their delivery spec.
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/channel/attributes"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/utils"
+	"knative.dev/pkg/apis"
+)
+
+// Sender sends events to a target, retries failed deliveries and forwards
+// undeliverable events to a dead letter sink.
+type Sender struct {
+	sender *kncloudevents.HTTPMessageSender
+}
+
+// NewSender returns a Sender that uses the given HTTP message sender.
+func NewSender(sender *kncloudevents.HTTPMessageSender) *Sender {
+	return &Sender{
+		sender: sender,
+	}
+}
+
+// Options defines how an event is delivered.
+type Options struct {
+	// Spec contains the retry parameters. Events are sent only once if it is nil.
+	Spec *eventingduckv1.DeliverySpec
+	// DeadLetterSink is the resolved URL of the dead letter sink, if any.
+	DeadLetterSink *apis.URL
+}
+
+// Send sends the event to the target with the retries defined in the
+// delivery options. When the event can not be delivered and a dead letter
+// sink is set, the event is sent to the dead letter sink instead, and the
+// returned response is nil with deadLettered set to true.
+func (s *Sender) Send(ctx context.Context, headers http.Header, target string, event *cloudevents.Event,
+	opts Options) (resp *http.Response, deadLettered bool, err error) {
+
+	var retryConfig *kncloudevents.RetryConfig
+	if opts.Spec != nil {
+		cfg, err := kncloudevents.RetryConfigFromDeliverySpec(*opts.Spec)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid delivery spec: %w", err)
+		}
+		retryConfig = &cfg
+	}
+
+	resp, err = s.send(ctx, headers, target, event, retryConfig)
+	if opts.DeadLetterSink == nil || (err == nil && isSuccess(resp)) {
+		return resp, false, err
+	}
+
+	code, data := http.StatusInternalServerError, ""
+	if err != nil {
+		data = err.Error()
+	}
+	if resp != nil {
+		code, data = resp.StatusCode, readBody(resp)
+	}
+
+	targetURL, parseErr := apis.ParseURL(target)
+	if parseErr != nil {
+		targetURL = &apis.URL{}
+	}
+
+	dlsResp, dlsErr := s.send(ctx, headers, opts.DeadLetterSink.String(), event, retryConfig,
+		attributes.KnativeErrorTransformers(*targetURL.URL(), code, data)...)
+	if dlsErr != nil {
+		return nil, false, fmt.Errorf("failed to send the event to the dead letter sink after delivery failure (%d): %w", code, dlsErr)
+	}
+	defer dlsResp.Body.Close()
+
+	if !isSuccess(dlsResp) {
+		return nil, false, fmt.Errorf("dead letter sink responded with status %d after delivery failure (%d)", dlsResp.StatusCode, code)
+	}
+
+	return nil, true, nil
+}
+
+// send writes the event to an HTTP request and sends it to the target.
+func (s *Sender) send(ctx context.Context, headers http.Header, target string, event *cloudevents.Event,
+	retryConfig *kncloudevents.RetryConfig, transformers ...binding.Transformer) (*http.Response, error) {
+
+	req, err := s.sender.NewCloudEventRequestWithTarget(ctx, target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the request: %w", err)
+	}
+
+	message := binding.ToMessage(event)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer message.Finish(nil)
+
+	additionalHeaders := utils.PassThroughHeaders(headers)
+	err = kncloudevents.WriteHTTPRequestWithAdditionalHeaders(ctx, message, req, additionalHeaders, transformers...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write request: %w", err)
+	}
+
+	resp, err := s.sender.SendWithRetries(req, retryConfig)
+	if err != nil {
+		err = fmt.Errorf("failed to dispatch message: %w", err)
+	}
+
+	return resp, err
+}
+
+// isSuccess returns whether the response has a 2xx status code.
+func isSuccess(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode >= 200 && resp.StatusCode < 300
+}
+
+// readBody reads and closes the body of a failed response. The returned
+// content is truncated by the Knative error transformers.
+func readBody(resp *http.Response) string {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, attributes.KnativeErrorDataExtensionMaxLength))
+	if err != nil {
+		return ""
+	}
+	return string(body)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delivery
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestSend(t *testing.T) {
+	testCases := map[string]struct {
+		failures      int32
+		retry         *int32
+		deadLetter    bool
+		expectStatus  int
+		expectDL      bool
+		expectErr     bool
+		expectTargetN int32
+	}{
+		"Delivered": {
+			expectStatus:  http.StatusOK,
+			expectTargetN: 1,
+		},
+		"Failure without retries": {
+			failures:      1,
+			expectStatus:  http.StatusServiceUnavailable,
+			expectTargetN: 1,
+		},
+		"Delivered after retries": {
+			failures:      2,
+			retry:         ptr.Int32(2),
+			expectStatus:  http.StatusOK,
+			expectTargetN: 3,
+		},
+		"Dead-lettered after retries": {
+			failures:      3,
+			retry:         ptr.Int32(2),
+			deadLetter:    true,
+			expectDL:      true,
+			expectTargetN: 3,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var targetN int32
+			target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&targetN, 1) <= tc.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer target.Close()
+
+			dlEvents := make(chan http.Header, 1)
+			dls := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				dlEvents <- r.Header
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer dls.Close()
+
+			opts := Options{
+				Spec: &eventingduckv1.DeliverySpec{
+					Retry: tc.retry,
+				},
+			}
+			if tc.deadLetter {
+				opts.DeadLetterSink = apis.HTTP(dls.Listener.Addr().String())
+			}
+
+			resp, deadLettered, err := newSender(t).Send(context.Background(), nil, target.URL, newEvent(t), opts)
+			assert.Equal(t, tc.expectErr, err != nil, "Unexpected error: %v", err)
+			assert.Equal(t, tc.expectDL, deadLettered)
+			assert.Equal(t, tc.expectTargetN, atomic.LoadInt32(&targetN))
+
+			if tc.expectDL {
+				assert.Nil(t, resp)
+				headers := <-dlEvents
+				assert.Equal(t, target.URL, headers.Get("ce-knativeerrordest"))
+				assert.Equal(t, "503", headers.Get("ce-knativeerrorcode"))
+				return
+			}
+
+			require.NotNil(t, resp)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.expectStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestSendDeadLetterFailure(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer target.Close()
+
+	dls := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer dls.Close()
+
+	opts := Options{
+		DeadLetterSink: apis.HTTP(dls.Listener.Addr().String()),
+	}
+
+	resp, deadLettered, err := newSender(t).Send(context.Background(), nil, target.URL, newEvent(t), opts)
+	assert.Error(t, err)
+	assert.False(t, deadLettered)
+	assert.Nil(t, resp)
+}
+
+func newSender(t *testing.T) *Sender {
+	sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+	require.NoError(t, err)
+	return NewSender(sender)
+}
+
+func newEvent(t *testing.T) *cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID("ce-abcd-0123")
+	event.SetType("ce.test.type")
+	event.SetSource("ce.test.source")
+	require.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(`{"foo":"bar"}`)))
+	return &event
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package forward sends events to the sinks of routing components and
+// relays the responses of the sinks to the senders of the events.
+package forward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"go.uber.org/zap"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+)
+
+// Forwarder sends events to a target and replies with the response of the target.
+type Forwarder struct {
+	sender *delivery.Sender
+	logger *zap.SugaredLogger
+}
+
+// New returns a Forwarder that sends events with the given sender.
+func New(sender *delivery.Sender, logger *zap.SugaredLogger) *Forwarder {
+	return &Forwarder{
+		sender: sender,
+		logger: logger,
+	}
+}
+
+// Send sends the event to the target and writes the response of the target
+// to the writer. A non-nil error is returned when the event could neither be
+// delivered to the target nor to the dead letter sink.
+func (f *Forwarder) Send(ctx context.Context, writer http.ResponseWriter, headers http.Header, target string,
+	event *cloudevents.Event, opts delivery.Options) error {
+
+	response, deadLettered, err := f.sender.Send(ctx, headers, target, event, opts)
+	if err != nil {
+		writer.WriteHeader(http.StatusInternalServerError)
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+
+	if deadLettered {
+		f.logger.Debug("Sent undeliverable message to the dead letter sink", zap.Any("target", target))
+		writer.WriteHeader(http.StatusAccepted)
+		return nil
+	}
+
+	f.logger.Debug("Successfully dispatched message", zap.Any("target", target))
+
+	// If there is an event in the response write it to the response
+	if _, err := f.writeResponse(ctx, writer, response, target); err != nil {
+		f.logger.Errorw("Failed to write response", zap.Error(err))
+	}
+	return nil
+}
+
+// Broadcast sends the event to every target and writes the aggregated status
+// to the writer. Responses of the targets are discarded since only one of
+// them could be written back to the sender.
+func (f *Forwarder) Broadcast(ctx context.Context, writer http.ResponseWriter, headers http.Header, targets []string,
+	event *cloudevents.Event, opts delivery.Options) {
+
+	status := http.StatusAccepted
+
+	for _, target := range targets {
+		response, deadLettered, err := f.sender.Send(ctx, headers, target, event, opts)
+		if err != nil {
+			f.logger.Errorw("Failed to send event", zap.Error(err), zap.Any("target", target))
+			status = http.StatusInternalServerError
+			continue
+		}
+
+		if deadLettered {
+			f.logger.Debug("Sent undeliverable message to the dead letter sink", zap.Any("target", target))
+			continue
+		}
+		response.Body.Close()
+
+		if !isSuccess(response) {
+			f.logger.Errorw("Event was not accepted", zap.Int("code", response.StatusCode), zap.Any("target", target))
+			status = http.StatusBadGateway
+			continue
+		}
+
+		f.logger.Debug("Successfully dispatched message", zap.Any("target", target))
+	}
+
+	writer.WriteHeader(status)
+}
+
+// Deliver sends the event to the target and discards the response of the
+// target. A non-nil error is returned when the event could neither be
+// delivered to the target nor to the dead letter sink.
+func (f *Forwarder) Deliver(ctx context.Context, headers http.Header, target string, event *cloudevents.Event,
+	opts delivery.Options) error {
+
+	response, deadLettered, err := f.sender.Send(ctx, headers, target, event, opts)
+	if err != nil {
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+
+	if deadLettered {
+		f.logger.Debug("Sent undeliverable message to the dead letter sink", zap.Any("target", target))
+		return nil
+	}
+	response.Body.Close()
+
+	if !isSuccess(response) {
+		return fmt.Errorf("target responded with status %d", response.StatusCode)
+	}
+
+	f.logger.Debug("Successfully dispatched message", zap.Any("target", target))
+	return nil
+}
+
+// ParseRequestURI returns the name of the routing object which events are
+// sent to at the given path, e.g. "/namespace/name".
+func ParseRequestURI(path string) (string, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("incorrect number of parts in the path, expected 2, actual %d, '%s'", len(parts), path)
+	}
+	return parts[2], nil
+}
+
+// isSuccess returns whether the response has a 2xx status.
+func isSuccess(resp *http.Response) bool {
+	return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices
+}
+
+// writeResponse writes the response of the target and returns its status.
+func (f *Forwarder) writeResponse(ctx context.Context, writer http.ResponseWriter, resp *http.Response, target string) (int, error) {
+	response := cehttp.NewMessageFromHttpResponse(resp)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer response.Finish(nil)
+
+	if response.ReadEncoding() == binding.EncodingUnknown {
+		// Response doesn't have a ce-specversion header nor a content-type matching a cloudevent event format
+		// Just read a byte out of the reader to see if it's non-empty, we don't care what it is,
+		// just that it is not empty. This means there was a response and it's not valid, so treat
+		// as delivery failure.
+		body := make([]byte, 1)
+		n, _ := response.BodyReader.Read(body)
+		response.BodyReader.Close()
+		if n != 0 {
+			// Note that we could just use StatusInternalServerError, but to distinguish
+			// between the failure cases, we use a different code here.
+			writer.WriteHeader(http.StatusBadGateway)
+			return http.StatusBadGateway, errors.New("received a non-empty response not recognized as CloudEvent. The response MUST be or empty or a valid CloudEvent")
+		}
+		f.logger.Debug("Response doesn't contain a CloudEvent, replying with an empty response", zap.Any("target", target))
+		writer.WriteHeader(resp.StatusCode)
+		return resp.StatusCode, nil
+	}
+
+	event, err := binding.ToEvent(ctx, response)
+	if err != nil {
+		// Like in the above case, we could just use StatusInternalServerError, but to distinguish
+		// between the failure cases, we use a different code here.
+		writer.WriteHeader(http.StatusBadGateway)
+		// Malformed event, reply with err
+		return http.StatusBadGateway, err
+	}
+
+	eventResponse := binding.ToMessage(event)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer eventResponse.Finish(nil)
+
+	if err := cehttp.WriteResponseWriter(ctx, eventResponse, resp.StatusCode, writer); err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to write response event: %w", err)
+	}
+
+	f.logger.Debug("Replied with a CloudEvent response", zap.Any("target", target))
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deduplicator
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"go.uber.org/zap"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/deduplicator"
+	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
+)
+
+const serverPort int = 8080
+
+// sweepInterval is the period of the removal of the expired keys.
+const sweepInterval = 10 * time.Second
+
+// Handler parses Cloud Events, drops the duplicate events, and sends the
+// other ones to a subscriber.
+type Handler struct {
+	// receiver receives incoming HTTP requests
+	receiver *kncloudevents.HTTPMessageReceiver
+	// forwarder sends requests to downstream services
+	forwarder *forward.Forwarder
+
+	deduplicatorLister routinglisters.DeduplicatorNamespaceLister
+	logger             *zap.SugaredLogger
+
+	// deduplications is the map of deduplicator refs with compiled specs
+	deduplications *deduplicationStorage
+	// seen records the keys of the forwarded events
+	seen *seenKeys
+}
+
+// NewEnvConfig satisfies env.ConfigConstructor.
+func NewEnvConfig() env.ConfigAccessor {
+	return &env.Config{}
+}
+
+// NewAdapter returns a constructor for the source's adapter.
+func NewAdapter(string) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, _ pkgadapter.EnvConfigAccessor, _ cloudevents.Client) pkgadapter.Adapter {
+		logger := logging.FromContext(ctx)
+
+		sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+		if err != nil {
+			logger.Panicf("failed to create message sender: %v", err)
+		}
+
+		informer := informerv1alpha1.Get(ctx)
+		ns := injection.GetNamespaceScope(ctx)
+
+		return &Handler{
+			receiver:           kncloudevents.NewHTTPMessageReceiver(serverPort),
+			forwarder:          forward.New(delivery.NewSender(sender), logger),
+			deduplicatorLister: informer.Lister().Deduplicators(ns),
+			logger:             logger,
+
+			deduplications: newDeduplicationStorage(),
+			seen:           newSeenKeys(),
+		}
+	}
+}
+
+// Start begins to receive messages for the handler.
+//
+// HTTP POST requests to the root path (/) are accepted.
+//
+// This method will block until ctx is done.
+func (h *Handler) Start(ctx context.Context) error {
+	go h.runSweep(ctx)
+	return h.receiver.StartListen(ctx, h)
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	deduplicator, err := forward.ParseRequestURI(request.URL.Path)
+	if err != nil {
+		h.logger.Errorw("Unable to parse path as deduplicator", zap.Error(err), zap.String("path", request.RequestURI))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := request.Context()
+
+	message := cehttp.NewMessageFromHttpRequest(request)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		h.logger.Errorw("Failed to extract event from request", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Debug("Received message", zap.Any("deduplicator", deduplicator))
+
+	d, err := h.deduplicatorLister.Get(deduplicator)
+	if err != nil {
+		h.logger.Errorw("Unable to get the Deduplicator", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	dd, err := h.deduplication(d)
+	if err != nil {
+		h.logger.Errorw("Failed to compile the Deduplicator spec", zap.Error(err), zap.Any("deduplicator", deduplicator))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	key, err := dd.keyOf(event)
+	if err != nil {
+		h.logger.Errorw("Unable to compute the key of the event", zap.Error(err), zap.String("id", event.ID()))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	seen := seenKey{deduplicator: deduplicator, key: key}
+	if !h.seen.add(seen, now, now.Add(dd.window)) {
+		h.logger.Debug("Dropped duplicate event", zap.String("key", key), zap.String("id", event.ID()))
+		return
+	}
+
+	if err := h.forwarder.Send(ctx, writer, request.Header, d.Status.SinkURI.String(), event, delivery.Options{}); err != nil {
+		h.logger.Errorw("Failed to forward the event", zap.Error(err))
+		// the redelivery of the event must not be dropped
+		h.seen.remove(seen)
+	}
+}
+
+// deduplication returns the compiled spec of the Deduplicator.
+func (h *Handler) deduplication(d *v1alpha1.Deduplicator) (*deduplication, error) {
+	dd, exists := h.deduplications.get(d.UID, d.Generation)
+	if exists {
+		return dd, nil
+	}
+
+	dd, err := newDeduplication(&d.Spec)
+	if err != nil {
+		return nil, err
+	}
+	h.deduplications.set(d.UID, d.Generation, dd)
+	return dd, nil
+}
+
+// runSweep periodically removes the keys which window is over.
+func (h *Handler) runSweep(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			h.seen.sweep(now)
+		}
+	}
+}
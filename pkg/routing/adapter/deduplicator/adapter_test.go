@@ -0,0 +1,202 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deduplicator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/rest"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	common "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
+	fakeinformer "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/deduplicator/fake"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
+)
+
+const (
+	tNS           = "test-namespace"
+	tDeduplicator = "deduplicator"
+)
+
+func TestAdapter(t *testing.T) {
+	ip, port := testServerSocket(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	received, sinkURL := setupSink(t)
+	ctx = setupFakeInformers(t, ctx, sinkURL)
+
+	h := newHandler(t, ctx, port)
+	go func() {
+		if err := h.Start(ctx); err != nil {
+			assert.FailNow(t, "could not start test adapter", err)
+		}
+	}()
+	<-h.receiver.Ready
+
+	addr := fmt.Sprintf("http://%s:%d/%s/%s", ip, port, tNS, tDeduplicator)
+
+	t.Run("duplicate events are dropped", func(t *testing.T) {
+		status := sendCE(t, newEvent(t, "1"), addr)
+		assert.Equal(t, http.StatusOK, status)
+		require.Len(t, received, 1)
+		assert.Equal(t, "1", <-received)
+
+		status = sendCE(t, newEvent(t, "1"), addr)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, received)
+
+		status = sendCE(t, newEvent(t, "2"), addr)
+		assert.Equal(t, http.StatusOK, status)
+		require.Len(t, received, 1)
+		assert.Equal(t, "2", <-received)
+	})
+
+	t.Run("keys are forgotten after the window", func(t *testing.T) {
+		h.seen.sweep(time.Now().Add(2 * v1alpha1.DefaultDeduplicatorWindow))
+
+		status := sendCE(t, newEvent(t, "1"), addr)
+		assert.Equal(t, http.StatusOK, status)
+		require.Len(t, received, 1)
+		assert.Equal(t, "1", <-received)
+	})
+}
+
+// sendCE sends the event and returns the HTTP status of the response.
+func sendCE(t *testing.T, event *cloudevents.Event, sink string) int {
+	ctx := cloudevents.ContextWithTarget(context.Background(), sink)
+	c, err := cloudevents.NewClientHTTP()
+	require.NoError(t, err)
+
+	result := c.Send(ctx, *event)
+
+	var httpResult *cehttp.Result
+	require.True(t, cloudevents.ResultAs(result, &httpResult), "Unexpected result: %v", result)
+	return httpResult.StatusCode
+}
+
+func setupFakeInformers(t *testing.T, ctx context.Context, sinkURI *apis.URL) context.Context {
+	d := &v1alpha1.Deduplicator{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  tNS,
+			Name:       tDeduplicator,
+			UID:        uuid.NewUUID(),
+			Generation: 1,
+		},
+		Status: common.Status{
+			SourceStatus: duckv1.SourceStatus{
+				SinkURI: sinkURI,
+			},
+		},
+	}
+	d.SetDefaults(ctx)
+
+	injection.Fake.RegisterClient(func(ctx context.Context, _ *rest.Config) context.Context {
+		ctx, _ = fakeinjectionclient.With(ctx, d)
+		return ctx
+	})
+
+	var infs []controller.Informer
+	ctx, infs = injection.Fake.SetupInformers(ctx, &rest.Config{})
+	err := controller.StartInformers(ctx.Done(), infs...)
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func newHandler(t *testing.T, ctx context.Context, port int) *Handler {
+	sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+	assert.NoError(t, err)
+
+	return &Handler{
+		receiver:           kncloudevents.NewHTTPMessageReceiver(port),
+		forwarder:          forward.New(delivery.NewSender(sender), logtesting.TestLogger(t)),
+		deduplicatorLister: fakeinformer.Get(ctx).Lister().Deduplicators(tNS),
+		logger:             logtesting.TestLogger(t),
+
+		deduplications: newDeduplicationStorage(),
+		seen:           newSeenKeys(),
+	}
+}
+
+// setupSink returns a channel that receives the IDs of the forwarded
+// events. The deduplicator replies after the delivery is done.
+func setupSink(t *testing.T) (chan string, *apis.URL) {
+	c := make(chan string, 4)
+
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c <- r.Header.Get("ce-id")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(sink.Close)
+
+	u, err := apis.ParseURL(sink.URL)
+	require.NoError(t, err)
+	return c, u
+}
+
+// testServerSocket returns a local IP and port where HTTP server can safely listen on.
+func newEvent(t *testing.T, id string) *cloudevents.Event {
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	err := e.SetData(cloudevents.ApplicationJSON, map[string]string{"id": id})
+	require.NoError(t, err)
+	return &e
+}
+
+// testServerSocket returns a local IP and port where HTTP server can safely listen on.
+func testServerSocket(t *testing.T) (net.IP, int) {
+	t.Helper()
+
+	// hack: Create a net.Listener on a random port, close it, and return its address.
+	// This guarantees that the address:port combination is free on the local host.
+	// See http/httptest.newLocalListener()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		if l, err = net.Listen("tcp6", "[::1]:0"); err != nil {
+			t.Fatalf("failed to listen on a port: %v", err)
+		}
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).IP, l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deduplicator
+
+import (
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventkey"
+)
+
+// deduplication is the compiled spec of a Deduplicator.
+type deduplication struct {
+	// key computes the keys of the events, nil to use their source and ID
+	key    *eventkey.Key
+	window time.Duration
+}
+
+// newDeduplication compiles the spec of a Deduplicator.
+func newDeduplication(spec *v1alpha1.DeduplicatorSpec) (*deduplication, error) {
+	dd := &deduplication{
+		window: v1alpha1.DefaultDeduplicatorWindow,
+	}
+
+	if spec.Window != nil {
+		dd.window = time.Duration(*spec.Window)
+	}
+
+	if spec.Key != "" {
+		key, err := eventkey.New(spec.Key)
+		if err != nil {
+			return nil, err
+		}
+		dd.key = key
+	}
+
+	return dd, nil
+}
+
+// keyOf returns the key which identifies duplicates of the event.
+func (dd *deduplication) keyOf(event *cloudevents.Event) (string, error) {
+	if dd.key == nil {
+		return eventkey.SourceID(event), nil
+	}
+	return dd.key.Of(event)
+}
+
+// seenKey identifies the events seen by a Deduplicator.
+type seenKey struct {
+	// deduplicator is the name of the Deduplicator.
+	deduplicator string
+	// key is the key of the events.
+	key string
+}
+
+// seenKeys records the keys of the forwarded events until their window is over.
+// Keys are not shared between replicas, which is why the adapter runs as a
+// single replica.
+type seenKeys struct {
+	sync.Mutex
+	expirations map[seenKey]time.Time
+}
+
+// newSeenKeys returns an empty record of keys.
+func newSeenKeys() *seenKeys {
+	return &seenKeys{
+		expirations: make(map[seenKey]time.Time),
+	}
+}
+
+// add records the key until the given expiration time, and returns false
+// if the key was already recorded and has not expired yet.
+func (s *seenKeys) add(key seenKey, now, expires time.Time) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	if exp, exists := s.expirations[key]; exists && now.Before(exp) {
+		return false
+	}
+	s.expirations[key] = expires
+	return true
+}
+
+// remove forgets the key, e.g. when the event could not be forwarded and
+// its redelivery must not be considered as a duplicate.
+func (s *seenKeys) remove(key seenKey) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.expirations, key)
+}
+
+// sweep forgets the keys which window is over.
+func (s *seenKeys) sweep(now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+
+	for k, exp := range s.expirations {
+		if !now.Before(exp) {
+			delete(s.expirations, k)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deduplicator
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type deduplicatorGenerations map[int64]*deduplication
+type deduplicatorUIDs map[types.UID]deduplicatorGenerations
+
+type deduplicationStorage struct {
+	*sync.RWMutex
+	deduplicatorUIDs
+}
+
+func newDeduplicationStorage() *deduplicationStorage {
+	return &deduplicationStorage{
+		RWMutex:          &sync.RWMutex{},
+		deduplicatorUIDs: make(deduplicatorUIDs),
+	}
+}
+
+func (d *deduplicationStorage) get(uid types.UID, generation int64) (*deduplication, bool) {
+	d.RLock()
+	defer d.RUnlock()
+
+	deduplicatorGens, exist := d.deduplicatorUIDs[uid]
+	if !exist {
+		return nil, false
+	}
+
+	dd, exist := deduplicatorGens[generation]
+	return dd, exist
+}
+
+// set method overrides previous generations of compiled deduplications
+func (d *deduplicationStorage) set(uid types.UID, generation int64, dd *deduplication) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.deduplicatorUIDs[uid] = deduplicatorGenerations{
+		generation: dd,
+	}
+}
@@ -18,10 +18,7 @@ package filter
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"net/http"
-	"strings"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
@@ -32,14 +29,15 @@ import (
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/eventing/pkg/kncloudevents"
-	"knative.dev/eventing/pkg/utils"
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/logging"
 
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/filter"
 	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
 	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
 	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter"
 	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
 )
@@ -50,8 +48,8 @@ const serverPort int = 8080
 type Handler struct {
 	// receiver receives incoming HTTP requests
 	receiver *kncloudevents.HTTPMessageReceiver
-	// sender sends requests to downstream services
-	sender *kncloudevents.HTTPMessageSender
+	// forwarder sends requests to downstream services
+	forwarder *forward.Forwarder
 
 	filterLister routinglisters.FilterNamespaceLister
 	logger       *zap.SugaredLogger
@@ -59,17 +57,31 @@ type Handler struct {
 	// expressions is the map of trigger refs with precompiled CEL expressions
 	// TODO (tzununbekov): Add cleanup
 	expressions *expressionStorage
+
+	// debugEndpoint enables the endpoint that evaluates filter expressions
+	// against sample events.
+	debugEndpoint bool
+}
+
+// envConfig is the set of configuration parameters of the filter adapter.
+type envConfig struct {
+	env.Config
+	// DebugEndpoint enables the /_debug/evaluate endpoint. The endpoint is
+	// unauthenticated and shared by all the Filters of the namespace,
+	// therefore it is disabled by default.
+	DebugEndpoint bool `envconfig:"FILTER_DEBUG_ENDPOINT" default:"false"`
 }
 
 // NewEnvConfig satisfies env.ConfigConstructor.
 func NewEnvConfig() env.ConfigAccessor {
-	return &env.Config{}
+	return &envConfig{}
 }
 
 // NewAdapter returns a constructor for the source's adapter.
 func NewAdapter(string) pkgadapter.AdapterConstructor {
-	return func(ctx context.Context, _ pkgadapter.EnvConfigAccessor, _ cloudevents.Client) pkgadapter.Adapter {
+	return func(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, _ cloudevents.Client) pkgadapter.Adapter {
 		logger := logging.FromContext(ctx)
+		cfg := envAcc.(*envConfig)
 
 		sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
 		if err != nil {
@@ -81,18 +93,22 @@ func NewAdapter(string) pkgadapter.AdapterConstructor {
 
 		return &Handler{
 			receiver:     kncloudevents.NewHTTPMessageReceiver(serverPort),
-			sender:       sender,
+			forwarder:    forward.New(delivery.NewSender(sender), logger),
 			filterLister: informer.Lister().Filters(ns),
 			logger:       logger,
 
 			expressions: newExpressionStorage(),
+
+			debugEndpoint: cfg.DebugEndpoint,
 		}
 	}
 }
 
 // Start begins to receive messages for the handler.
 //
-// HTTP POST requests to the root path (/) are accepted.
+// HTTP POST requests to the root path (/) are accepted. When the debug
+// endpoint is enabled, filter expressions can be tested against sample
+// events at the /_debug/evaluate path.
 //
 // This method will block until ctx is done.
 func (h *Handler) Start(ctx context.Context) error {
@@ -100,12 +116,17 @@ func (h *Handler) Start(ctx context.Context) error {
 }
 
 func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if h.debugEndpoint && request.URL.Path == debugEvaluatePath {
+		h.serveEvaluate(writer, request)
+		return
+	}
+
 	if request.Method != http.MethodPost {
 		writer.WriteHeader(http.StatusMethodNotAllowed)
 		return
 	}
 
-	filter, err := parseRequestURI(request.URL.Path)
+	filter, err := forward.ParseRequestURI(request.URL.Path)
 	if err != nil {
 		h.logger.Errorw("Unable to parse path as filter", zap.Error(err), zap.String("path", request.RequestURI))
 		writer.WriteHeader(http.StatusBadRequest)
@@ -151,8 +172,14 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	event = updateAttributes(f.Status, event)
-	h.send(ctx, writer, request.Header, f.Status.SinkURI.String(), event)
+	event = updateAttributes(f.Status.Status, event)
+	opts := delivery.Options{
+		Spec:           f.Spec.Delivery,
+		DeadLetterSink: f.Status.DeadLetterSinkURI,
+	}
+	if err := h.forwarder.Send(ctx, writer, request.Header, f.Status.SinkURI.String(), event, opts); err != nil {
+		h.logger.Errorw("Failed to forward the event", zap.Error(err))
+	}
 }
 
 func updateAttributes(fs commonv1alpha1.Status, event *event.Event) *event.Event {
@@ -163,99 +190,6 @@ func updateAttributes(fs commonv1alpha1.Status, event *event.Event) *event.Event
 	return event
 }
 
-func (h *Handler) send(ctx context.Context, writer http.ResponseWriter, headers http.Header, target string, event *cloudevents.Event) {
-	// send the event to trigger's subscriber
-	response, err := h.sendEvent(ctx, headers, target, event)
-	if err != nil {
-		h.logger.Errorw("Failed to send event", zap.Error(err))
-		writer.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	h.logger.Debug("Successfully dispatched message", zap.Any("target", target))
-
-	// If there is an event in the response write it to the response
-	_, err = h.writeResponse(ctx, writer, response, target)
-	if err != nil {
-		h.logger.Errorw("Failed to write response", zap.Error(err))
-	}
-}
-
-func (h *Handler) sendEvent(ctx context.Context, headers http.Header, target string, event *cloudevents.Event) (*http.Response, error) {
-	// Send the event to the subscriber
-	req, err := h.sender.NewCloudEventRequestWithTarget(ctx, target)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create the request: %w", err)
-	}
-
-	message := binding.ToMessage(event)
-	// cannot be err, but makes linter complain about missing err check
-	//nolint
-	defer message.Finish(nil)
-
-	additionalHeaders := utils.PassThroughHeaders(headers)
-	err = kncloudevents.WriteHTTPRequestWithAdditionalHeaders(ctx, message, req, additionalHeaders)
-	if err != nil {
-		return nil, fmt.Errorf("failed to write request: %w", err)
-	}
-
-	resp, err := h.sender.Send(req)
-	if err != nil {
-		err = fmt.Errorf("failed to dispatch message: %w", err)
-	}
-
-	return resp, err
-}
-
-// The return values are the status
-func (h *Handler) writeResponse(ctx context.Context, writer http.ResponseWriter, resp *http.Response, target string) (int, error) {
-	response := cehttp.NewMessageFromHttpResponse(resp)
-	// cannot be err, but makes linter complain about missing err check
-	//nolint
-	defer response.Finish(nil)
-
-	if response.ReadEncoding() == binding.EncodingUnknown {
-		// Response doesn't have a ce-specversion header nor a content-type matching a cloudevent event format
-		// Just read a byte out of the reader to see if it's non-empty, we don't care what it is,
-		// just that it is not empty. This means there was a response and it's not valid, so treat
-		// as delivery failure.
-		body := make([]byte, 1)
-		n, _ := response.BodyReader.Read(body)
-		response.BodyReader.Close()
-		if n != 0 {
-			// Note that we could just use StatusInternalServerError, but to distinguish
-			// between the failure cases, we use a different code here.
-			writer.WriteHeader(http.StatusBadGateway)
-			return http.StatusBadGateway, errors.New("received a non-empty response not recognized as CloudEvent. The response MUST be or empty or a valid CloudEvent")
-		}
-		h.logger.Debug("Response doesn't contain a CloudEvent, replying with an empty response", zap.Any("target", target))
-		writer.WriteHeader(resp.StatusCode)
-		return resp.StatusCode, nil
-	}
-
-	event, err := binding.ToEvent(ctx, response)
-	if err != nil {
-		// Like in the above case, we could just use StatusInternalServerError, but to distinguish
-		// between the failure cases, we use a different code here.
-		writer.WriteHeader(http.StatusBadGateway)
-		// Malformed event, reply with err
-		return http.StatusBadGateway, err
-	}
-
-	eventResponse := binding.ToMessage(event)
-	// cannot be err, but makes linter complain about missing err check
-	//nolint
-	defer eventResponse.Finish(nil)
-
-	if err := cehttp.WriteResponseWriter(ctx, eventResponse, resp.StatusCode, writer); err != nil {
-		return http.StatusInternalServerError, fmt.Errorf("failed to write response event: %w", err)
-	}
-
-	h.logger.Debug("Replied with a CloudEvent response", zap.Any("target", target))
-
-	return resp.StatusCode, nil
-}
-
 func filterEvent(ctx context.Context, filter cel.ConditionalFilter, event cloudevents.Event) eventfilter.FilterResult {
 	var filters eventfilter.Filters
 	if filter.Expression != nil {
@@ -264,11 +198,3 @@ func filterEvent(ctx context.Context, filter cel.ConditionalFilter, event cloude
 
 	return filters.Filter(ctx, event)
 }
-
-func parseRequestURI(path string) (string, error) {
-	parts := strings.Split(path, "/")
-	if len(parts) != 3 {
-		return "", fmt.Errorf("incorrect number of parts in the path, expected 2, actual %d, '%s'", len(parts), path)
-	}
-	return parts[2], nil
-}
@@ -47,6 +47,8 @@ import (
 	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
 	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
 	fakeinformer "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/filter/fake"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
 )
 
 const (
@@ -259,9 +261,11 @@ func newFilter(t *testing.T, key, expression, addr string) *v1alpha1.Filter {
 		Spec: v1alpha1.FilterSpec{
 			Expression: expression,
 		},
-		Status: common.Status{
-			SourceStatus: duckv1.SourceStatus{
-				SinkURI: sinkURI,
+		Status: v1alpha1.FilterStatus{
+			Status: common.Status{
+				SourceStatus: duckv1.SourceStatus{
+					SinkURI: sinkURI,
+				},
 			},
 		},
 	}
@@ -273,7 +277,7 @@ func newHandler(t *testing.T, ctx context.Context, port int) *Handler {
 
 	return &Handler{
 		receiver:     kncloudevents.NewHTTPMessageReceiver(port),
-		sender:       sender,
+		forwarder:    forward.New(delivery.NewSender(sender), logtesting.TestLogger(t)),
 		filterLister: fakeinformer.Get(ctx).Lister().Filters(tNS),
 		logger:       logtesting.TestLogger(t),
 		expressions:  newExpressionStorage(),
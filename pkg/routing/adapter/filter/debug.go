@@ -0,0 +1,111 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// debugEvaluatePath is the path of the endpoint that evaluates a filter
+// expression against a sample event, without sending the event anywhere.
+// Namespace names can not start with an underscore, therefore this path
+// never conflicts with the path of a Filter.
+const debugEvaluatePath = "/_debug/evaluate"
+
+// maxEvaluateRequestSize limits the size of the requests to the debug endpoint.
+const maxEvaluateRequestSize = 1 << 20
+
+// evaluateRequest is the payload of the requests to the debug endpoint.
+type evaluateRequest struct {
+	// Expression is the filter expression to evaluate.
+	Expression string `json:"expression"`
+	// Event is the sample event, in the CloudEvents JSON format.
+	Event *cloudevents.Event `json:"event"`
+}
+
+// evaluateResponse is the payload of the responses of the debug endpoint.
+type evaluateResponse struct {
+	// Pass tells whether the event passes the filter.
+	Pass bool `json:"pass"`
+	// Variables contains the values of the "$json_path.(type)" variables
+	// of the expression, indexed by their path.
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	// Error is the compilation or evaluation error of the expression.
+	// Filters drop the events against which their expression can not be
+	// evaluated.
+	Error string `json:"error,omitempty"`
+}
+
+// serveEvaluate evaluates the expression of the request against its sample
+// event, and replies with the result of the evaluation.
+func (h *Handler) serveEvaluate(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req evaluateRequest
+	if err := json.NewDecoder(io.LimitReader(request.Body, maxEvaluateRequestSize)).Decode(&req); err != nil {
+		writeEvaluateResponse(writer, http.StatusBadRequest, &evaluateResponse{Error: "invalid request: " + err.Error()})
+		return
+	}
+	if req.Expression == "" || req.Event == nil {
+		writeEvaluateResponse(writer, http.StatusBadRequest, &evaluateResponse{Error: "expression and event are required"})
+		return
+	}
+
+	cond, err := cel.CompileExpression(req.Expression)
+	if err != nil {
+		writeEvaluateResponse(writer, http.StatusBadRequest, &evaluateResponse{Error: "cannot compile expression: " + err.Error()})
+		return
+	}
+
+	resolve := func(path string) gjson.Result {
+		return gjson.GetBytes(req.Event.Data(), path)
+	}
+
+	resp := &evaluateResponse{
+		Variables: cond.VariableValues(resolve),
+	}
+
+	// mirror the behaviour of the filter, which drops the events against
+	// which the expression can not be evaluated
+	pass, err := cond.EvaluateEvent(req.Event, resolve)
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	resp.Pass = pass && err == nil
+
+	h.logger.Debugw("Evaluated expression", zap.String("expression", req.Expression), zap.Bool("pass", resp.Pass))
+
+	writeEvaluateResponse(writer, http.StatusOK, resp)
+}
+
+// writeEvaluateResponse writes the response of the debug endpoint as JSON.
+func writeEvaluateResponse(writer http.ResponseWriter, status int, resp *evaluateResponse) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(resp)
+}
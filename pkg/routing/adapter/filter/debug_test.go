@@ -0,0 +1,120 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestServeEvaluate(t *testing.T) {
+	const event = `{
+		"specversion": "1.0",
+		"id": "1",
+		"type": "io.triggermesh.test",
+		"source": "test",
+		"datacontenttype": "application/json",
+		"data": {"name": {"first": "bob"}, "age": 25}
+	}`
+
+	testCases := map[string]struct {
+		body       string
+		wantStatus int
+		wantResp   evaluateResponse
+	}{
+		"event passes the filter": {
+			body:       `{"expression": "$name.first.(string) == \"bob\" && ce.type == \"io.triggermesh.test\"", "event": ` + event + `}`,
+			wantStatus: http.StatusOK,
+			wantResp: evaluateResponse{
+				Pass:      true,
+				Variables: map[string]interface{}{"name.first": "bob"},
+			},
+		},
+		"event does not pass the filter": {
+			body:       `{"expression": "$age.(int64) > 30", "event": ` + event + `}`,
+			wantStatus: http.StatusOK,
+			wantResp: evaluateResponse{
+				Pass:      false,
+				Variables: map[string]interface{}{"age": float64(25)},
+			},
+		},
+		"expression can not be evaluated": {
+			body:       `{"expression": "data.missing == 1.0", "event": ` + event + `}`,
+			wantStatus: http.StatusOK,
+			wantResp: evaluateResponse{
+				Pass:  false,
+				Error: "no such key: missing",
+			},
+		},
+		"invalid expression": {
+			body:       `{"expression": "hi!", "event": ` + event + `}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		"missing event": {
+			body:       `{"expression": "true"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	h := &Handler{
+		logger:        logtesting.TestLogger(t),
+		debugEndpoint: true,
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, debugEvaluatePath, strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+			require.Equal(t, tc.wantStatus, rec.Code)
+
+			var resp evaluateResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+			if tc.wantStatus != http.StatusOK {
+				assert.NotEmpty(t, resp.Error)
+				return
+			}
+			assert.Equal(t, tc.wantResp, resp)
+		})
+	}
+}
+
+func TestServeEvaluateDisabled(t *testing.T) {
+	h := &Handler{
+		logger: logtesting.TestLogger(t),
+	}
+
+	body := `{"expression": "true", "event": {"specversion": "1.0", "id": "1", "type": "t", "source": "s"}}`
+	req := httptest.NewRequest(http.MethodPost, debugEvaluatePath, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	// the path is handled as the path of a Filter, which is not a valid one
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, rec.Body.Bytes())
+}
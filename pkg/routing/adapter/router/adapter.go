@@ -0,0 +1,227 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/tidwall/gjson"
+
+	"go.uber.org/zap"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/router"
+	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+const serverPort int = 8080
+
+// Handler parses Cloud Events, evaluates the conditions of the routes, and sends
+// the events to the matching routes sinks.
+type Handler struct {
+	// receiver receives incoming HTTP requests
+	receiver *kncloudevents.HTTPMessageReceiver
+	// forwarder sends requests to downstream services
+	forwarder *forward.Forwarder
+
+	routerLister routinglisters.RouterNamespaceLister
+	logger       *zap.SugaredLogger
+
+	// expressions is the map of router refs with precompiled CEL expressions
+	// of their routes
+	expressions *expressionStorage
+}
+
+// NewEnvConfig satisfies env.ConfigConstructor.
+func NewEnvConfig() env.ConfigAccessor {
+	return &env.Config{}
+}
+
+// NewAdapter returns a constructor for the source's adapter.
+func NewAdapter(string) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, _ pkgadapter.EnvConfigAccessor, _ cloudevents.Client) pkgadapter.Adapter {
+		logger := logging.FromContext(ctx)
+
+		sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+		if err != nil {
+			logger.Panicf("failed to create message sender: %v", err)
+		}
+
+		informer := informerv1alpha1.Get(ctx)
+		ns := injection.GetNamespaceScope(ctx)
+
+		return &Handler{
+			receiver:     kncloudevents.NewHTTPMessageReceiver(serverPort),
+			forwarder:    forward.New(delivery.NewSender(sender), logger),
+			routerLister: informer.Lister().Routers(ns),
+			logger:       logger,
+
+			expressions: newExpressionStorage(),
+		}
+	}
+}
+
+// Start begins to receive messages for the handler.
+//
+// HTTP POST requests to the root path (/) are accepted.
+//
+// This method will block until ctx is done.
+func (h *Handler) Start(ctx context.Context) error {
+	return h.receiver.StartListen(ctx, h)
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	router, err := forward.ParseRequestURI(request.URL.Path)
+	if err != nil {
+		h.logger.Errorw("Unable to parse path as router", zap.Error(err), zap.String("path", request.RequestURI))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := request.Context()
+
+	message := cehttp.NewMessageFromHttpRequest(request)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		h.logger.Errorw("Failed to extract event from request", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Debug("Received message", zap.Any("router", router))
+
+	r, err := h.routerLister.Get(router)
+	if err != nil {
+		h.logger.Errorw("Unable to get the Router", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	conds, exists := h.expressions.get(r.UID, r.Generation)
+	if !exists {
+		conds, err = compileRoutes(r.Spec.Routes)
+		if err != nil {
+			h.logger.Errorw("Failed to compile route expression", zap.Error(err), zap.Any("router", router))
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		h.expressions.set(r.UID, r.Generation, conds)
+	}
+
+	targets, err := matchRoutes(r, conds, *event)
+	if err != nil {
+		h.logger.Errorw("Unable to route the event", zap.Error(err), zap.Any("router", router))
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	event = updateAttributes(r.Status.Status, event)
+
+	switch len(targets) {
+	case 0:
+		h.logger.Debug("Event did not match any route", zap.Any("router", router))
+	case 1:
+		if err := h.forwarder.Send(ctx, writer, request.Header, targets[0], event, delivery.Options{}); err != nil {
+			h.logger.Errorw("Failed to forward the event", zap.Error(err))
+		}
+	default:
+		h.forwarder.Broadcast(ctx, writer, request.Header, targets, event, delivery.Options{})
+	}
+}
+
+// compileRoutes compiles the conditions of the routes.
+func compileRoutes(routes []v1alpha1.Route) ([]cel.ConditionalFilter, error) {
+	conds := make([]cel.ConditionalFilter, len(routes))
+	for i, route := range routes {
+		cond, err := cel.CompileExpression(route.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("route %d: %w", i, err)
+		}
+		conds[i] = cond
+	}
+	return conds, nil
+}
+
+// matchRoutes returns the sink URIs of the routes that match the event,
+// or the default sink if none of the routes match.
+func matchRoutes(r *v1alpha1.Router, conds []cel.ConditionalFilter, event cloudevents.Event) ([]string, error) {
+	if len(r.Status.Routes) != len(conds) {
+		return nil, errors.New("the sinks of the routes are not resolved yet")
+	}
+
+	var targets []string
+	for i, cond := range conds {
+		// like the Filter, the routes which conditions cannot be
+		// evaluated against the event are considered not matching
+		match, err := cond.EvaluateEvent(&event, func(path string) gjson.Result {
+			return gjson.GetBytes(event.Data(), path)
+		})
+		if err != nil || !match {
+			continue
+		}
+
+		sinkURI := r.Status.Routes[i].SinkURI
+		if sinkURI == nil {
+			return nil, fmt.Errorf("the sink of route %d is not resolved", i)
+		}
+		targets = append(targets, sinkURI.String())
+
+		if r.Spec.Match != v1alpha1.RouterMatchAll {
+			break
+		}
+	}
+
+	if len(targets) == 0 && r.Status.SinkURI != nil {
+		targets = append(targets, r.Status.SinkURI.String())
+	}
+
+	return targets, nil
+}
+
+func updateAttributes(fs commonv1alpha1.Status, event *event.Event) *event.Event {
+	if len(fs.CloudEventAttributes) == 1 {
+		event.SetType(fs.CloudEventAttributes[0].Type)
+		event.SetSource(fs.CloudEventAttributes[0].Source)
+	}
+	return event
+}
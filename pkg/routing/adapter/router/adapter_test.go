@@ -0,0 +1,300 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/rest"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	common "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
+	fakeinformer "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/router/fake"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
+)
+
+const (
+	tCloudEventID     = "ce-abcd-0123"
+	tCloudEventSource = "ce.test.source"
+
+	tNS = "test-namespace"
+)
+
+// tRoutes are the conditions of the routes which sinks are named after their index.
+var tRoutes = []string{
+	`ce.type == "order.created"`,
+	`data.total > 100.0`,
+}
+
+func TestAdapter(t *testing.T) {
+	testCases := map[string]struct {
+		router    string
+		eventType string
+		payload   string
+
+		expectSinks []string
+		expectNACK  bool
+	}{
+		"first match": {
+			router:      "first",
+			eventType:   "order.created",
+			payload:     `{"total":150}`,
+			expectSinks: []string{"route-0"},
+		},
+		"first match of the second route": {
+			router:      "first",
+			eventType:   "order.updated",
+			payload:     `{"total":150}`,
+			expectSinks: []string{"route-1"},
+		},
+		"all matches": {
+			router:      "all",
+			eventType:   "order.created",
+			payload:     `{"total":150}`,
+			expectSinks: []string{"route-0", "route-1"},
+		},
+		"default sink": {
+			router:      "all",
+			eventType:   "order.updated",
+			payload:     `{"total":50}`,
+			expectSinks: []string{"default"},
+		},
+		"unevaluable condition does not match": {
+			router:      "first",
+			eventType:   "order.updated",
+			payload:     `{"items":[]}`,
+			expectSinks: []string{"default"},
+		},
+		"no match without default sink": {
+			router:    "nodefault",
+			eventType: "order.updated",
+			payload:   `{"total":50}`,
+		},
+		"unresolved routes": {
+			router:     "unresolved",
+			eventType:  "order.created",
+			payload:    `{}`,
+			expectNACK: true,
+		},
+		"missing router": {
+			router:     "missing",
+			eventType:  "order.created",
+			payload:    `{}`,
+			expectNACK: true,
+		},
+	}
+
+	ip, port := testServerSocket(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	sinks := newSinks(t)
+	ctx = setupFakeInformers(t, ctx, sinks)
+
+	h := newHandler(t, ctx, port)
+	go func() {
+		if err := h.Start(ctx); err != nil {
+			assert.FailNow(t, "could not start test adapter", err)
+		}
+	}()
+	<-h.receiver.Ready
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sinks.reset()
+
+			routerAddr := fmt.Sprintf("http://%s:%d/%s/%s", ip, port, tNS, tc.router)
+
+			ce := newCloudEvent(t, tc.eventType, tc.payload)
+			result := sendCE(t, &ce, routerAddr)
+			assert.Equal(t, tc.expectNACK, cloudevents.IsNACK(result), "Unexpected router response: %v", result)
+
+			assert.Equal(t, tc.expectSinks, sinks.received())
+		})
+	}
+}
+
+func newCloudEvent(t *testing.T, typ, data string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(tCloudEventID)
+	event.SetType(typ)
+	event.SetSource(tCloudEventSource)
+	err := event.SetData(cloudevents.ApplicationJSON, []byte(data))
+	require.NoError(t, err)
+	return event
+}
+
+func sendCE(t *testing.T, event *cloudevents.Event, sink string) protocol.Result {
+	ctx := cloudevents.ContextWithTarget(context.Background(), sink)
+	c, err := cloudevents.NewClientHTTP()
+	require.NoError(t, err)
+
+	return c.Send(ctx, *event)
+}
+
+func setupFakeInformers(t *testing.T, ctx context.Context, s *sinks) context.Context {
+	routers := []runtime.Object{
+		newRouter(t, "first", v1alpha1.RouterMatchFirst, s, true, true),
+		newRouter(t, "all", v1alpha1.RouterMatchAll, s, true, true),
+		newRouter(t, "nodefault", v1alpha1.RouterMatchFirst, s, false, true),
+		newRouter(t, "unresolved", v1alpha1.RouterMatchFirst, s, true, false),
+	}
+
+	injection.Fake.RegisterClient(func(ctx context.Context, _ *rest.Config) context.Context {
+		ctx, _ = fakeinjectionclient.With(ctx, routers...)
+		return ctx
+	})
+
+	var infs []controller.Informer
+	ctx, infs = injection.Fake.SetupInformers(ctx, &rest.Config{})
+	err := controller.StartInformers(ctx.Done(), infs...)
+	require.NoError(t, err)
+
+	return ctx
+}
+
+func newRouter(t *testing.T, name string, match v1alpha1.RouterMatchPolicy, s *sinks, defaultSink, resolved bool) *v1alpha1.Router {
+	r := &v1alpha1.Router{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  tNS,
+			Name:       name,
+			UID:        uuid.NewUUID(),
+			Generation: 1,
+		},
+		Spec: v1alpha1.RouterSpec{
+			Match: match,
+		},
+	}
+
+	for i, cond := range tRoutes {
+		sinkURI := s.url(t, fmt.Sprintf("route-%d", i))
+		r.Spec.Routes = append(r.Spec.Routes, v1alpha1.Route{
+			Condition: cond,
+			Sink:      duckv1.Destination{URI: sinkURI},
+		})
+		if resolved {
+			r.Status.Routes = append(r.Status.Routes, v1alpha1.RouteStatus{SinkURI: sinkURI})
+		}
+	}
+
+	if defaultSink {
+		r.Status.Status = common.Status{
+			SourceStatus: duckv1.SourceStatus{
+				SinkURI: s.url(t, "default"),
+			},
+		}
+	}
+
+	return r
+}
+
+func newHandler(t *testing.T, ctx context.Context, port int) *Handler {
+	sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+	assert.NoError(t, err)
+
+	return &Handler{
+		receiver:     kncloudevents.NewHTTPMessageReceiver(port),
+		forwarder:    forward.New(delivery.NewSender(sender), logtesting.TestLogger(t)),
+		routerLister: fakeinformer.Get(ctx).Lister().Routers(tNS),
+		logger:       logtesting.TestLogger(t),
+		expressions:  newExpressionStorage(),
+	}
+}
+
+// sinks is a test server that records the names of the sinks,
+// passed as the request path, that received the events.
+type sinks struct {
+	sync.Mutex
+	names  []string
+	server *httptest.Server
+}
+
+func newSinks(t *testing.T) *sinks {
+	s := &sinks{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Lock()
+		defer s.Unlock()
+		s.names = append(s.names, r.URL.Path[1:])
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+func (s *sinks) url(t *testing.T, name string) *apis.URL {
+	u, err := apis.ParseURL(s.server.URL + "/" + name)
+	require.NoError(t, err)
+	return u
+}
+
+func (s *sinks) reset() {
+	s.Lock()
+	defer s.Unlock()
+	s.names = nil
+}
+
+// received returns the sorted names of the sinks. The router replies
+// only after the deliveries are done.
+func (s *sinks) received() []string {
+	s.Lock()
+	defer s.Unlock()
+	sort.Strings(s.names)
+	return s.names
+}
+
+// testServerSocket returns a local IP and port where HTTP server can safely listen on.
+func testServerSocket(t *testing.T) (net.IP, int) {
+	t.Helper()
+
+	// hack: Create a net.Listener on a random port, close it, and return its address.
+	// This guarantees that the address:port combination is free on the local host.
+	// See http/httptest.newLocalListener()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		if l, err = net.Listen("tcp6", "[::1]:0"); err != nil {
+			t.Fatalf("failed to listen on a port: %v", err)
+		}
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).IP, l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"sync"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type routerGenerations map[int64][]cel.ConditionalFilter
+type routerUIDs map[types.UID]routerGenerations
+
+type expressionStorage struct {
+	*sync.RWMutex
+	routerUIDs
+}
+
+func newExpressionStorage() *expressionStorage {
+	return &expressionStorage{
+		RWMutex:    &sync.RWMutex{},
+		routerUIDs: make(routerUIDs),
+	}
+}
+
+func (r *expressionStorage) get(uid types.UID, generation int64) ([]cel.ConditionalFilter, bool) {
+	r.RLock()
+	defer r.RUnlock()
+
+	routerGens, exist := r.routerUIDs[uid]
+	if !exist {
+		return nil, false
+	}
+
+	conditions, exist := routerGens[generation]
+	return conditions, exist
+}
+
+// set method overrides previous generations of compiled expressions
+func (r *expressionStorage) set(uid types.UID, generation int64, conditions []cel.ConditionalFilter) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.routerUIDs[uid] = routerGenerations{
+		generation: conditions,
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sampler
+
+import (
+	"context"
+	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"go.uber.org/zap"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/sampler"
+	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
+)
+
+const serverPort int = 8080
+
+// Handler parses Cloud Events, and sends a sample of them to a subscriber.
+type Handler struct {
+	// receiver receives incoming HTTP requests
+	receiver *kncloudevents.HTTPMessageReceiver
+	// forwarder sends requests to downstream services
+	forwarder *forward.Forwarder
+
+	samplerLister routinglisters.SamplerNamespaceLister
+	logger        *zap.SugaredLogger
+
+	// samplings is the map of sampler refs with compiled specs
+	samplings *samplingStorage
+}
+
+// NewEnvConfig satisfies env.ConfigConstructor.
+func NewEnvConfig() env.ConfigAccessor {
+	return &env.Config{}
+}
+
+// NewAdapter returns a constructor for the source's adapter.
+func NewAdapter(string) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, _ pkgadapter.EnvConfigAccessor, _ cloudevents.Client) pkgadapter.Adapter {
+		logger := logging.FromContext(ctx)
+
+		sender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+		if err != nil {
+			logger.Panicf("failed to create message sender: %v", err)
+		}
+
+		informer := informerv1alpha1.Get(ctx)
+		ns := injection.GetNamespaceScope(ctx)
+
+		return &Handler{
+			receiver:      kncloudevents.NewHTTPMessageReceiver(serverPort),
+			forwarder:     forward.New(delivery.NewSender(sender), logger),
+			samplerLister: informer.Lister().Samplers(ns),
+			logger:        logger,
+
+			samplings: newSamplingStorage(),
+		}
+	}
+}
+
+// Start begins to receive messages for the handler.
+//
+// HTTP POST requests to the root path (/) are accepted.
+//
+// This method will block until ctx is done.
+func (h *Handler) Start(ctx context.Context) error {
+	return h.receiver.StartListen(ctx, h)
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	sampler, err := forward.ParseRequestURI(request.URL.Path)
+	if err != nil {
+		h.logger.Errorw("Unable to parse path as sampler", zap.Error(err), zap.String("path", request.RequestURI))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := request.Context()
+
+	message := cehttp.NewMessageFromHttpRequest(request)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		h.logger.Errorw("Failed to extract event from request", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Debug("Received message", zap.Any("sampler", sampler))
+
+	s, err := h.samplerLister.Get(sampler)
+	if err != nil {
+		h.logger.Errorw("Unable to get the Sampler", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	smp, err := h.sampling(s)
+	if err != nil {
+		h.logger.Errorw("Failed to compile the Sampler spec", zap.Error(err), zap.Any("sampler", sampler))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	sampled, err := smp.sample(event)
+	if err != nil {
+		h.logger.Errorw("Unable to compute the key of the event", zap.Error(err), zap.String("id", event.ID()))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if !sampled {
+		h.logger.Debug("Dropped event out of the sample", zap.String("id", event.ID()))
+		return
+	}
+
+	if err := h.forwarder.Send(ctx, writer, request.Header, s.Status.SinkURI.String(), event, delivery.Options{}); err != nil {
+		h.logger.Errorw("Failed to forward the event", zap.Error(err))
+	}
+}
+
+// sampling returns the compiled spec of the Sampler.
+func (h *Handler) sampling(s *v1alpha1.Sampler) (*sampling, error) {
+	smp, exists := h.samplings.get(s.UID, s.Generation)
+	if exists {
+		return smp, nil
+	}
+
+	smp, err := newSampling(&s.Spec)
+	if err != nil {
+		return nil, err
+	}
+	h.samplings.set(s.UID, s.Generation, smp)
+	return smp, nil
+}
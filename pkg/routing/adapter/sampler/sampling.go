@@ -0,0 +1,88 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sampler
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventkey"
+)
+
+// sampling is the compiled spec of a Sampler.
+type sampling struct {
+	percentage int
+	// key computes the keys of the events, nil to sample them randomly
+	key *eventkey.Key
+
+	// rand is not safe for concurrent use
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// newSampling compiles the spec of a Sampler.
+func newSampling(spec *v1alpha1.SamplerSpec) (*sampling, error) {
+	smp := &sampling{
+		percentage: spec.Percentage,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+	}
+
+	if spec.Key != "" {
+		key, err := eventkey.New(spec.Key)
+		if err != nil {
+			return nil, err
+		}
+		smp.key = key
+	}
+
+	return smp, nil
+}
+
+// sample returns whether the event is part of the sample.
+func (smp *sampling) sample(event *cloudevents.Event) (bool, error) {
+	switch {
+	case smp.percentage >= 100:
+		return true, nil
+	case smp.percentage <= 0:
+		return false, nil
+	}
+
+	if smp.key == nil {
+		smp.mu.Lock()
+		defer smp.mu.Unlock()
+		return smp.rand.Intn(100) < smp.percentage, nil
+	}
+
+	key, err := smp.key.Of(event)
+	if err != nil {
+		return false, err
+	}
+	return bucket(key) < uint32(smp.percentage), nil
+}
+
+// bucket hashes the key into one of 100 buckets, so that the events
+// sharing a key are consistently sampled.
+func bucket(key string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum32() % 100
+}
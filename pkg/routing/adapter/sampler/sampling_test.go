@@ -0,0 +1,92 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sampler
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+func TestSample(t *testing.T) {
+	t.Run("all or nothing", func(t *testing.T) {
+		for percentage, want := range map[int]int{0: 0, 100: 100} {
+			smp, err := newSampling(&v1alpha1.SamplerSpec{Percentage: percentage})
+			require.NoError(t, err)
+			assert.Equal(t, want, countSampled(t, smp, func(i int) string { return "" }))
+		}
+	})
+
+	t.Run("random", func(t *testing.T) {
+		smp, err := newSampling(&v1alpha1.SamplerSpec{Percentage: 50})
+		require.NoError(t, err)
+
+		n := countSampled(t, smp, func(i int) string { return "" })
+		assert.Greater(t, n, 0)
+		assert.Less(t, n, 100)
+	})
+
+	t.Run("by key", func(t *testing.T) {
+		smp, err := newSampling(&v1alpha1.SamplerSpec{Percentage: 50, Key: `ce.user`})
+		require.NoError(t, err)
+
+		// events sharing a key are all either sampled or dropped
+		n := countSampled(t, smp, func(i int) string { return "alice" })
+		assert.Contains(t, []int{0, 100}, n)
+
+		n = countSampled(t, smp, func(i int) string { return string(rune('a' + i%26)) })
+		assert.Greater(t, n, 0)
+		assert.Less(t, n, 100)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		smp, err := newSampling(&v1alpha1.SamplerSpec{Percentage: 50, Key: `ce.user`})
+		require.NoError(t, err)
+
+		_, err = smp.sample(newEvent(""))
+		assert.Error(t, err)
+	})
+}
+
+// countSampled samples 100 events which user extension is returned by
+// the user function, and returns the number of sampled events.
+func countSampled(t *testing.T, smp *sampling, user func(i int) string) int {
+	n := 0
+	for i := 0; i < 100; i++ {
+		sampled, err := smp.sample(newEvent(user(i)))
+		require.NoError(t, err)
+		if sampled {
+			n++
+		}
+	}
+	return n
+}
+
+func newEvent(user string) *cloudevents.Event {
+	e := cloudevents.NewEvent()
+	e.SetID("1")
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	if user != "" {
+		e.SetExtension("user", user)
+	}
+	return &e
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sampler
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type samplerGenerations map[int64]*sampling
+type samplerUIDs map[types.UID]samplerGenerations
+
+type samplingStorage struct {
+	*sync.RWMutex
+	samplerUIDs
+}
+
+func newSamplingStorage() *samplingStorage {
+	return &samplingStorage{
+		RWMutex:     &sync.RWMutex{},
+		samplerUIDs: make(samplerUIDs),
+	}
+}
+
+func (s *samplingStorage) get(uid types.UID, generation int64) (*sampling, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	samplerGens, exist := s.samplerUIDs[uid]
+	if !exist {
+		return nil, false
+	}
+
+	smp, exist := samplerGens[generation]
+	return smp, exist
+}
+
+// set method overrides previous generations of compiled samplings
+func (s *samplingStorage) set(uid types.UID, generation int64, smp *sampling) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.samplerUIDs[uid] = samplerGenerations{
+		generation: smp,
+	}
+}
@@ -21,36 +21,47 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
-	"github.com/cloudevents/sdk-go/v2/event"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
-	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/eventing/pkg/kncloudevents"
-	"knative.dev/eventing/pkg/utils"
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
 	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/splitter"
 	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
 	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventkey"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventsplit"
 )
 
 const serverPort int = 8080
 
+// sweepInterval is the period of the removal of the expired partial deliveries.
+const sweepInterval = time.Minute
+
 // Handler parses Cloud Events, determines if they pass a filter, and sends them to a subscriber.
 type Handler struct {
 	// receiver receives incoming HTTP requests
 	receiver *kncloudevents.HTTPMessageReceiver
 	// sender sends requests to downstream services
-	sender *kncloudevents.HTTPMessageSender
+	sender *delivery.Sender
 
 	splitterLister routinglisters.SplitterNamespaceLister
 	logger         *zap.SugaredLogger
+
+	// splitters is the map of splitter refs with compiled specs
+	splitters *splitterStorage
+	// deliveries records the split events delivered for the parent
+	// events that could not be fully delivered
+	deliveries *partialDeliveries
 }
 
 // NewEnvConfig satisfies env.ConfigConstructor.
@@ -73,9 +84,12 @@ func NewAdapter(string) pkgadapter.AdapterConstructor {
 
 		return &Handler{
 			receiver:       kncloudevents.NewHTTPMessageReceiver(serverPort),
-			sender:         sender,
+			sender:         delivery.NewSender(sender),
 			splitterLister: informer.Lister().Splitters(ns),
 			logger:         logger,
+
+			splitters:  newSplitterStorage(),
+			deliveries: newPartialDeliveries(),
 		}
 	}
 }
@@ -86,6 +100,7 @@ func NewAdapter(string) pkgadapter.AdapterConstructor {
 //
 // This method will block until ctx is done.
 func (h *Handler) Start(ctx context.Context) error {
+	go h.runSweep(ctx)
 	return h.receiver.StartListen(ctx, h)
 }
 
@@ -125,66 +140,119 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	for i, e := range h.split(s.Spec.Path, event) {
-		e.SetID(fmt.Sprintf("%s-%d", event.ID(), i))
-		e.SetType(s.Spec.CEContext.Type)
-		e.SetSource(s.Spec.CEContext.Source)
-		for key, value := range s.Spec.CEContext.Extensions {
-			e.SetExtension(key, value)
-		}
-		// we may want to keep responses and send them back to the source
-		_, err := h.sendEvent(ctx, request.Header, s.Status.SinkURI.String(), e)
+	sp, exists := h.splitters.get(s.UID, s.Generation)
+	if !exists {
+		sp, err = eventsplit.New(splitterConfig(&s.Spec))
 		if err != nil {
-			h.logger.Errorw("Failed to send the event", zap.Error(err))
+			h.logger.Errorw("Failed to compile the Splitter spec", zap.Error(err), zap.Any("splitter", splitter))
+			writer.WriteHeader(http.StatusBadRequest)
+			return
 		}
+		h.splitters.set(s.UID, s.Generation, sp)
 	}
 
-	writer.WriteHeader(http.StatusOK)
-}
+	events, err := sp.Split(event)
+	if err != nil {
+		h.logger.Errorw("Failed to split the event", zap.Error(err), zap.String("id", event.ID()))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
 
-func (h *Handler) split(path string, e *event.Event) []*event.Event {
-	val := gjson.Get(string(e.Data()), path)
-	if !val.IsArray() {
-		val = gjson.Parse("[" + val.Raw + "]")
+	opts := delivery.Options{
+		Spec:           s.Spec.Delivery,
+		DeadLetterSink: s.Status.DeadLetterSinkURI,
 	}
 
-	var result []*event.Event
-	for _, v := range val.Array() {
-		newCE := cloudevents.NewEvent()
-		if err := newCE.SetData(cloudevents.ApplicationJSON, []byte(v.Raw)); err != nil {
-			h.logger.Errorw("Failed to set event data", zap.Error(err))
+	// split events delivered during previous deliveries of the parent
+	// event are not sent again
+	parent := parentKey{splitter: s.UID, generation: s.Generation, event: eventkey.SourceID(event)}
+	delivered := h.deliveries.get(parent, time.Now())
+
+	lost := 0
+	for i, e := range events {
+		if delivered[i] {
 			continue
 		}
-		newCE.DataBase64 = false
-		result = append(result, &newCE)
+		// we may want to keep responses and send them back to the source
+		if h.sendEvent(ctx, request.Header, s.Status.SinkURI.String(), e, opts) {
+			delivered[i] = true
+		} else {
+			lost++
+		}
 	}
-	return result
-}
 
-func (h *Handler) sendEvent(ctx context.Context, headers http.Header, target string, event *cloudevents.Event) (*http.Response, error) {
-	// Send the event to the subscriber
-	req, err := h.sender.NewCloudEventRequestWithTarget(ctx, target)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create the request: %w", err)
+	// children that were neither delivered nor dead-lettered are reported
+	// to the sender so it can apply its own delivery policy
+	if lost != 0 {
+		h.logger.Errorw("Some of the split events could not be delivered", zap.Int("count", lost))
+		h.deliveries.set(parent, delivered, time.Now().Add(partialDeliveryTTL))
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
 	}
 
-	message := binding.ToMessage(event)
-	// cannot be err, but makes linter complain about missing err check
-	//nolint
-	defer message.Finish(nil)
+	h.deliveries.remove(parent)
+	writer.WriteHeader(http.StatusOK)
+}
 
-	additionalHeaders := utils.PassThroughHeaders(headers)
-	err = kncloudevents.WriteHTTPRequestWithAdditionalHeaders(ctx, message, req, additionalHeaders)
-	if err != nil {
-		return nil, fmt.Errorf("failed to write request: %w", err)
+// runSweep periodically removes the expired partial deliveries.
+func (h *Handler) runSweep(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			h.deliveries.sweep(now)
+		}
 	}
+}
 
-	resp, err := h.sender.Send(req)
+// splitterConfig returns the configuration of the event splitter
+// defined in the Splitter spec.
+func splitterConfig(spec *v1alpha1.SplitterSpec) eventsplit.Config {
+	cfg := eventsplit.Config{
+		Path:       spec.Path,
+		Type:       spec.CEContext.Type,
+		Source:     spec.CEContext.Source,
+		Subject:    spec.CEContext.Subject,
+		ID:         spec.CEContext.ID,
+		Extensions: spec.CEContext.Extensions,
+	}
+	if spec.Query != nil {
+		cfg.Query = *spec.Query
+	}
+	if spec.JSONPath != nil {
+		cfg.JSONPath = *spec.JSONPath
+	}
+	if spec.ChunkSize != nil {
+		cfg.ChunkSize = *spec.ChunkSize
+	}
+	return cfg
+}
+
+// sendEvent sends a split event to the target and returns whether it was
+// either delivered or sent to the dead letter sink.
+func (h *Handler) sendEvent(ctx context.Context, headers http.Header, target string, event *cloudevents.Event,
+	opts delivery.Options) bool {
+
+	resp, deadLettered, err := h.sender.Send(ctx, headers, target, event, opts)
 	if err != nil {
-		err = fmt.Errorf("failed to dispatch message: %w", err)
+		h.logger.Errorw("Failed to send the event", zap.Error(err), zap.String("id", event.ID()))
+		return false
 	}
+	if deadLettered {
+		h.logger.Debugw("Sent undeliverable event to the dead letter sink", zap.String("id", event.ID()))
+		return true
+	}
+	defer resp.Body.Close()
 
-	return resp, err
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		h.logger.Errorw("Failed to deliver the event", zap.Int("status", resp.StatusCode), zap.String("id", event.ID()))
+		return false
+	}
+	return true
 }
 
 func parseRequestURI(path string) (string, error) {
@@ -46,6 +46,7 @@ import (
 	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
 	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
 	fakeinformer "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/splitter/fake"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
 )
 
 const (
@@ -202,9 +203,11 @@ func newSplitter(t *testing.T, key, path, addr string) *v1alpha1.Splitter {
 		Spec: v1alpha1.SplitterSpec{
 			Path: path,
 		},
-		Status: common.Status{
-			SourceStatus: duckv1.SourceStatus{
-				SinkURI: sinkURI,
+		Status: v1alpha1.SplitterStatus{
+			Status: common.Status{
+				SourceStatus: duckv1.SourceStatus{
+					SinkURI: sinkURI,
+				},
 			},
 		},
 	}
@@ -216,9 +219,12 @@ func newHandler(t *testing.T, ctx context.Context, port int) *Handler {
 
 	return &Handler{
 		receiver:       kncloudevents.NewHTTPMessageReceiver(port),
-		sender:         sender,
+		sender:         delivery.NewSender(sender),
 		splitterLister: fakeinformer.Get(ctx).Lister().Splitters(tNS),
 		logger:         logtesting.TestLogger(t),
+
+		splitters:  newSplitterStorage(),
+		deliveries: newPartialDeliveries(),
 	}
 }
 
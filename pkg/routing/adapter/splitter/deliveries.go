@@ -0,0 +1,113 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitter
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// partialDeliveryTTL is the period during which the split events delivered
+// for a parent event that could not be fully delivered are remembered.
+const partialDeliveryTTL = time.Hour
+
+// maxPartialDeliveries limits the number of remembered parent events.
+const maxPartialDeliveries = 10000
+
+// parentKey identifies a parent event split by a Splitter.
+type parentKey struct {
+	splitter   types.UID
+	generation int64
+	// event is the source and ID of the parent event.
+	event string
+}
+
+// partialDelivery is the set of split events delivered for a parent event,
+// indexed by their position in the split output.
+type partialDelivery struct {
+	delivered map[int]bool
+	expires   time.Time
+}
+
+// partialDeliveries remembers which split events of the parent events that
+// could not be fully delivered were delivered, so that the redeliveries of
+// these parent events only send the split events which were lost.
+//
+// Deliveries are remembered in the memory of the adapter replica, redeliveries
+// handled by another replica send all the split events again.
+type partialDeliveries struct {
+	sync.Mutex
+	parents map[parentKey]*partialDelivery
+}
+
+// newPartialDeliveries returns an empty record of deliveries.
+func newPartialDeliveries() *partialDeliveries {
+	return &partialDeliveries{
+		parents: make(map[parentKey]*partialDelivery),
+	}
+}
+
+// get returns the split events already delivered for the parent event.
+func (d *partialDeliveries) get(key parentKey, now time.Time) map[int]bool {
+	d.Lock()
+	defer d.Unlock()
+
+	delivered := make(map[int]bool)
+	if p, exists := d.parents[key]; exists && now.Before(p.expires) {
+		for i := range p.delivered {
+			delivered[i] = true
+		}
+	}
+	return delivered
+}
+
+// set records the split events delivered for the parent event until the
+// given expiration time. Nothing is recorded once the limit is reached.
+func (d *partialDeliveries) set(key parentKey, delivered map[int]bool, expires time.Time) {
+	d.Lock()
+	defer d.Unlock()
+
+	if _, exists := d.parents[key]; !exists && len(d.parents) >= maxPartialDeliveries {
+		return
+	}
+	d.parents[key] = &partialDelivery{
+		delivered: delivered,
+		expires:   expires,
+	}
+}
+
+// remove forgets the parent event once all its split events are delivered.
+func (d *partialDeliveries) remove(key parentKey) {
+	d.Lock()
+	defer d.Unlock()
+
+	delete(d.parents, key)
+}
+
+// sweep forgets the parent events which record has expired.
+func (d *partialDeliveries) sweep(now time.Time) {
+	d.Lock()
+	defer d.Unlock()
+
+	for k, p := range d.parents {
+		if !now.Before(p.expires) {
+			delete(d.parents, k)
+		}
+	}
+}
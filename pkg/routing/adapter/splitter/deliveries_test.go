@@ -0,0 +1,53 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartialDeliveries(t *testing.T) {
+	d := newPartialDeliveries()
+	now := time.Now()
+
+	parent := parentKey{splitter: "uid", generation: 1, event: "source/id"}
+	assert.Empty(t, d.get(parent, now))
+
+	d.set(parent, map[int]bool{0: true, 2: true}, now.Add(time.Minute))
+
+	delivered := d.get(parent, now)
+	assert.Equal(t, map[int]bool{0: true, 2: true}, delivered)
+
+	// the returned set is a copy of the record
+	delivered[1] = true
+	assert.Len(t, d.get(parent, now), 2)
+
+	// other generations of the Splitter split events differently
+	assert.Empty(t, d.get(parentKey{splitter: "uid", generation: 2, event: "source/id"}, now))
+
+	assert.Empty(t, d.get(parent, now.Add(time.Minute)), "expired record")
+
+	d.sweep(now.Add(time.Minute))
+	assert.Empty(t, d.parents)
+
+	d.set(parent, map[int]bool{0: true}, now.Add(time.Minute))
+	d.remove(parent)
+	assert.Empty(t, d.get(parent, now))
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitter
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventsplit"
+)
+
+type splitterGenerations map[int64]*eventsplit.Splitter
+type splitterUIDs map[types.UID]splitterGenerations
+
+type splitterStorage struct {
+	*sync.RWMutex
+	splitterUIDs
+}
+
+func newSplitterStorage() *splitterStorage {
+	return &splitterStorage{
+		RWMutex:      &sync.RWMutex{},
+		splitterUIDs: make(splitterUIDs),
+	}
+}
+
+func (s *splitterStorage) get(uid types.UID, generation int64) (*eventsplit.Splitter, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	splitterGens, exist := s.splitterUIDs[uid]
+	if !exist {
+		return nil, false
+	}
+
+	splitter, exist := splitterGens[generation]
+	return splitter, exist
+}
+
+// set method overrides previous generations of compiled splitters
+func (s *splitterStorage) set(uid types.UID, generation int64, splitter *eventsplit.Splitter) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.splitterUIDs[uid] = splitterGenerations{
+		generation: splitter,
+	}
+}
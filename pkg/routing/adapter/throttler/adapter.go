@@ -0,0 +1,212 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"go.uber.org/zap"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/throttler"
+	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/delivery"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/forward"
+)
+
+const serverPort int = 8080
+
+// sweepInterval is the period of the removal of the unused token buckets.
+const sweepInterval = time.Minute
+
+// Handler parses Cloud Events, limits their rate, and sends them to a subscriber.
+type Handler struct {
+	// receiver receives incoming HTTP requests
+	receiver *kncloudevents.HTTPMessageReceiver
+	// sender sends the excess events to dead letter sinks
+	sender *delivery.Sender
+	// forwarder sends requests to downstream services
+	forwarder *forward.Forwarder
+
+	throttlerLister routinglisters.ThrottlerNamespaceLister
+	logger          *zap.SugaredLogger
+
+	// throttlings is the map of throttler refs with compiled specs
+	throttlings *throttlingStorage
+}
+
+// NewEnvConfig satisfies env.ConfigConstructor.
+func NewEnvConfig() env.ConfigAccessor {
+	return &env.Config{}
+}
+
+// NewAdapter returns a constructor for the source's adapter.
+func NewAdapter(string) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, _ pkgadapter.EnvConfigAccessor, _ cloudevents.Client) pkgadapter.Adapter {
+		logger := logging.FromContext(ctx)
+
+		httpSender, err := kncloudevents.NewHTTPMessageSenderWithTarget("")
+		if err != nil {
+			logger.Panicf("failed to create message sender: %v", err)
+		}
+		sender := delivery.NewSender(httpSender)
+
+		informer := informerv1alpha1.Get(ctx)
+		ns := injection.GetNamespaceScope(ctx)
+
+		return &Handler{
+			receiver:        kncloudevents.NewHTTPMessageReceiver(serverPort),
+			sender:          sender,
+			forwarder:       forward.New(sender, logger),
+			throttlerLister: informer.Lister().Throttlers(ns),
+			logger:          logger,
+
+			throttlings: newThrottlingStorage(),
+		}
+	}
+}
+
+// Start begins to receive messages for the handler.
+//
+// HTTP POST requests to the root path (/) are accepted.
+//
+// This method will block until ctx is done.
+func (h *Handler) Start(ctx context.Context) error {
+	return h.receiver.StartListen(ctx, h)
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	throttler, err := forward.ParseRequestURI(request.URL.Path)
+	if err != nil {
+		h.logger.Errorw("Unable to parse path as throttler", zap.Error(err), zap.String("path", request.RequestURI))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := request.Context()
+
+	message := cehttp.NewMessageFromHttpRequest(request)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		h.logger.Errorw("Failed to extract event from request", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Debug("Received message", zap.Any("throttler", throttler))
+
+	t, err := h.throttlerLister.Get(throttler)
+	if err != nil {
+		h.logger.Errorw("Unable to get the Throttler", zap.Error(err))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	thr, err := h.throttling(t)
+	if err != nil {
+		h.logger.Errorw("Failed to compile the Throttler spec", zap.Error(err), zap.Any("throttler", throttler))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	key, err := thr.keyOf(event)
+	if err != nil {
+		h.logger.Errorw("Unable to compute the key of the event", zap.Error(err), zap.String("id", event.ID()))
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	allowed, err := thr.allow(ctx, key)
+	if err != nil {
+		// e.g. the rate limit of a previous generation of the Throttler was
+		// released, the event is not let through without being throttled
+		h.logger.Errorw("Unable to apply the rate limit", zap.Error(err))
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	if allowed {
+		err := h.forwarder.Send(ctx, writer, request.Header, t.Status.SinkURI.String(), event, delivery.Options{
+			Spec:           t.Spec.Delivery,
+			DeadLetterSink: t.Status.DeadLetterSinkURI,
+		})
+		if err != nil {
+			h.logger.Errorw("Failed to forward the event", zap.Error(err))
+		}
+		return
+	}
+
+	if thr.excess != v1alpha1.ThrottlerExcessDeadLetter || t.Status.DeadLetterSinkURI == nil {
+		h.logger.Debug("Dropped event over the rate limit", zap.String("key", key), zap.String("id", event.ID()))
+		return
+	}
+
+	if err := h.deadLetter(ctx, request.Header, t, event); err != nil {
+		h.logger.Errorw("Failed to send the event over the rate limit to the dead letter sink", zap.Error(err))
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	writer.WriteHeader(http.StatusAccepted)
+}
+
+// deadLetter sends an event over the rate limit to the dead letter sink
+// of the Throttler.
+func (h *Handler) deadLetter(ctx context.Context, headers http.Header, t *v1alpha1.Throttler,
+	event *cloudevents.Event) error {
+
+	resp, _, err := h.sender.Send(ctx, headers, t.Status.DeadLetterSinkURI.String(), event, delivery.Options{
+		Spec: t.Spec.Delivery,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("dead letter sink responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// throttling returns the compiled spec of the Throttler.
+func (h *Handler) throttling(t *v1alpha1.Throttler) (*throttling, error) {
+	return h.throttlings.getOrCreate(t.UID, t.Generation, func() (*throttling, error) {
+		return newThrottling(&t.Spec)
+	})
+}
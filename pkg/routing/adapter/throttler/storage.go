@@ -0,0 +1,91 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttler
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type throttlerGenerations map[int64]*throttling
+type throttlerUIDs map[types.UID]throttlerGenerations
+
+type throttlingStorage struct {
+	*sync.RWMutex
+	throttlerUIDs
+}
+
+func newThrottlingStorage() *throttlingStorage {
+	return &throttlingStorage{
+		RWMutex:       &sync.RWMutex{},
+		throttlerUIDs: make(throttlerUIDs),
+	}
+}
+
+func (t *throttlingStorage) get(uid types.UID, generation int64) (*throttling, bool) {
+	t.RLock()
+	defer t.RUnlock()
+
+	throttlerGens, exist := t.throttlerUIDs[uid]
+	if !exist {
+		return nil, false
+	}
+
+	thr, exist := throttlerGens[generation]
+	return thr, exist
+}
+
+// getOrCreate returns the compiled throttling of the generation, it is
+// created under the write lock if missing so that concurrent requests share
+// the same token buckets. The throttlings of older generations are released.
+func (t *throttlingStorage) getOrCreate(uid types.UID, generation int64,
+	create func() (*throttling, error)) (*throttling, error) {
+
+	if thr, exists := t.get(uid, generation); exists {
+		return thr, nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	throttlerGens, exist := t.throttlerUIDs[uid]
+	if !exist {
+		throttlerGens = make(throttlerGenerations)
+		t.throttlerUIDs[uid] = throttlerGens
+	}
+
+	if thr, exists := throttlerGens[generation]; exists {
+		return thr, nil
+	}
+
+	thr, err := create()
+	if err != nil {
+		return nil, err
+	}
+
+	for gen, prev := range throttlerGens {
+		if gen < generation {
+			_ = prev.close(context.Background())
+			delete(throttlerGens, gen)
+		}
+	}
+	throttlerGens[generation] = thr
+
+	return thr, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttler
+
+import (
+	"context"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/sethvargo/go-limiter"
+	"github.com/sethvargo/go-limiter/memorystore"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventkey"
+)
+
+// globalKey is the key of the events when the Throttler has no key expression.
+const globalKey = "global"
+
+// throttling is the compiled spec of a Throttler.
+type throttling struct {
+	// key computes the keys of the events, nil if all events share the same limit
+	key    *eventkey.Key
+	excess v1alpha1.ThrottlerExcessPolicy
+
+	// store keeps a token bucket per key, in the memory of the replica
+	store limiter.Store
+}
+
+// newThrottling compiles the spec of a Throttler.
+func newThrottling(spec *v1alpha1.ThrottlerSpec) (*throttling, error) {
+	period := v1alpha1.DefaultThrottlerPeriod
+	if spec.Period != nil {
+		period = time.Duration(*spec.Period)
+	}
+
+	thr := &throttling{
+		excess: spec.Excess,
+	}
+
+	if spec.Key != "" {
+		key, err := eventkey.New(spec.Key)
+		if err != nil {
+			return nil, err
+		}
+		thr.key = key
+	}
+
+	store, err := memorystore.New(&memorystore.Config{
+		Tokens:   uint64(spec.Rate),
+		Interval: period,
+		// buckets unused for a whole period are full, and can be recreated
+		SweepInterval: sweepInterval,
+		SweepMinTTL:   period,
+	})
+	if err != nil {
+		return nil, err
+	}
+	thr.store = store
+
+	return thr, nil
+}
+
+// keyOf returns the key which selects the token bucket of the event.
+func (thr *throttling) keyOf(event *cloudevents.Event) (string, error) {
+	if thr.key == nil {
+		return globalKey, nil
+	}
+	return thr.key.Of(event)
+}
+
+// allow takes a token from the bucket of the key and returns whether the
+// event is within the rate limit.
+func (thr *throttling) allow(ctx context.Context, key string) (bool, error) {
+	_, _, _, ok, err := thr.store.Take(ctx, key)
+	return ok, err
+}
+
+// close releases the token buckets.
+func (thr *throttling) close(ctx context.Context) error {
+	return thr.store.Close(ctx)
+}
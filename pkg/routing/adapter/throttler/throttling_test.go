@@ -0,0 +1,105 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+func TestAllow(t *testing.T) {
+	ctx := context.Background()
+	period := apis.Duration(time.Hour)
+
+	thr, err := newThrottling(&v1alpha1.ThrottlerSpec{
+		Rate:   2,
+		Period: &period,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = thr.close(ctx) })
+
+	for _, tc := range []struct {
+		key   string
+		allow bool
+	}{
+		{key: "alice", allow: true},
+		{key: "alice", allow: true},
+		{key: "alice", allow: false},
+		{key: "bob", allow: true},
+	} {
+		allowed, err := thr.allow(ctx, tc.key)
+		require.NoError(t, err)
+		assert.Equal(t, tc.allow, allowed, "Unexpected result for key %q", tc.key)
+	}
+}
+
+func TestAllowReleased(t *testing.T) {
+	ctx := context.Background()
+
+	thr, err := newThrottling(&v1alpha1.ThrottlerSpec{Rate: 1})
+	require.NoError(t, err)
+	require.NoError(t, thr.close(ctx))
+
+	_, err = thr.allow(ctx, globalKey)
+	assert.Error(t, err)
+}
+
+func TestKeyOf(t *testing.T) {
+	thr, err := newThrottling(&v1alpha1.ThrottlerSpec{Rate: 1})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = thr.close(context.Background()) })
+
+	e := cloudevents.NewEvent()
+	key, err := thr.keyOf(&e)
+	require.NoError(t, err)
+	assert.Equal(t, globalKey, key, "Events share the same bucket without a key expression")
+}
+
+func TestStorageGenerations(t *testing.T) {
+	ctx := context.Background()
+	s := newThrottlingStorage()
+
+	create := func() (*throttling, error) {
+		return newThrottling(&v1alpha1.ThrottlerSpec{Rate: 1})
+	}
+
+	gen1, err := s.getOrCreate("uid", 1, create)
+	require.NoError(t, err)
+
+	thr, err := s.getOrCreate("uid", 1, create)
+	require.NoError(t, err)
+	assert.Same(t, gen1, thr, "Expected the throttling of the generation to be reused")
+
+	gen2, err := s.getOrCreate("uid", 2, create)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = gen2.close(ctx) })
+
+	_, err = gen1.allow(ctx, globalKey)
+	assert.Error(t, err, "Expected the throttling of the older generation to be released")
+
+	allowed, err := gen2.allow(ctx, globalKey)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
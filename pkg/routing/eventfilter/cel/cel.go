@@ -23,12 +23,22 @@ import (
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/ext"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
 
 var errVarType = errors.New("variable definition doesn't match expected format: \"$json_path.(type)\"")
 
+// Variables that expose the whole event to the expressions, e.g.
+// 'ce.type == "foo.bar" && data.items.exists(i, i.id == "baz")'.
+const (
+	// ceVariable is a map of the CE Context attributes and extensions.
+	ceVariable = "ce"
+	// dataVariable is the decoded CE Data.
+	dataVariable = "data"
+)
+
 // CompileExpression accepts the expression string from the Filter spec,
 // parses variables and their types, compiles expression into CEL Program
 func CompileExpression(expression string) (ConditionalFilter, error) {
@@ -36,7 +46,25 @@ func CompileExpression(expression string) (ConditionalFilter, error) {
 	if err != nil {
 		return ConditionalFilter{}, err
 	}
-	prog, err := newCEL(expr, vars)
+	prog, err := newCEL(expr, vars, decls.Bool)
+	if err != nil {
+		return ConditionalFilter{}, err
+	}
+	return ConditionalFilter{
+		Expression: &prog,
+		Variables:  vars,
+	}, nil
+}
+
+// CompileValueExpression is similar to CompileExpression but accepts
+// the expressions of any result type. Such expressions are evaluated
+// with the Value method.
+func CompileValueExpression(expression string) (ConditionalFilter, error) {
+	expr, vars, err := parseExpressionString(expression)
+	if err != nil {
+		return ConditionalFilter{}, err
+	}
+	prog, err := newCEL(expr, vars, nil)
 	if err != nil {
 		return ConditionalFilter{}, err
 	}
@@ -50,58 +78,70 @@ func CompileExpression(expression string) (ConditionalFilter, error) {
 // and a set of variable definitions, e.g.:
 // '$foo.(string) == "bar"' becomes
 // expr: foo == "bar", vars: ["foo": string]
+// The "$" symbols inside string literals, e.g. in regular expressions, are kept as is.
 func parseExpressionString(expression string) (string, []Variable, error) {
 	var vars []Variable
-	var cleanExpr string
+	var cleanExpr strings.Builder
+	var quote byte
 
 	for i := 0; i < len(expression); i++ {
-		expression = expression[i:]
-
-		variable := strings.Index(expression, "$")
-		if variable == -1 {
-			cleanExpr += expression
-			break
-		}
-
-		// start looking for the variable type after the variable name
-		typ := strings.Index(expression[variable:], ".(")
-		if typ == -1 {
-			return "", []Variable{}, errVarType
-		}
-		typ += variable
-
-		end := strings.Index(expression[variable:], ")")
-		if end == -1 {
-			return "", []Variable{}, errVarType
+		c := expression[i]
+
+		switch {
+		case quote != 0:
+			if c == '\\' && i+1 < len(expression) {
+				cleanExpr.WriteByte(c)
+				i++
+				c = expression[i]
+			} else if c == quote {
+				quote = 0
+			}
+			cleanExpr.WriteByte(c)
+
+		case c == '"' || c == '\'':
+			quote = c
+			cleanExpr.WriteByte(c)
+
+		case c == '$':
+			variable := expression[i:]
+
+			// start looking for the variable type after the variable name
+			typ := strings.Index(variable, ".(")
+			if typ == -1 {
+				return "", []Variable{}, errVarType
+			}
+			end := strings.Index(variable, ")")
+			if end == -1 || typ+2 > end {
+				return "", []Variable{}, errVarType
+			}
+
+			safeCELName := strings.ReplaceAll(variable[1:typ], ".", "_")
+			// integer as the variable name first symbol causes issue with matching
+			// var types. String prefix ensures that we don't have first integer symbol.
+			safeCELName = "var_" + safeCELName
+
+			vars = append(vars, Variable{
+				Name: safeCELName,
+				Path: variable[1:typ],
+				Type: variable[typ+2 : end],
+			})
+			cleanExpr.WriteString(safeCELName)
+			i += end
+
+		default:
+			cleanExpr.WriteByte(c)
 		}
-		end += variable
-
-		i = end
-		cleanExpr += expression[:variable]
-
-		safeCELName := strings.ReplaceAll(expression[variable+1:typ], ".", "_")
-		// integer as the variable name first symbol causes issue with matching
-		// var types. String prefix ensures that we don't have first integer symbol.
-		safeCELName = "var_" + safeCELName
-
-		if variable+1 > typ || typ+2 > end {
-			return "", []Variable{}, errVarType
-		}
-
-		vars = append(vars, Variable{
-			Name: safeCELName,
-			Path: expression[variable+1 : typ],
-			Type: expression[typ+2 : end],
-		})
-		cleanExpr += safeCELName
 	}
-	return cleanExpr, vars, nil
+	return cleanExpr.String(), vars, nil
 }
 
 // newCEL creates CEL env, sets its variables, compiles expression string
-// and validates expression result type
-func newCEL(expr string, vars []Variable) (cel.Program, error) {
-	declVars := []*exprpb.Decl{}
+// and validates expression result type unless it is nil
+func newCEL(expr string, vars []Variable, resultType *exprpb.Type) (cel.Program, error) {
+	declVars := []*exprpb.Decl{
+		decls.NewVar(ceVariable, decls.NewMapType(decls.String, decls.Dyn)),
+		decls.NewVar(dataVariable, decls.Dyn),
+	}
 	for _, variable := range vars {
 		primitiveType := exprpb.Type_PrimitiveType(exprpb.Type_PrimitiveType_value[strings.ToUpper(variable.Type)])
 		declVars = append(declVars, decls.NewVar(variable.Name, decls.NewPrimitiveType(primitiveType)))
@@ -109,6 +149,8 @@ func newCEL(expr string, vars []Variable) (cel.Program, error) {
 
 	env, err := cel.NewEnv(
 		cel.Declarations(declVars...),
+		ext.Strings(),
+		ext.Encoders(),
 	)
 	if err != nil {
 		return nil, err
@@ -119,8 +161,9 @@ func newCEL(expr string, vars []Variable) (cel.Program, error) {
 		return nil, iss.Err()
 	}
 
-	if !proto.Equal(ast.ResultType(), decls.Bool) {
-		return nil, fmt.Errorf("expression %q must return bool type, got %s", expr, ast.ResultType().String())
+	// dynamic results, e.g. the data fields, are asserted during the evaluation
+	if resultType != nil && !proto.Equal(ast.ResultType(), resultType) && !proto.Equal(ast.ResultType(), decls.Dyn) {
+		return nil, fmt.Errorf("expression %q must return %s type, got %s", expr, resultType.String(), ast.ResultType().String())
 	}
 
 	return env.Program(ast)
@@ -86,6 +86,25 @@ func TestCompileExpression(t *testing.T) {
 		"Valid expression 5": {
 			expression: `true`,
 		},
+		"Native attribute": {
+			expression: `ce.type == "foo.bar"`,
+		},
+		"Native data": {
+			expression: `data.items.exists(i, i.id == "foo")`,
+		},
+		"Native dynamic result": {
+			expression: `data.enabled`,
+		},
+		"Native non-bool result": {
+			expression: `ce.type + "foo"`,
+			wantError:  true,
+		},
+		"Native and variables": {
+			expression: `has(ce.subject) && $count.(int64) > 1`,
+		},
+		"Dollar in string literal": {
+			expression: `$name.(string).matches("^foo$")`,
+		},
 	}
 
 	for name, tc := range cases {
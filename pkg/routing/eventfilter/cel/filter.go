@@ -18,10 +18,16 @@ package cel
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
 	"github.com/google/cel-go/cel"
 	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter"
 )
@@ -43,34 +49,160 @@ type Variable struct {
 
 // Filter parses Event payload values defined as the expression variables, asserts their types,
 // and executes CEL Program. If expression result is true, Event passes the filter.
+// Events against which the expression cannot be evaluated, e.g. because they
+// lack an attribute or a data field used by the expression, do not pass the filter.
 func (c *ConditionalFilter) Filter(ctx context.Context, event cloudevents.Event) eventfilter.FilterResult {
-	vars := make(map[string]interface{})
+	pass, err := c.EvaluateEvent(&event, func(path string) gjson.Result {
+		return gjson.GetBytes(event.Data(), path)
+	})
+	if err != nil {
+		logging.FromContext(ctx).Debugw("Unable to evaluate the filter expression against the event",
+			zap.Error(err), zap.String("id", event.ID()))
+		return eventfilter.FailFilter
+	}
+
+	if pass {
+		return eventfilter.PassFilter
+	}
+	return eventfilter.FailFilter
+}
+
+// Evaluate reads expression variables values with the resolve function, asserts their types,
+// and executes CEL Program.
+func (c *ConditionalFilter) Evaluate(resolve func(path string) gjson.Result) (bool, error) {
+	return c.EvaluateEvent(nil, resolve)
+}
+
+// EvaluateEvent is similar to Evaluate but also exposes the event to the expression
+// as the "ce" and "data" variables.
+func (c *ConditionalFilter) EvaluateEvent(event *cloudevents.Event, resolve func(path string) gjson.Result) (bool, error) {
+	out, err := c.EventValue(event, resolve)
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %T instead of bool", out)
+	}
+	return result, nil
+}
+
+// Value reads expression variables values with the resolve function, asserts their types,
+// executes CEL Program and returns its result.
+func (c *ConditionalFilter) Value(resolve func(path string) gjson.Result) (interface{}, error) {
+	return c.EventValue(nil, resolve)
+}
+
+// EventValue is similar to Value but also exposes the event to the expression
+// as the "ce" and "data" variables.
+func (c *ConditionalFilter) EventValue(event *cloudevents.Event, resolve func(path string) gjson.Result) (interface{}, error) {
+	vars := eventVariables(event)
 
 	for _, v := range c.Variables {
-		switch v.Type {
-		case "bool":
-			vars[v.Name] = gjson.GetBytes(event.Data(), v.Path).Bool()
-		case "int64":
-			vars[v.Name] = gjson.GetBytes(event.Data(), v.Path).Int()
-		case "uint64":
-			vars[v.Name] = gjson.GetBytes(event.Data(), v.Path).Uint()
-		case "double":
-			vars[v.Name] = gjson.GetBytes(event.Data(), v.Path).Float()
-		case "string":
-			vars[v.Name] = gjson.GetBytes(event.Data(), v.Path).String()
+		if val := v.value(resolve); val != nil {
+			vars[v.Name] = val
 		}
 	}
 
-	pass, err := eval(*c.Expression, vars)
-	if err != nil || pass {
-		return eventfilter.PassFilter
+	out, _, err := (*c.Expression).Eval(vars)
+	if err != nil {
+		return nil, err
 	}
+	return out.Value(), nil
+}
 
-	return eventfilter.FailFilter
+// VariableValues reads expression variables values with the resolve function and
+// asserts their types. The values are indexed by the paths of the variables.
+func (c *ConditionalFilter) VariableValues(resolve func(path string) gjson.Result) map[string]interface{} {
+	values := make(map[string]interface{}, len(c.Variables))
+	for _, v := range c.Variables {
+		values[v.Path] = v.value(resolve)
+	}
+	return values
 }
 
-// eval evaluates precompiled Expression with passed variables
-func eval(program cel.Program, vars map[string]interface{}) (bool, error) {
-	out, _, err := program.Eval(vars)
-	return out.Value().(bool), err
+// value reads the value of the variable with the resolve function and
+// converts it to the variable type.
+func (v *Variable) value(resolve func(path string) gjson.Result) interface{} {
+	switch v.Type {
+	case "bool":
+		return resolve(v.Path).Bool()
+	case "int64":
+		return resolve(v.Path).Int()
+	case "uint64":
+		return resolve(v.Path).Uint()
+	case "double":
+		return resolve(v.Path).Float()
+	case "string":
+		return resolve(v.Path).String()
+	}
+	return nil
+}
+
+// eventVariables returns the values of the variables that expose the event.
+// Data that cannot be decoded as JSON is exposed as a string. Data is only
+// decoded when the expression references the "data" variable.
+func eventVariables(event *cloudevents.Event) map[string]interface{} {
+	attributes := make(map[string]interface{})
+	vars := map[string]interface{}{
+		ceVariable:   attributes,
+		dataVariable: nil,
+	}
+	if event == nil {
+		return vars
+	}
+
+	for name, value := range event.Extensions() {
+		attributes[name] = extensionValue(value)
+	}
+	attributes["specversion"] = event.SpecVersion()
+	attributes["id"] = event.ID()
+	attributes["type"] = event.Type()
+	attributes["source"] = event.Source()
+	if subject := event.Subject(); subject != "" {
+		attributes["subject"] = subject
+	}
+	if !event.Time().IsZero() {
+		attributes["time"] = event.Time()
+	}
+	if contentType := event.DataContentType(); contentType != "" {
+		attributes["datacontenttype"] = contentType
+	}
+	if schema := event.DataSchema(); schema != "" {
+		attributes["dataschema"] = schema
+	}
+
+	// lazy values are resolved by CEL the first time they are accessed
+	vars[dataVariable] = func() interface{} {
+		return decodeData(event.Data())
+	}
+
+	return vars
+}
+
+// decodeData returns the JSON decoded payload, or the payload as a string
+// when it cannot be decoded.
+func decodeData(payload []byte) interface{} {
+	if len(payload) == 0 {
+		return nil
+	}
+	var data interface{}
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return string(payload)
+	}
+	return data
+}
+
+// extensionValue converts the canonical CE extension types that are not
+// supported by CEL, e.g. URIs and timestamps.
+func extensionValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case types.Timestamp:
+		return v.Time
+	case types.URI, types.URIRef:
+		if str, err := types.Format(v); err == nil {
+			return str
+		}
+	}
+	return value
 }
@@ -0,0 +1,179 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter"
+)
+
+func TestFilterEvent(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetType("io.triggermesh.test")
+	event.SetSource("test")
+	event.SetSubject("Order-42")
+	event.SetTime(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+	event.SetExtension("region", "eu-west-1")
+	err := event.SetData(cloudevents.ApplicationJSON, []byte(
+		`{"count":3,"enabled":true,"items":[{"id":"a","price":10},{"id":"b","price":25}]}`))
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		expression string
+		expect     eventfilter.FilterResult
+	}{
+		"Context attribute": {
+			expression: `ce.type == "io.triggermesh.test" && ce.source == "test"`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Extension": {
+			expression: `ce.region.startsWith("eu-")`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Missing attribute": {
+			expression: `has(ce.dataschema)`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Any item matches": {
+			expression: `data.items.exists(i, i.price > 20.0)`,
+			expect:     eventfilter.PassFilter,
+		},
+		"No item matches": {
+			expression: `data.items.exists(i, i.id == "c")`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Timestamp comparison": {
+			expression: `ce.time > timestamp("2022-01-01T00:00:00Z")`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Regular expression": {
+			expression: `ce.subject.matches("^order-[0-9]+$")`,
+			expect:     eventfilter.FailFilter,
+		},
+		"String extension": {
+			expression: `ce.subject.lowerAscii().matches("^order-[0-9]+$")`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Dynamic result": {
+			expression: `data.enabled`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Native and variables": {
+			expression: `size(data.items) == 2 && $count.(int64) > 2`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Missing attribute in comparison": {
+			expression: `ce.dataschema == "foo"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Missing attribute in negated comparison": {
+			expression: `ce.dataschema != "foo"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Missing data field": {
+			expression: `data.orders.exists(i, i.id == "x")`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Variables": {
+			expression: `$items.0.id.(string) == "b"`,
+			expect:     eventfilter.FailFilter,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cond, err := CompileExpression(tc.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, cond.Filter(context.Background(), event))
+		})
+	}
+}
+
+func TestFilterEventWithoutData(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetType("io.triggermesh.test")
+	event.SetSource("test")
+
+	cases := map[string]struct {
+		expression string
+		expect     eventfilter.FilterResult
+	}{
+		"Missing subject": {
+			expression: `ce.subject == "foo"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Missing data": {
+			expression: `data.items.exists(i, i.id == "x")`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Presence test": {
+			expression: `!has(ce.subject)`,
+			expect:     eventfilter.PassFilter,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cond, err := CompileExpression(tc.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, cond.Filter(context.Background(), event))
+		})
+	}
+}
+
+func TestVariableValues(t *testing.T) {
+	cond, err := CompileExpression(`$name.first.(string) == "bob" && $age.(int64) < 30 && $missing.(bool)`)
+	require.NoError(t, err)
+
+	data := []byte(`{"name":{"first":"bob"},"age":25}`)
+	values := cond.VariableValues(func(path string) gjson.Result {
+		return gjson.GetBytes(data, path)
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"name.first": "bob",
+		"age":        int64(25),
+		"missing":    false,
+	}, values)
+}
+
+func TestEventVariablesLazyData(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetType("io.triggermesh.test")
+	event.SetSource("test")
+	require.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(`{"amount":12.5}`)))
+
+	vars := eventVariables(&event)
+	decode, isLazy := vars[dataVariable].(func() interface{})
+	require.True(t, isLazy, "data is decoded before it is accessed")
+	assert.Equal(t, map[string]interface{}{"amount": 12.5}, decode())
+
+	cond, err := CompileExpression(`data.amount > 10.0 && ce.type == "io.triggermesh.test"`)
+	require.NoError(t, err)
+	assert.Equal(t, eventfilter.PassFilter, cond.Filter(context.Background(), event))
+}
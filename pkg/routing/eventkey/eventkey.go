@@ -0,0 +1,66 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventkey computes the keys of events with Common Language
+// Expressions, e.g. to group the events of a same user or tenant.
+package eventkey
+
+import (
+	"errors"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/tidwall/gjson"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Key computes the keys of events.
+type Key struct {
+	expr cel.ConditionalFilter
+}
+
+// New compiles a key expression. The expression has the same syntax as
+// the Filter expressions, e.g. 'ce.source + "/" + data.user'.
+func New(expression string) (*Key, error) {
+	expr, err := cel.CompileValueExpression(expression)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compile key expression: %w", err)
+	}
+	return &Key{
+		expr: expr,
+	}, nil
+}
+
+// Of returns the key of the event, formatted as a string.
+func (k *Key) Of(event *cloudevents.Event) (string, error) {
+	val, err := k.expr.EventValue(event, func(path string) gjson.Result {
+		return gjson.GetBytes(event.Data(), path)
+	})
+	if err != nil {
+		return "", fmt.Errorf("evaluating key expression: %w", err)
+	}
+	if val == nil {
+		return "", errors.New("key expression returned a null value")
+	}
+	return fmt.Sprint(val), nil
+}
+
+// SourceID returns a key made of the source and ID attributes of the
+// event, which uniquely identify an event.
+func SourceID(event *cloudevents.Event) string {
+	return event.Source() + "/" + event.ID()
+}
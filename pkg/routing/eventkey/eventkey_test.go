@@ -0,0 +1,83 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventkey
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("1")
+	event.SetSource("test.source")
+	event.SetType("test.type")
+	event.SetExtension("tenant", "acme")
+	err := event.SetData(cloudevents.ApplicationJSON, map[string]interface{}{
+		"user":  "alice",
+		"count": 3,
+	})
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		expression string
+		key        string
+		wantError  bool
+	}{
+		"Context attribute": {
+			expression: `ce.source`,
+			key:        "test.source",
+		},
+		"Extension and payload": {
+			expression: `ce.tenant + "/" + data.user`,
+			key:        "acme/alice",
+		},
+		"Typed variable": {
+			expression: `$user.(string)`,
+			key:        "alice",
+		},
+		"Non-string value": {
+			expression: `$count.(int64)`,
+			key:        "3",
+		},
+		"Missing field": {
+			expression: `data.missing`,
+			wantError:  true,
+		},
+	}
+
+	//nolint:scopelint
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			k, err := New(tc.expression)
+			require.NoError(t, err)
+
+			key, err := k.Of(&event)
+			if tc.wantError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.key, key)
+		})
+	}
+
+	assert.Equal(t, "test.source/1", SourceID(&event))
+}
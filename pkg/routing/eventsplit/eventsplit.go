@@ -0,0 +1,198 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventsplit splits the data of CloudEvents into multiple events.
+package eventsplit
+
+import (
+	"bytes"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Extensions set on every split event, which allow consumers to trace and
+// reassemble the items of the same parent event.
+const (
+	// ExtensionParentID is the ID of the split event.
+	ExtensionParentID = "parentid"
+	// ExtensionIndex is the position of the item, or chunk, in the parent event.
+	ExtensionIndex = "index"
+	// ExtensionTotal is the number of events the parent event was split into.
+	ExtensionTotal = "total"
+)
+
+// Config contains the parameters of a Splitter.
+type Config struct {
+	// Items selection, only one of Path, Query and JSONPath can be set.
+	Path     string
+	Query    string
+	JSONPath string
+
+	// ChunkSize is the maximum number of items per emitted event.
+	// Every item is emitted as a single event when it is lower than 1.
+	ChunkSize int
+
+	// Context attributes templates. Subject and ID are optional.
+	Type    string
+	Source  string
+	Subject string
+	ID      string
+
+	Extensions map[string]string
+}
+
+// Splitter splits the data of CloudEvents into multiple events.
+type Splitter struct {
+	selector  Selector
+	chunkSize int
+
+	typ     *jsonpath.JSONPath
+	source  *jsonpath.JSONPath
+	subject *jsonpath.JSONPath
+	id      *jsonpath.JSONPath
+
+	extensions map[string]string
+}
+
+// New returns a Splitter for the given configuration.
+func New(cfg Config) (*Splitter, error) {
+	sel, err := NewSelector(cfg.Path, cfg.Query, cfg.JSONPath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Splitter{
+		selector:   sel,
+		chunkSize:  cfg.ChunkSize,
+		extensions: cfg.Extensions,
+	}
+
+	for _, tpl := range []struct {
+		dst  **jsonpath.JSONPath
+		name string
+		text string
+	}{
+		{&s.typ, "type", cfg.Type},
+		{&s.source, "source", cfg.Source},
+		{&s.subject, "subject", cfg.Subject},
+		{&s.id, "id", cfg.ID},
+	} {
+		if tpl.text == "" {
+			continue
+		}
+		if *tpl.dst, err = ParseTemplate(tpl.name, tpl.text); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// ParseTemplate parses a context attribute template. Templates are plain
+// strings that may contain JSONPath expressions in brackets, e.g.
+// "user/{.name}", evaluated against the data of each split event.
+func ParseTemplate(name, text string) (*jsonpath.JSONPath, error) {
+	tpl := jsonpath.New(name).AllowMissingKeys(true)
+	if err := tpl.Parse(text); err != nil {
+		return nil, fmt.Errorf("cannot parse %s template: %w", name, err)
+	}
+	return tpl, nil
+}
+
+// Split returns the events made of the items selected in the data of the
+// parent event. Split events inherit the parent's subject, time and
+// extensions unless they are overridden by the Splitter configuration.
+func (s *Splitter) Split(parent *cloudevents.Event) ([]*cloudevents.Event, error) {
+	items, err := s.selector.Select(parent.Data())
+	if err != nil {
+		return nil, fmt.Errorf("cannot select items: %w", err)
+	}
+
+	parts := chunk(items, s.chunkSize)
+
+	events := make([]*cloudevents.Event, 0, len(parts))
+	for i, p := range parts {
+		e, err := s.event(parent, p, i, len(parts))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// event returns a child of the parent event with the given data.
+func (s *Splitter) event(parent *cloudevents.Event, data interface{}, index, total int) (*cloudevents.Event, error) {
+	e := parent.Clone()
+	e.SetID(fmt.Sprintf("%s-%d", parent.ID(), index))
+	e.SetDataSchema("")
+
+	if err := e.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return nil, fmt.Errorf("cannot set event data: %w", err)
+	}
+	e.DataBase64 = false
+
+	for _, attr := range []struct {
+		tpl *jsonpath.JSONPath
+		set func(string)
+	}{
+		{s.typ, e.SetType},
+		{s.source, e.SetSource},
+		{s.subject, e.SetSubject},
+		{s.id, e.SetID},
+	} {
+		if attr.tpl == nil {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := attr.tpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("cannot execute template: %w", err)
+		}
+		attr.set(buf.String())
+	}
+
+	for key, value := range s.extensions {
+		e.SetExtension(key, value)
+	}
+	e.SetExtension(ExtensionParentID, parent.ID())
+	e.SetExtension(ExtensionIndex, index)
+	e.SetExtension(ExtensionTotal, total)
+
+	if err := e.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid split event: %w", err)
+	}
+	return &e, nil
+}
+
+// chunk groups the items in slices of the given size. Items are returned
+// as is when size is lower than 1.
+func chunk(items []interface{}, size int) []interface{} {
+	if size < 1 {
+		return items
+	}
+
+	chunks := make([]interface{}, 0, (len(items)+size-1)/size)
+	for size < len(items) {
+		chunks = append(chunks, items[:size:size])
+		items = items[size:]
+	}
+	if len(items) != 0 {
+		chunks = append(chunks, items)
+	}
+	return chunks
+}
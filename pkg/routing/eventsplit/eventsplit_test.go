@@ -0,0 +1,189 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsplit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+const tData = `{
+	"order": "o-1",
+	"items": [
+		{"id": 5, "name": "foo", "price": 10000000000000001},
+		{"id": 10, "name": "bar"},
+		{"id": 15, "name": "baz"}
+	]
+}`
+
+func TestSplit(t *testing.T) {
+	testCases := map[string]struct {
+		cfg        Config
+		data       string
+		expectData []string
+		expectErr  bool
+	}{
+		"Path selector": {
+			cfg:        Config{Path: "items.#.name"},
+			data:       tData,
+			expectData: []string{`"foo"`, `"bar"`, `"baz"`},
+		},
+		"Path selector on a single value": {
+			cfg:        Config{Path: "order"},
+			data:       tData,
+			expectData: []string{`"o-1"`},
+		},
+		"Path selector on a missing value": {
+			cfg:  Config{Path: "nope"},
+			data: tData,
+		},
+		"Path selector preserving numbers": {
+			cfg:        Config{Path: "items.0"},
+			data:       tData,
+			expectData: []string{`{"id":5,"name":"foo","price":10000000000000001}`},
+		},
+		"Root selector": {
+			cfg:        Config{},
+			data:       `[1,2]`,
+			expectData: []string{`1`, `2`},
+		},
+		"jq selector": {
+			cfg:        Config{Query: `.items[] | select(.id > 5) | .id`},
+			data:       tData,
+			expectData: []string{`10`, `15`},
+		},
+		"jq selector returning an array": {
+			cfg:        Config{Query: `[.items[].id]`},
+			data:       tData,
+			expectData: []string{`5`, `10`, `15`},
+		},
+		"JSONPath selector": {
+			cfg:        Config{JSONPath: `$.items[?(@.id > 5.0)].name`},
+			data:       tData,
+			expectData: []string{`"bar"`, `"baz"`},
+		},
+		"JSONPath selector in brackets": {
+			cfg:        Config{JSONPath: `{.items[1]}`},
+			data:       tData,
+			expectData: []string{`{"id":10,"name":"bar"}`},
+		},
+		"Chunks": {
+			cfg:        Config{Query: `.items[].id`, ChunkSize: 2},
+			data:       tData,
+			expectData: []string{`[5,10]`, `[15]`},
+		},
+		"Invalid data": {
+			cfg:       Config{Query: `.items[]`},
+			data:      `{"items":`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s, err := New(tc.cfg)
+			require.NoError(t, err)
+
+			events, err := s.Split(newEvent(t, tc.data))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, events, len(tc.expectData))
+			for i, e := range events {
+				assert.JSONEq(t, tc.expectData[i], string(e.Data()))
+			}
+		})
+	}
+}
+
+func TestSplitContext(t *testing.T) {
+	s, err := New(Config{
+		Path:    "items",
+		Type:    "item.{.name}",
+		Source:  "orders",
+		Subject: "items/{.id}",
+		Extensions: map[string]string{
+			"foo": "bar",
+		},
+	})
+	require.NoError(t, err)
+
+	parent := newEvent(t, tData)
+	events, err := s.Split(parent)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	e := events[1]
+	assert.Equal(t, "ce-abcd-0123-1", e.ID())
+	assert.Equal(t, "item.bar", e.Type())
+	assert.Equal(t, "orders", e.Source())
+	assert.Equal(t, "items/10", e.Subject())
+	assert.Equal(t, parent.Time(), e.Time())
+
+	ext := e.Extensions()
+	assert.Equal(t, "bar", ext["foo"])
+	assert.Equal(t, "keep", ext["parentext"])
+	assert.Equal(t, "ce-abcd-0123", ext[ExtensionParentID])
+	assert.EqualValues(t, 1, ext[ExtensionIndex])
+	assert.EqualValues(t, 3, ext[ExtensionTotal])
+
+	s, err = New(Config{Path: "items", ID: "item-{.id}"})
+	require.NoError(t, err)
+
+	events, err = s.Split(parent)
+	require.NoError(t, err)
+	assert.Equal(t, "item-15", events[2].ID())
+	assert.Equal(t, parent.Subject(), events[2].Subject())
+}
+
+func TestNew(t *testing.T) {
+	testCases := map[string]Config{
+		"Multiple selectors": {Path: "items", Query: ".items[]"},
+		"Invalid jq query":   {Query: ".items["},
+		"Invalid JSONPath":   {JSONPath: "{.items[}"},
+		"Invalid template":   {Path: "items", Subject: "{.id"},
+	}
+
+	for name, cfg := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			_, err := New(cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func newEvent(t *testing.T, data string) *cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID("ce-abcd-0123")
+	event.SetType("ce.test.type")
+	event.SetSource("ce.test.source")
+	event.SetSubject("ce.test.subject")
+	event.SetTime(time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC))
+	event.SetExtension("parentext", "keep")
+	require.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(data)))
+	return &event
+}
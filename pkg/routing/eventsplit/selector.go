@@ -0,0 +1,198 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsplit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/itchyny/gojq"
+	"github.com/tidwall/gjson"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+var errMultipleSelectors = errors.New("only one of path, query and JSONPath can be set")
+
+// Selector extracts the items to split from the data of an event.
+type Selector interface {
+	Select(data []byte) ([]interface{}, error)
+}
+
+// NewSelector returns the Selector of either a gjson path, a jq query or a
+// JSONPath expression. The root of the data is selected when all of them
+// are empty.
+func NewSelector(path, query, jsonPathExpr string) (Selector, error) {
+	set := 0
+	for _, s := range []string{path, query, jsonPathExpr} {
+		if s != "" {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, errMultipleSelectors
+	}
+
+	switch {
+	case query != "":
+		q, err := gojq.Parse(query)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse jq query: %w", err)
+		}
+		code, err := gojq.Compile(q)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compile jq query: %w", err)
+		}
+		return &jqSelector{code: code}, nil
+
+	case jsonPathExpr != "":
+		if !isJSONPathTemplate(jsonPathExpr) {
+			jsonPathExpr = "{" + jsonPathExpr + "}"
+		}
+		jp := jsonpath.New("selector").AllowMissingKeys(true)
+		if err := jp.Parse(jsonPathExpr); err != nil {
+			return nil, fmt.Errorf("cannot parse JSONPath expression: %w", err)
+		}
+		return &jsonPathSelector{jp: jp}, nil
+
+	case path != "":
+		return &pathSelector{path: path}, nil
+
+	default:
+		return &pathSelector{path: "@this"}, nil
+	}
+}
+
+// pathSelector selects items with a gjson path.
+type pathSelector struct {
+	path string
+}
+
+var _ Selector = (*pathSelector)(nil)
+
+// Select implements Selector.
+func (s *pathSelector) Select(data []byte) ([]interface{}, error) {
+	val := gjson.GetBytes(data, s.path)
+	if !val.Exists() {
+		return nil, nil
+	}
+
+	raws := []gjson.Result{val}
+	if val.IsArray() {
+		raws = val.Array()
+	}
+
+	items := make([]interface{}, 0, len(raws))
+	for _, r := range raws {
+		item, err := decode([]byte(r.Raw))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// jqSelector selects items with the results of a jq query.
+type jqSelector struct {
+	code *gojq.Code
+}
+
+var _ Selector = (*jqSelector)(nil)
+
+// Select implements Selector.
+func (s *jqSelector) Select(data []byte) ([]interface{}, error) {
+	in, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []interface{}
+	iter := s.code.Run(in)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			return nil, fmt.Errorf("jq query failed: %w", err)
+		}
+		results = append(results, v)
+	}
+	return expand(results), nil
+}
+
+// jsonPathSelector selects items with a JSONPath expression.
+type jsonPathSelector struct {
+	jp *jsonpath.JSONPath
+}
+
+var _ Selector = (*jsonPathSelector)(nil)
+
+// Select implements Selector.
+func (s *jsonPathSelector) Select(data []byte) ([]interface{}, error) {
+	// numbers are decoded as float64 values, which the filter
+	// expressions of the jsonpath package are able to compare
+	var in interface{}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, fmt.Errorf("cannot decode event data: %w", err)
+	}
+
+	res, err := s.jp.FindResults(in)
+	if err != nil {
+		return nil, fmt.Errorf("JSONPath evaluation failed: %w", err)
+	}
+
+	var results []interface{}
+	for _, values := range res {
+		for _, v := range values {
+			results = append(results, v.Interface())
+		}
+	}
+	return expand(results), nil
+}
+
+// expand returns the elements of the result when a selector returns
+// a single array, e.g. ".items" instead of ".items[]".
+func expand(results []interface{}) []interface{} {
+	if len(results) == 1 {
+		if arr, ok := results[0].([]interface{}); ok {
+			return arr
+		}
+	}
+	return results
+}
+
+// decode decodes JSON data preserving the representation of numbers.
+func decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("cannot decode event data: %w", err)
+	}
+	return v, nil
+}
+
+// isJSONPathTemplate returns whether a JSONPath expression is enclosed in
+// brackets, as required by the jsonpath package.
+func isJSONPathTemplate(expr string) bool {
+	return strings.HasPrefix(expr, "{") && strings.HasSuffix(expr, "}")
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package filter
 
 import (
+	"strconv"
+
 	"knative.dev/eventing/pkg/reconciler/source"
 	"knative.dev/pkg/apis"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -26,11 +28,15 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+const envDebugEndpoint = "FILTER_DEBUG_ENDPOINT"
+
 // adapterConfig contains properties used to configure the router's adapter.
 // These are automatically populated by envconfig.
 type adapterConfig struct {
 	// Container image
 	Image string `default:"gcr.io/triggermesh/filter-adapter"`
+	// Enables the endpoint that evaluates filter expressions against sample events
+	DebugEndpoint bool `envconfig:"DEBUG_ENDPOINT" default:"false"`
 
 	// Configuration accessor for logging/metrics/tracing
 	configs source.ConfigAccessor
@@ -43,6 +49,7 @@ var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 func (r *Reconciler) BuildAdapter(rtr commonv1alpha1.Reconcilable, _ *apis.URL) (*servingv1.Service, error) {
 	return common.NewMTAdapterKnService(rtr,
 		resource.Image(r.adapterCfg.Image),
+		resource.EnvVar(envDebugEndpoint, strconv.FormatBool(r.adapterCfg.DebugEndpoint)),
 		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
 	), nil
 }
@@ -43,5 +43,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, o *v1alpha1.Splitter) re
 	// inject component instance into context for usage in reconciliation logic
 	ctx = commonv1alpha1.WithReconcilable(ctx, o)
 
+	dlsURI, err := common.ResolveDeadLetterSink(ctx, r.base.SinkResolver, o.Spec.Delivery)
+	if err != nil {
+		o.Status.DeadLetterSinkURI = nil
+		return err
+	}
+	o.Status.DeadLetterSinkURI = dlsURI
+
 	return r.base.ReconcileAdapter(ctx, r)
 }
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -39,6 +40,8 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/nametemplate"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/objectbatch"
 )
 
 // NewTarget Adapter implementation
@@ -71,10 +74,19 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		config.Credentials = stscreds.NewCredentials(sess, env.AssumeIamRole)
 	}
 
-	return &adapter{
+	var objectKey *nametemplate.Template
+	if env.ObjectKey != "" {
+		if objectKey, err = nametemplate.Parse(env.ObjectKey); err != nil {
+			logger.Panicf("Error parsing object key: %v", err)
+		}
+	}
+
+	trg := &adapter{
 		awsArnString: env.AwsTargetArn,
 		awsArn:       a,
+		bucket:       strings.Split(a.Resource, "/")[0],
 		s3Client:     s3.New(sess, config),
+		objectKey:    objectKey,
 
 		discardCEContext: env.DiscardCEContext,
 		ceClient:         ceClient,
@@ -82,6 +94,14 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.Enabled {
+		if trg.batcher, err = objectbatch.New(env.Options(), trg.putBatch, logger); err != nil {
+			logger.Panicf("Error creating object batcher: %v", err)
+		}
+	}
+
+	return trg
 }
 
 var _ pkgadapter.Adapter = (*adapter)(nil)
@@ -89,8 +109,12 @@ var _ pkgadapter.Adapter = (*adapter)(nil)
 type adapter struct {
 	awsArnString string
 	awsArn       arn.ARN
+	bucket       string
 	s3Client     *s3.S3
 
+	objectKey *nametemplate.Template
+	batcher   *objectbatch.Batcher
+
 	discardCEContext bool
 	ceClient         cloudevents.Client
 	logger           *zap.SugaredLogger
@@ -100,35 +124,43 @@ type adapter struct {
 
 func (a *adapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting AWS S3 Target adapter")
+
+	if a.batcher != nil {
+		defer a.batcher.Close()
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
 // Parse and send the aws event
-func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
-	var dataReader *bytes.Reader
+func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	var data []byte
 	if event.Type() == v1alpha1.EventTypeAWSS3Put || a.discardCEContext {
-		dataReader = bytes.NewReader(event.Data())
+		data = event.Data()
 	} else {
 		d, err := json.Marshal(event)
 		if err != nil {
 			return a.reportError("error marshalling CloudEvent", err)
 		}
-		dataReader = bytes.NewReader(d)
+		data = d
+	}
+
+	key, err := a.key(&event)
+	if err != nil {
+		return a.reportBadRequest("error rendering object key", err)
 	}
 
-	key := event.Subject()
-	if key == "" {
-		key = event.Type() + "/" + event.Source() + "/" + event.Time().String()
+	if a.batcher != nil {
+		return a.dispatchBatch(ctx, key, &event, data)
 	}
 
-	bucket := strings.Split(a.awsArn.Resource, "/")[0]
 	putInput := s3.PutObjectInput{
-		Bucket: &bucket,
+		Bucket: &a.bucket,
 		Key:    &key,
-		Body:   dataReader,
+		Body:   bytes.NewReader(data),
 	}
 
-	result, err := a.s3Client.PutObject(&putInput)
+	result, err := a.s3Client.PutObjectWithContext(ctx, &putInput)
 	if err != nil {
 		return a.reportError("error publishing object to s3 bucket", err)
 	}
@@ -144,7 +176,80 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 	return &responseEvent, cloudevents.ResultACK
 }
 
+// dispatchBatch adds the event to the batch of the given key prefix, and
+// replies once the object that contains the event is written.
+func (a *adapter) dispatchBatch(ctx context.Context, prefix string, event *cloudevents.Event,
+	data []byte) (*cloudevents.Event, cloudevents.Result) {
+	receipt, err := a.batcher.Add(ctx, prefix, event, data)
+	if err != nil {
+		return a.reportError("error publishing batch to s3 bucket", err)
+	}
+
+	responseEvent := cloudevents.NewEvent(cloudevents.VersionV1)
+	err = responseEvent.SetData(cloudevents.ApplicationJSON, batchResult{
+		Bucket:  a.bucket,
+		Receipt: receipt,
+	})
+	if err != nil {
+		return a.reportError("error generating response event", err)
+	}
+
+	responseEvent.SetType(v1alpha1.EventTypeAWSS3Result)
+	responseEvent.SetSource(a.awsArnString)
+	return &responseEvent, cloudevents.ResultACK
+}
+
+// batchResult is the payload of the responses to batched events.
+type batchResult struct {
+	Bucket string `json:"bucket"`
+	*objectbatch.Receipt
+}
+
+// putBatch writes a batch of events to the bucket.
+func (a *adapter) putBatch(ctx context.Context, o *objectbatch.Object) error {
+	putInput := s3.PutObjectInput{
+		Bucket:      &a.bucket,
+		Key:         &o.Name,
+		Body:        bytes.NewReader(o.Body),
+		ContentType: &o.ContentType,
+	}
+	if o.ContentEncoding != "" {
+		putInput.ContentEncoding = &o.ContentEncoding
+	}
+
+	_, err := a.s3Client.PutObjectWithContext(ctx, &putInput)
+	return err
+}
+
+// key returns the key of the object of the event, or the key prefix of
+// its batch when batching. Events are keyed by their subject, or by their
+// type, source and time when the key is not templated.
+func (a *adapter) key(event *cloudevents.Event) (string, error) {
+	if a.objectKey != nil {
+		return a.objectKey.Render(event)
+	}
+
+	if a.batcher != nil {
+		return "", nil
+	}
+
+	if key := event.Subject(); key != "" {
+		return key, nil
+	}
+
+	ts := event.Time()
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	return event.Type() + "/" + event.Source() + "/" + ts.UTC().Format(time.RFC3339Nano), nil
+}
+
 func (a *adapter) reportError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Errorw(msg, zap.Error(err))
 	return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, msg)
 }
+
+func (a *adapter) reportBadRequest(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
+	a.logger.Errorw(msg, zap.Error(err))
+	return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "%s: %s", msg, err)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awss3target
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/nametemplate"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/objectbatch"
+)
+
+func TestObjectKey(t *testing.T) {
+	testCases := map[string]struct {
+		template  string
+		subject   string
+		batch     bool
+		expectKey string
+	}{
+		"subject": {
+			subject:   "reports/1.json",
+			expectKey: "reports/1.json",
+		},
+		"type, source and time": {
+			expectKey: "com.example.order/example/2022-09-05T21:30:15Z",
+		},
+		"template": {
+			template:  "dt={yyyy-MM-dd}/hour={HH}/{id}.json",
+			subject:   "reports/1.json",
+			expectKey: "dt=2022-09-05/hour=21/1234.json",
+		},
+		"batch without template": {
+			batch:     true,
+			expectKey: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			event := cloudevents.NewEvent()
+			event.SetID("1234")
+			event.SetType("com.example.order")
+			event.SetSource("example")
+			event.SetSubject(tc.subject)
+			event.SetTime(time.Date(2022, 9, 5, 23, 30, 15, 0, time.FixedZone("", 2*3600)))
+
+			a := &adapter{}
+			if tc.template != "" {
+				tpl, err := nametemplate.Parse(tc.template)
+				require.NoError(t, err)
+				a.objectKey = tpl
+			}
+			if tc.batch {
+				a.batcher = &objectbatch.Batcher{}
+			}
+
+			key, err := a.key(&event)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectKey, key)
+		})
+	}
+}
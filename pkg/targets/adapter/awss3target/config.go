@@ -18,6 +18,8 @@ package awss3target
 
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/objectbatch"
 )
 
 // NewEnvConfig for configuration parameters
@@ -32,6 +34,12 @@ type envAccessor struct {
 
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
 
+	// Template of the object keys.
+	ObjectKey string `envconfig:"AWS_S3_OBJECT_KEY"`
+
+	// Batching of events into objects.
+	objectbatch.Config
+
 	// Assume this IAM Role when access keys provided.
 	AssumeIamRole string `envconfig:"AWS_ASSUME_ROLE_ARN"`
 
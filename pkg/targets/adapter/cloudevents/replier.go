@@ -162,9 +162,12 @@ func (r *Replier) Ok(in *cloudevents.Event, payload interface{}, opts ...EventRe
 		out.SetID(uuid.New().String())
 	}
 
-	rct, err := r.responseContentType(in)
-	if err != nil {
-		return r.Error(in, ErrorCodeCloudEventProcessing, fmt.Errorf("error choosing response content-type: %w", err), nil)
+	// Choose the content type if no option have already set it.
+	rct := out.DataContentType()
+	if rct == "" {
+		if rct, err = r.responseContentType(in); err != nil {
+			return r.Error(in, ErrorCodeCloudEventProcessing, fmt.Errorf("error choosing response content-type: %w", err), nil)
+		}
 	}
 
 	err = out.SetData(rct, payload)
@@ -88,6 +88,8 @@ func TestOkReplies(t *testing.T) {
 		expectedSource     string
 		expectedSubject    string
 		expectedExtensions map[string]interface{}
+
+		expectedDataContentType string
 	}{
 		"default replier": {
 			in: createFakeEvent(),
@@ -209,6 +211,18 @@ func TestOkReplies(t *testing.T) {
 			expectedSubject:    tOutSubject,
 			expectedExtensions: createSuccessCategoryExtension(),
 		},
+		"reply with data content type replier": {
+			in:                   createFakeEvent(),
+			payload:              tPayload,
+			replierOptions:       []ReplierOption{ReplierWithStaticDataContentType(cloudevents.ApplicationXML)},
+			eventResponseOptions: []EventResponseOption{ResponseWithDataContentType(cloudevents.TextPlain)},
+
+			expectedNilEvent:        false,
+			expectedType:            tOutType,
+			expectedSource:          tOutSource,
+			expectedExtensions:      createSuccessCategoryExtension(),
+			expectedDataContentType: cloudevents.TextPlain,
+		},
 	}
 
 	for name, c := range tc {
@@ -235,6 +249,9 @@ func TestOkReplies(t *testing.T) {
 			assert.Equal(t, c.expectedType, out.Context.GetType(), "Unexpected response type")
 			assert.Equal(t, c.expectedSource, out.Context.GetSource(), "Unexpected response source")
 			assert.Equal(t, c.expectedSubject, out.Context.GetSubject(), "Unexpected response subecjt")
+			if c.expectedDataContentType != "" {
+				assert.Equal(t, c.expectedDataContentType, out.DataContentType(), "Unexpected response content type")
+			}
 
 			exts := out.Context.GetExtensions()
 
@@ -20,8 +20,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -37,6 +40,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/nametemplate"
 )
 
 // NewTarget adapter implementation
@@ -61,10 +65,27 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
+	index, err := nametemplate.Parse(env.IndexName)
+	if err != nil {
+		logger.Panicf("Error parsing index name: %v", err)
+	}
+
+	var documentID *nametemplate.Template
+	if env.DocumentID != "" {
+		if documentID, err = nametemplate.Parse(env.DocumentID); err != nil {
+			logger.Panicf("Error parsing document ID: %v", err)
+		}
+	}
+
 	return &esAdapter{
 		config:           env.GetElasticsearchConfig(),
 		replier:          replier,
-		index:            env.IndexName,
+		index:            index,
+		documentID:       documentID,
+		bulk:             env.Bulk,
+		bulkMaxEvents:    env.BulkMaxEvents,
+		bulkMaxBytes:     env.BulkMaxBytes,
+		bulkInterval:     env.BulkFlushInterval,
 		discardCEContext: env.DiscardCEContext,
 		ceClient:         ceClient,
 		logger:           logger,
@@ -79,7 +100,14 @@ type esAdapter struct {
 	config *elasticsearch.Config
 	client *elasticsearch.Client
 
-	index string
+	index      *nametemplate.Template
+	documentID *nametemplate.Template
+
+	bulk          bool
+	bulkMaxEvents int
+	bulkMaxBytes  int
+	bulkInterval  time.Duration
+	bulkIndexer   *bulkIndexer
 
 	discardCEContext bool
 
@@ -113,10 +141,29 @@ func (a *esAdapter) Start(ctx context.Context) error {
 		a.logger.Debug("Connected to Elasticsearch: %s", string(info))
 	}
 
+	if a.bulk {
+		a.bulkIndexer = newBulkIndexer(client, a.bulkMaxEvents, a.bulkMaxBytes, a.bulkInterval, a.logger)
+		defer a.bulkIndexer.close()
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
 func (a *esAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	index, err := a.index.Render(&event)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeEventContext, fmt.Errorf("rendering index name: %w", err), nil)
+	}
+	// Elasticsearch index names must be lowercase.
+	index = strings.ToLower(index)
+
+	var documentID string
+	if a.documentID != nil {
+		if documentID, err = a.documentID.Render(&event); err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeEventContext, fmt.Errorf("rendering document ID: %w", err), nil)
+		}
+	}
+
 	var data []byte
 
 	if a.discardCEContext {
@@ -129,9 +176,14 @@ func (a *esAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*clo
 		data = jsonEvent
 	}
 
+	if a.bulkIndexer != nil {
+		return a.dispatchBulk(ctx, &event, index, documentID, data)
+	}
+
 	req := esapi.IndexRequest{
-		Index: a.index,
-		Body:  bytes.NewReader(data),
+		Index:      index,
+		DocumentID: documentID,
+		Body:       bytes.NewReader(data),
 	}
 
 	res, err := req.Do(ctx, a.client)
@@ -141,7 +193,7 @@ func (a *esAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*clo
 	}
 	defer res.Body.Close()
 	if res.IsError() {
-		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, errors.New(res.String()), nil)
 
 	}
 
@@ -154,3 +206,26 @@ func (a *esAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*clo
 	a.logger.Debug("Indexed CloudEvent: ", resp["result"])
 	return a.replier.Ok(&event, res)
 }
+
+// dispatchBulk indexes the document through the bulk indexer. Documents
+// rejected with a transient status are left to the retries of the platform,
+// other rejections are replied with the reason reported by Elasticsearch.
+func (a *esAdapter) dispatchBulk(ctx context.Context, event *cloudevents.Event, index, documentID string,
+	data []byte) (*cloudevents.Event, cloudevents.Result) {
+	// Documents of bulk requests are delimited by newlines.
+	var doc bytes.Buffer
+	if err := json.Compact(&doc, data); err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, fmt.Errorf("document is not valid JSON: %w", err), nil)
+	}
+
+	item, err := a.bulkIndexer.index(ctx, index, documentID, doc.Bytes())
+	switch {
+	case item != nil && err != nil && isRetryable(item):
+		return a.replier.ErrorKnativeManaged(event, err)
+	case err != nil:
+		return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, item)
+	}
+
+	a.logger.Debug("Indexed CloudEvent: ", item.Result)
+	return a.replier.Ok(event, item)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearchtarget
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// bulkRequestTimeout is the maximum duration of a request to the _bulk API.
+// Batches are sent independently of the requests of their documents, which
+// therefore do not bound the duration of the indexing.
+const bulkRequestTimeout = 30 * time.Second
+
+// bulkIndexer buffers documents and indexes them through the _bulk API when
+// the number of documents, their size or the time they have been buffered
+// reaches its threshold.
+type bulkIndexer struct {
+	client *elasticsearch.Client
+	logger *zap.SugaredLogger
+
+	maxEvents int
+	maxBytes  int
+	interval  time.Duration
+
+	mu    sync.Mutex
+	items []*bulkItem
+	size  int
+	timer *time.Timer
+}
+
+// bulkItem is a document waiting for the result of its indexing.
+type bulkItem struct {
+	index string
+	id    string
+	doc   []byte
+
+	result chan bulkResult
+}
+
+// bulkResult is the outcome of the indexing of a bulkItem.
+type bulkResult struct {
+	item *bulkResponseItem
+	err  error
+}
+
+// bulkResponse is the response of the _bulk API.
+type bulkResponse struct {
+	Errors bool                           `json:"errors"`
+	Items  []map[string]*bulkResponseItem `json:"items"`
+}
+
+// bulkResponseItem is the result of a single action of the _bulk API.
+type bulkResponseItem struct {
+	Index   string         `json:"_index"`
+	ID      string         `json:"_id"`
+	Version int64          `json:"_version,omitempty"`
+	Result  string         `json:"result,omitempty"`
+	Status  int            `json:"status"`
+	Error   *bulkItemError `json:"error,omitempty"`
+}
+
+// bulkItemError is the reason of a failed action of the _bulk API.
+type bulkItemError struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// newBulkIndexer returns a bulkIndexer which uses the given client.
+func newBulkIndexer(client *elasticsearch.Client, maxEvents, maxBytes int, interval time.Duration,
+	logger *zap.SugaredLogger) *bulkIndexer {
+	return &bulkIndexer{
+		client:    client,
+		logger:    logger,
+		maxEvents: maxEvents,
+		maxBytes:  maxBytes,
+		interval:  interval,
+	}
+}
+
+// index buffers the document and waits for the result of its indexing.
+// The document must be a single line of JSON.
+//
+// The document is removed from the buffer if the context is done before it
+// is flushed, so that it is not indexed along with the redelivery of the
+// event. Once the document is being flushed, it is no longer removed.
+func (b *bulkIndexer) index(ctx context.Context, index, id string, doc []byte) (*bulkResponseItem, error) {
+	item := &bulkItem{
+		index:  index,
+		id:     id,
+		doc:    doc,
+		result: make(chan bulkResult, 1),
+	}
+
+	if batch := b.add(item); batch != nil {
+		b.flush(batch)
+	}
+
+	select {
+	case res := <-item.result:
+		return res.item, res.err
+	case <-ctx.Done():
+		b.remove(item)
+		return nil, ctx.Err()
+	}
+}
+
+// add appends the item to the buffer, and returns the buffered items if
+// one of the thresholds is reached.
+func (b *bulkIndexer) add(item *bulkItem) []*bulkItem {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.items = append(b.items, item)
+	b.size += len(item.doc)
+
+	if (b.maxEvents > 0 && len(b.items) >= b.maxEvents) || (b.maxBytes > 0 && b.size >= b.maxBytes) {
+		return b.takeLocked()
+	}
+
+	if b.timer == nil {
+		b.timer = time.AfterFunc(b.interval, b.flushPending)
+	}
+	return nil
+}
+
+// remove removes the item from the buffer, unless it was already flushed.
+func (b *bulkIndexer) remove(item *bulkItem) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, bi := range b.items {
+		if bi == item {
+			b.items = append(b.items[:i], b.items[i+1:]...)
+			b.size -= len(item.doc)
+			break
+		}
+	}
+
+	if len(b.items) == 0 {
+		b.takeLocked()
+	}
+}
+
+// flushPending indexes the buffered items.
+func (b *bulkIndexer) flushPending() {
+	b.mu.Lock()
+	batch := b.takeLocked()
+	b.mu.Unlock()
+
+	if batch != nil {
+		b.flush(batch)
+	}
+}
+
+// takeLocked empties the buffer and returns its items.
+// The caller must hold the lock.
+func (b *bulkIndexer) takeLocked() []*bulkItem {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	batch := b.items
+	b.items = nil
+	b.size = 0
+	return batch
+}
+
+// flush indexes a batch of items and delivers their results.
+func (b *bulkIndexer) flush(batch []*bulkItem) {
+	if len(batch) == 0 {
+		return
+	}
+
+	items, err := b.do(batch)
+	if err != nil {
+		b.logger.Errorw("Bulk request failed", zap.Int("documents", len(batch)), zap.Error(err))
+	}
+
+	for i, item := range batch {
+		switch {
+		case err != nil:
+			item.result <- bulkResult{err: err}
+		case items[i].Error != nil:
+			item.result <- bulkResult{
+				item: items[i],
+				err:  fmt.Errorf("document rejected with status %d: %s: %s", items[i].Status, items[i].Error.Type, items[i].Error.Reason),
+			}
+		default:
+			item.result <- bulkResult{item: items[i]}
+		}
+	}
+}
+
+// do sends the batch to the _bulk API and returns the result of each item,
+// in the same order as the batch.
+func (b *bulkIndexer) do(batch []*bulkItem) ([]*bulkResponseItem, error) {
+	var body bytes.Buffer
+
+	for _, item := range batch {
+		meta := map[string]map[string]string{
+			"index": {"_index": item.index},
+		}
+		if item.id != "" {
+			meta["index"]["_id"] = item.id
+		}
+		if err := json.NewEncoder(&body).Encode(meta); err != nil {
+			return nil, fmt.Errorf("encoding bulk action: %w", err)
+		}
+		body.Write(item.doc)
+		body.WriteByte('\n')
+	}
+
+	req := esapi.BulkRequest{
+		Body: &body,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), bulkRequestTimeout)
+	defer cancel()
+
+	res, err := req.Do(ctx, b.client)
+	if err != nil {
+		return nil, fmt.Errorf("sending bulk request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		msg, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("bulk request returned status %d: %s", res.StatusCode, msg)
+	}
+
+	resp := &bulkResponse{}
+	if err := json.NewDecoder(res.Body).Decode(resp); err != nil {
+		return nil, fmt.Errorf("decoding bulk response: %w", err)
+	}
+
+	if len(resp.Items) != len(batch) {
+		return nil, fmt.Errorf("bulk response contains %d items, expected %d", len(resp.Items), len(batch))
+	}
+
+	items := make([]*bulkResponseItem, len(batch))
+	for i, action := range resp.Items {
+		item := action["index"]
+		if item == nil {
+			return nil, fmt.Errorf("bulk response item %d is not the result of an index action", i)
+		}
+		items[i] = item
+	}
+
+	return items, nil
+}
+
+// close indexes the buffered items.
+func (b *bulkIndexer) close() {
+	b.flushPending()
+}
+
+// isRetryable returns whether the rejection of a document is transient,
+// in which case indexing the document again may succeed.
+func isRetryable(item *bulkResponseItem) bool {
+	return item.Status == http.StatusTooManyRequests || item.Status >= http.StatusInternalServerError
+}
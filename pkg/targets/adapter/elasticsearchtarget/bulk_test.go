@@ -0,0 +1,153 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearchtarget
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/elastic/go-elasticsearch/v7"
+)
+
+func TestBulkIndexer(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+
+		// product check of the client
+		if r.URL.Path != "/_bulk" {
+			_, _ = w.Write([]byte(`{"version":{"number":"7.17.7"}}`))
+			return
+		}
+
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		resp := &bulkResponse{}
+
+		sc := bufio.NewScanner(r.Body)
+		for sc.Scan() {
+			meta := map[string]map[string]string{}
+			require.NoError(t, json.Unmarshal(sc.Bytes(), &meta))
+			require.True(t, sc.Scan(), "missing document")
+
+			item := &bulkResponseItem{
+				Index:  meta["index"]["_index"],
+				ID:     meta["index"]["_id"],
+				Result: "created",
+				Status: http.StatusCreated,
+			}
+			if strings.Contains(sc.Text(), "reject") {
+				resp.Errors = true
+				item.Result = ""
+				item.Status = http.StatusBadRequest
+				item.Error = &bulkItemError{Type: "mapper_parsing_exception", Reason: "failed to parse"}
+			}
+			resp.Items = append(resp.Items, map[string]*bulkResponseItem{"index": item})
+		}
+
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{srv.URL}})
+	require.NoError(t, err)
+
+	t.Run("flush on max events with partial failure", func(t *testing.T) {
+		b := newBulkIndexer(client, 3, 0, time.Hour, zap.NewNop().Sugar())
+
+		docs := []string{`{"n":1}`, `{"n":"reject"}`, `{"n":3}`}
+		items := make([]*bulkResponseItem, len(docs))
+		errs := make([]error, len(docs))
+
+		var wg sync.WaitGroup
+		for i, doc := range docs {
+			wg.Add(1)
+			go func(i int, doc string) {
+				defer wg.Done()
+				items[i], errs[i] = b.index(context.Background(), "logs", "id-"+doc, []byte(doc))
+			}(i, doc)
+		}
+		wg.Wait()
+
+		assert.NoError(t, errs[0])
+		assert.Equal(t, "created", items[0].Result)
+		assert.Equal(t, "id-"+docs[0], items[0].ID)
+
+		assert.Error(t, errs[1])
+		assert.Equal(t, http.StatusBadRequest, items[1].Status)
+		assert.False(t, isRetryable(items[1]))
+
+		assert.NoError(t, errs[2])
+
+		mu.Lock()
+		assert.Equal(t, 1, requests)
+		requests = 0
+		mu.Unlock()
+	})
+
+	t.Run("flush on interval", func(t *testing.T) {
+		b := newBulkIndexer(client, 100, 0, 10*time.Millisecond, zap.NewNop().Sugar())
+
+		item, err := b.index(context.Background(), "logs", "", []byte(`{"n":1}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "logs", item.Index)
+
+		mu.Lock()
+		assert.Equal(t, 1, requests)
+		requests = 0
+		mu.Unlock()
+	})
+
+	t.Run("flush on max bytes", func(t *testing.T) {
+		b := newBulkIndexer(client, 100, 8, time.Hour, zap.NewNop().Sugar())
+
+		_, err := b.index(context.Background(), "logs", "", []byte(`{"n":12345}`))
+		assert.NoError(t, err)
+	})
+}
+
+func TestBulkIndexerCancelled(t *testing.T) {
+	b := newBulkIndexer(nil, 2, 0, time.Hour, zap.NewNop().Sugar())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := b.index(ctx, "events", "", []byte(`{"a":1}`))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	assert.Empty(t, b.items, "Expected the document to be removed from the buffer")
+	assert.Zero(t, b.size)
+	assert.Nil(t, b.timer, "Expected the timer of the empty buffer to be stopped")
+}
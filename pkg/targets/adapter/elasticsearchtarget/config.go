@@ -20,6 +20,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"net/http"
+	"time"
 
 	"github.com/elastic/go-elasticsearch/v7"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
@@ -40,7 +41,14 @@ type envAccessor struct {
 	CACert     string   `envconfig:"ELASTICSEARCH_CACERT"`
 	SkipVerify bool     `envconfig:"ELASTICSEARCH_SKIPVERIFY" default:"false"`
 
-	IndexName string `envconfig:"ELASTICSEARCH_INDEX" required:"true"`
+	IndexName  string `envconfig:"ELASTICSEARCH_INDEX" required:"true"`
+	DocumentID string `envconfig:"ELASTICSEARCH_DOCUMENT_ID"`
+
+	// Bulk indexing parametrization
+	Bulk              bool          `envconfig:"ELASTICSEARCH_BULK"`
+	BulkMaxEvents     int           `envconfig:"ELASTICSEARCH_BULK_MAX_EVENTS" default:"500"`
+	BulkMaxBytes      int           `envconfig:"ELASTICSEARCH_BULK_MAX_BYTES" default:"5242880"`
+	BulkFlushInterval time.Duration `envconfig:"ELASTICSEARCH_BULK_FLUSH_INTERVAL" default:"1s"`
 
 	DiscardCEContext bool `envconfig:"ELASTICSEARCH_DISCARD_CE_CONTEXT"`
 
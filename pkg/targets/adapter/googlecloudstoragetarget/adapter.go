@@ -34,6 +34,8 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/nametemplate"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/objectbatch"
 )
 
 // NewTarget adapter implementation
@@ -64,9 +66,20 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
-	return &googlecloudstorageAdapter{
-		client: client,
-		bucket: client.Bucket(env.BucketName),
+	var objectName *nametemplate.Template
+	if env.ObjectName != "" {
+		if objectName, err = nametemplate.Parse(env.ObjectName); err != nil {
+			logger.Panicf("Error parsing object name: %v", err)
+		}
+	}
+
+	a := &googlecloudstorageAdapter{
+		client:     client,
+		bucket:     client.Bucket(env.BucketName),
+		bucketName: env.BucketName,
+
+		objectName:  objectName,
+		contentType: env.ContentType,
 
 		discardCEContext: env.DiscardCEContext,
 		replier:          replier,
@@ -75,13 +88,26 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.Enabled {
+		if a.batcher, err = objectbatch.New(env.Options(), a.writeBatch, logger); err != nil {
+			logger.Panicf("Error creating object batcher: %v", err)
+		}
+	}
+
+	return a
 }
 
 var _ pkgadapter.Adapter = (*googlecloudstorageAdapter)(nil)
 
 type googlecloudstorageAdapter struct {
-	client *storage.Client
-	bucket *storage.BucketHandle
+	client     *storage.Client
+	bucket     *storage.BucketHandle
+	bucketName string
+
+	objectName  *nametemplate.Template
+	contentType string
+	batcher     *objectbatch.Batcher
 
 	discardCEContext bool
 	replier          *targetce.Replier
@@ -94,6 +120,11 @@ type googlecloudstorageAdapter struct {
 // Returns if stopCh is closed or Send() returns an error.
 func (a *googlecloudstorageAdapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting Google Cloud Storage Adapter")
+
+	if a.batcher != nil {
+		defer a.batcher.Close()
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
@@ -110,8 +141,19 @@ func (a *googlecloudstorageAdapter) dispatch(ctx context.Context, event cloudeve
 		}
 	}
 
-	obj := a.bucket.Object(event.ID() + ".json")
-	w := obj.NewWriter(ctx)
+	name, err := a.name(&event)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeEventContext, err, nil)
+	}
+
+	if a.batcher != nil {
+		return a.dispatchBatch(ctx, name, &event, data)
+	}
+
+	w := a.bucket.Object(name).NewWriter(ctx)
+	if a.contentType != "" {
+		w.ContentType = a.contentType
+	}
 
 	if _, err := w.Write(data); err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
@@ -121,5 +163,61 @@ func (a *googlecloudstorageAdapter) dispatch(ctx context.Context, event cloudeve
 	}
 
 	return a.replier.Ok(&event, "ok")
+}
+
+// dispatchBatch adds the event to the batch of the given name prefix, and
+// waits until the object that contains the event is written. The last event
+// of the object is replied with a manifest of the object.
+func (a *googlecloudstorageAdapter) dispatchBatch(ctx context.Context, prefix string, event *cloudevents.Event,
+	data []byte) (*cloudevents.Event, cloudevents.Result) {
+	receipt, err := a.batcher.Add(ctx, prefix, event, data)
+	if err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	if !receipt.Final {
+		return a.replier.Ack()
+	}
+
+	return a.replier.Ok(event, &manifest{
+		Bucket:  a.bucketName,
+		Receipt: receipt,
+	}, targetce.ResponseWithType(v1alpha1.EventTypeGoogleCloudStorageManifest))
+}
+
+// manifest is the payload of the events which report written batches.
+type manifest struct {
+	Bucket string `json:"bucket"`
+	*objectbatch.Receipt
+}
+
+// writeBatch writes a batch of events to the bucket.
+func (a *googlecloudstorageAdapter) writeBatch(ctx context.Context, o *objectbatch.Object) error {
+	w := a.bucket.Object(o.Name).NewWriter(ctx)
+	w.ContentType = o.ContentType
+	if a.contentType != "" {
+		w.ContentType = a.contentType
+	}
+	w.ContentEncoding = o.ContentEncoding
+
+	if _, err := w.Write(o.Body); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// name returns the name of the object of the event, or the name prefix of
+// its batch when batching. Events are named after their ID when the name
+// is not templated.
+func (a *googlecloudstorageAdapter) name(event *cloudevents.Event) (string, error) {
+	if a.objectName != nil {
+		return a.objectName.Render(event)
+	}
+
+	if a.batcher != nil {
+		return "", nil
+	}
 
+	return event.ID() + ".json", nil
 }
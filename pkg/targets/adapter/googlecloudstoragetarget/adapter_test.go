@@ -0,0 +1,80 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecloudstoragetarget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/nametemplate"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/objectbatch"
+)
+
+func TestObjectName(t *testing.T) {
+	testCases := map[string]struct {
+		template   string
+		batch      bool
+		expectName string
+	}{
+		"event ID": {
+			expectName: "1234.json",
+		},
+		"template": {
+			template:   "{type}/dt={yyyy-MM-dd}/{id}.json",
+			expectName: "com.example.order/dt=2022-09-05/1234.json",
+		},
+		"batch with template": {
+			template:   "{type}/dt={yyyy-MM-dd}/",
+			batch:      true,
+			expectName: "com.example.order/dt=2022-09-05/",
+		},
+		"batch without template": {
+			batch:      true,
+			expectName: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			event := cloudevents.NewEvent()
+			event.SetID("1234")
+			event.SetType("com.example.order")
+			event.SetSource("example")
+			event.SetTime(time.Date(2022, 9, 5, 21, 30, 15, 0, time.UTC))
+
+			a := &googlecloudstorageAdapter{}
+			if tc.template != "" {
+				tpl, err := nametemplate.Parse(tc.template)
+				require.NoError(t, err)
+				a.objectName = tpl
+			}
+			if tc.batch {
+				a.batcher = &objectbatch.Batcher{}
+			}
+
+			n, err := a.name(&event)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectName, n)
+		})
+	}
+}
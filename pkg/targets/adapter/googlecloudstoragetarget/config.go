@@ -16,7 +16,11 @@ limitations under the License.
 
 package googlecloudstoragetarget
 
-import pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+import (
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/objectbatch"
+)
 
 // EnvAccessorCtor for configuration parameters
 func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
@@ -37,4 +41,12 @@ type envAccessor struct {
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 
 	DiscardCEContext bool `envconfig:"GOOGLE_STORAGE_DISCARD_CE_CONTEXT"`
+
+	// Template of the object names.
+	ObjectName string `envconfig:"GOOGLE_STORAGE_OBJECT_NAME"`
+	// Content type of the objects.
+	ContentType string `envconfig:"GOOGLE_STORAGE_CONTENT_TYPE"`
+
+	// Batching of events into objects.
+	objectbatch.Config
 }
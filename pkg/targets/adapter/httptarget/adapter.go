@@ -21,12 +21,17 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/itchyny/gojq"
 	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -95,6 +100,33 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		client = cfg.Client(ctx)
 	}
 
+	var request *requestTemplate
+	if env.RequestTemplate.enabled {
+		request = &env.RequestTemplate
+	}
+
+	var successCodes map[int]struct{}
+	if len(env.SuccessCodes) > 0 {
+		successCodes = make(map[int]struct{}, len(env.SuccessCodes))
+		for _, c := range env.SuccessCodes {
+			successCodes[c] = struct{}{}
+		}
+	}
+
+	var retry *retryPolicy
+	if env.Retry {
+		retry = &retryPolicy{
+			attempts:   env.RetryAttempts,
+			minBackoff: env.RetryMinBackoff,
+			maxBackoff: env.RetryMaxBackoff,
+		}
+	}
+
+	var breaker *circuitBreaker
+	if env.CircuitBreaker {
+		breaker = newCircuitBreaker(env.CircuitBreakerFailureThreshold, env.CircuitBreakerOpenDuration)
+	}
+
 	return &httpAdapter{
 		eventType:   env.EventType,
 		eventSource: env.EventSource,
@@ -105,6 +137,13 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		basicAuthUsername: env.BasicAuthUsername,
 		basicAuthPassword: env.BasicAuthPassword,
 		client:            client,
+		retry:             retry,
+		breaker:           breaker,
+
+		request:            request,
+		successCodes:       successCodes,
+		responseDataQuery:  env.ResponseDataQuery.Code,
+		responseAttributes: env.ResponseAttributes,
 
 		ceClient: ceClient,
 		logger:   logger,
@@ -125,7 +164,14 @@ type httpAdapter struct {
 	basicAuthUsername string
 	basicAuthPassword string
 
-	client *http.Client
+	client  *http.Client
+	retry   *retryPolicy
+	breaker *circuitBreaker
+
+	request            *requestTemplate
+	successCodes       map[int]struct{}
+	responseDataQuery  *gojq.Code
+	responseAttributes attributeTemplates
 
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -142,10 +188,18 @@ func (a *httpAdapter) Start(ctx context.Context) error {
 
 func (a *httpAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	rd := &RequestData{}
-	if event.Type() != v1alpha1.EventTypeHTTPTargetRequest {
+	switch {
+	case a.request != nil:
+		var err error
+		if rd, err = a.request.render(ctx, &event); err != nil {
+			return nil, a.errorHTTPResult(http.StatusBadRequest, "Error rendering request template: %w", err)
+		}
+	case event.Type() != v1alpha1.EventTypeHTTPTargetRequest:
 		rd.Body = event.Data()
-	} else if err := event.DataAs(rd); err != nil {
-		return nil, a.errorHTTPResult(http.StatusBadRequest, "Error processing incoming event data: %w", err)
+	default:
+		if err := event.DataAs(rd); err != nil {
+			return nil, a.errorHTTPResult(http.StatusBadRequest, "Error processing incoming event data: %w", err)
+		}
 	}
 
 	u := *a.url
@@ -167,7 +221,7 @@ func (a *httpAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*c
 		u.Path = path.Join(u.Path, rd.PathSuffix)
 	}
 
-	req, err := http.NewRequest(a.method, u.String(), bytes.NewBuffer(rd.Body))
+	req, err := http.NewRequestWithContext(ctx, a.method, u.String(), bytes.NewBuffer(rd.Body))
 	if err != nil {
 		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Could not create HTTP request: %w", err)
 	}
@@ -186,31 +240,52 @@ func (a *httpAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*c
 		req.SetBasicAuth(a.basicAuthUsername, a.basicAuthPassword)
 	}
 
-	res, err := a.client.Do(req)
-	if err != nil {
+	res, resb, err := a.send(ctx, req)
+	switch {
+	case errors.Is(err, errCircuitOpen):
+		return nil, a.errorHTTPResult(statusCircuitOpen, "Request not sent to HTTP endpoint: %w", err)
+	case err != nil:
 		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error sending request: %w", err)
 	}
 
-	defer res.Body.Close()
-	resb, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error reading response body: %w", err)
-	}
-
-	if res.StatusCode >= 400 {
-		return nil, a.errorHTTPResult(res.StatusCode, "Received code %d from HTTP endpoint: %s", res.StatusCode, string(resb))
+	if !a.isSuccess(res.StatusCode) {
+		// statuses which are not errors on their own are reported as a bad gateway
+		code := res.StatusCode
+		if code < 400 {
+			code = http.StatusBadGateway
+		}
+		return nil, a.errorHTTPResult(code, "Received code %d from HTTP endpoint: %s", res.StatusCode, string(resb))
 	}
 
 	// build response event:
 	// - ID is a new generated UUID
 	// - content-type set to the one received at the HTTP response
-	// - raw response data stored at the event data
-	// - status code discarded since there will only be a response if the code is not an error
+	// - raw response data stored at the event data, unless mapped by the response data query
+	// - status code discarded since there will only be a response if the code is successful
 	// - Experimental: keeps the stateful headers if informed at the received event
-	// - subject not informed
+	// - subject not informed, unless rendered from the response attribute templates
+	// - response attribute templates take precedence over type and source
+
+	contentType := res.Header.Get("Content-Type")
+
+	var resData interface{}
+	if a.responseDataQuery != nil || len(a.responseAttributes) > 0 {
+		resData = decodeData(resb)
+	}
+
+	if a.responseDataQuery != nil {
+		v, err := runQuery(ctx, a.responseDataQuery, resData)
+		if err != nil {
+			return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error running response data query: %w", err)
+		}
+		if resb, err = json.Marshal(v); err != nil {
+			return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error serializing response data query result: %w", err)
+		}
+		contentType = cloudevents.ApplicationJSON
+	}
 
 	out := cloudevents.NewEvent()
-	if err := out.SetData(res.Header.Get("Content-Type"), resb); err != nil {
+	if err := out.SetData(contentType, resb); err != nil {
 		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error setting response event data: %w", err)
 	}
 
@@ -231,9 +306,101 @@ func (a *httpAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*c
 	out.SetType(a.eventType)
 	out.SetSource(a.eventSource)
 
+	if len(a.responseAttributes) > 0 {
+		data := responseTemplateData{
+			Event:      event,
+			Data:       resData,
+			StatusCode: res.StatusCode,
+			Header:     res.Header,
+		}
+		if err := a.responseAttributes.apply(&out, data); err != nil {
+			return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error setting response event attributes: %w", err)
+		}
+	}
+
 	return &out, cloudevents.ResultACK
 }
 
+// send sends the request to the HTTP endpoint, retries it as long as it
+// fails with a network error or a retryable status code and the retry policy
+// allows it, and returns the last response along with its body.
+func (a *httpAdapter) send(ctx context.Context, req *http.Request) (*http.Response, []byte, error) {
+	if !a.breaker.allow() {
+		return nil, nil, errCircuitOpen
+	}
+
+	for retry := 1; ; retry++ {
+		res, body, err := a.do(req)
+
+		if !isRetryable(res, err) {
+			// only a response from the endpoint proves that it is healthy
+			if err != nil {
+				a.breaker.release()
+			} else {
+				a.breaker.done(true)
+			}
+			return res, body, err
+		}
+
+		delay, ok := a.retry.backoff(retry, res)
+		if !ok {
+			a.breaker.done(false)
+			return res, body, err
+		}
+
+		a.logger.Debugw("Retrying failed request", zap.Int("retry", retry), zap.Duration("delay", delay),
+			zap.Error(err), zap.Int("status", statusCode(res)))
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			a.breaker.done(false)
+			return res, body, err
+		case <-t.C:
+		}
+
+		if req.Body, err = req.GetBody(); err != nil {
+			a.breaker.done(false)
+			return nil, nil, fmt.Errorf("resetting request body: %w", err)
+		}
+	}
+}
+
+// do sends the request to the HTTP endpoint and reads the response body.
+func (a *httpAdapter) do(req *http.Request) (*http.Response, []byte, error) {
+	res, err := a.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	return res, body, nil
+}
+
+// statusCode returns the status code of the given response, if any.
+func statusCode(res *http.Response) int {
+	if res == nil {
+		return 0
+	}
+	return res.StatusCode
+}
+
+// isSuccess returns whether the given status code of an HTTP response is
+// considered successful.
+func (a *httpAdapter) isSuccess(statusCode int) bool {
+	if a.successCodes == nil {
+		return statusCode < 400
+	}
+	_, ok := a.successCodes[statusCode]
+	return ok
+}
+
 // errorResult given an error status code, writes an error log entry
 // and returns a CloudEvents.Result
 func (a *httpAdapter) errorHTTPResult(statusCode int, message string, args ...interface{}) cloudevents.Result {
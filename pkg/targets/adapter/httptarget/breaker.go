@@ -0,0 +1,118 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptarget
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// statusCircuitOpen is the status of the results of events which are
+// rejected without contacting the endpoint because the circuit is open. It
+// is a non-standard 5xx status, so that these events are redelivered while
+// remaining distinguishable from the statuses returned by the endpoint.
+const statusCircuitOpen = 529
+
+// errCircuitOpen is returned for requests which are not sent to the endpoint
+// because the circuit is open.
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+// circuitBreaker stops requests to the endpoint after a number of
+// consecutive failures. Once the circuit has been open for the configured
+// duration, a single trial request is let through, which success closes the
+// circuit.
+//
+// A nil circuitBreaker lets all requests through.
+type circuitBreaker struct {
+	threshold    int
+	openDuration time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	trial    bool
+
+	// overridable in tests
+	now func() time.Time
+}
+
+// newCircuitBreaker returns a closed circuitBreaker.
+func newCircuitBreaker(threshold int, openDuration time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold:    threshold,
+		openDuration: openDuration,
+		now:          time.Now,
+	}
+}
+
+// allow returns whether a request can be sent to the endpoint. Allowed
+// requests must report their outcome using done.
+func (cb *circuitBreaker) allow() bool {
+	if cb == nil {
+		return true
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.failures < cb.threshold {
+		return true
+	}
+
+	if cb.trial || cb.now().Sub(cb.openedAt) < cb.openDuration {
+		return false
+	}
+
+	cb.trial = true
+	return true
+}
+
+// release ends a request which was allowed but did not reach the endpoint,
+// without recording an outcome.
+func (cb *circuitBreaker) release() {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.trial = false
+}
+
+// done records the outcome of a request which was allowed.
+func (cb *circuitBreaker) done(success bool) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.trial = false
+
+	if success {
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.failures >= cb.threshold {
+		cb.openedAt = cb.now()
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
@@ -47,6 +48,20 @@ type envAccessor struct {
 	OAuthClientSecret string   `envconfig:"HTTP_OAUTH_CLIENT_SECRET"`
 	OAuthAuthTokenURL string   `envconfig:"HTTP_OAUTH_TOKEN_URL"`
 	OAuthScopes       []string `envconfig:"HTTP_OAUTH_SCOPE"`
+
+	RequestTemplate    requestTemplate    `envconfig:"HTTP_REQUEST_TEMPLATE"`
+	SuccessCodes       []int              `envconfig:"HTTP_SUCCESS_CODES"`
+	ResponseDataQuery  dataQuery          `envconfig:"HTTP_RESPONSE_DATA_QUERY"`
+	ResponseAttributes attributeTemplates `envconfig:"HTTP_RESPONSE_ATTRIBUTES"`
+
+	Retry           bool          `envconfig:"HTTP_RETRY"`
+	RetryAttempts   int           `envconfig:"HTTP_RETRY_ATTEMPTS" default:"3"`
+	RetryMinBackoff time.Duration `envconfig:"HTTP_RETRY_MIN_BACKOFF" default:"500ms"`
+	RetryMaxBackoff time.Duration `envconfig:"HTTP_RETRY_MAX_BACKOFF" default:"30s"`
+
+	CircuitBreaker                 bool          `envconfig:"HTTP_CIRCUIT_BREAKER"`
+	CircuitBreakerFailureThreshold int           `envconfig:"HTTP_CIRCUIT_BREAKER_FAILURE_THRESHOLD" default:"5"`
+	CircuitBreakerOpenDuration     time.Duration `envconfig:"HTTP_CIRCUIT_BREAKER_OPEN_DURATION" default:"30s"`
 }
 
 func (e *envAccessor) validateAuth() error {
@@ -0,0 +1,306 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptarget
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/itchyny/gojq"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// requestTemplateData is the data Go templates of requests are executed with.
+type requestTemplateData struct {
+	Event cloudevents.Event
+	Data  interface{}
+}
+
+// responseTemplateData is the data Go templates of reply attributes are
+// executed with.
+type responseTemplateData struct {
+	Event      cloudevents.Event
+	Data       interface{}
+	StatusCode int
+	Header     http.Header
+}
+
+// requestTemplate builds HTTP requests from the incoming events.
+type requestTemplate struct {
+	// set when the template was configured, in which case incoming
+	// events are not interpreted as RequestData.
+	enabled bool
+
+	path      *template.Template
+	query     map[string]*template.Template
+	headers   map[string]*template.Template
+	body      *template.Template
+	bodyQuery *gojq.Code
+}
+
+// Decode implements envconfig.Decoder.
+func (t *requestTemplate) Decode(value string) error {
+	spec := &v1alpha1.HTTPRequestTemplate{}
+	if err := json.Unmarshal([]byte(value), spec); err != nil {
+		return fmt.Errorf("parsing request template: %w", err)
+	}
+
+	var err error
+
+	if spec.Path != nil {
+		if t.path, err = parseTemplate("path", *spec.Path); err != nil {
+			return err
+		}
+	}
+	if t.query, err = parseTemplates("query", spec.Query); err != nil {
+		return err
+	}
+	if t.headers, err = parseTemplates("headers", spec.Headers); err != nil {
+		return err
+	}
+	if spec.Body != nil {
+		if t.body, err = parseTemplate("body", *spec.Body); err != nil {
+			return err
+		}
+	}
+	if spec.BodyQuery != nil {
+		if t.bodyQuery, err = compileQuery(*spec.BodyQuery); err != nil {
+			return err
+		}
+	}
+
+	t.enabled = true
+	return nil
+}
+
+// render executes the template with the given event.
+func (t *requestTemplate) render(ctx context.Context, event *cloudevents.Event) (*RequestData, error) {
+	data := requestTemplateData{
+		Event: *event,
+		Data:  decodeData(event.Data()),
+	}
+
+	rd := &RequestData{
+		Body: event.Data(),
+	}
+
+	var err error
+
+	if t.path != nil {
+		if rd.PathSuffix, err = execute(t.path, data); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(t.query) > 0 {
+		kv := make(url.Values, len(t.query))
+		for k, tpl := range t.query {
+			v, err := execute(tpl, data)
+			if err != nil {
+				return nil, err
+			}
+			kv.Set(k, v)
+		}
+		rd.Query = kv.Encode()
+	}
+
+	if len(t.headers) > 0 {
+		rd.Headers = make(map[string]string, len(t.headers))
+		for k, tpl := range t.headers {
+			if rd.Headers[k], err = execute(tpl, data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	switch {
+	case t.body != nil:
+		body, err := execute(t.body, data)
+		if err != nil {
+			return nil, err
+		}
+		rd.Body = []byte(body)
+
+	case t.bodyQuery != nil:
+		res, err := runQuery(ctx, t.bodyQuery, data.Data)
+		if err != nil {
+			return nil, fmt.Errorf("running body query: %w", err)
+		}
+		if rd.Body, err = json.Marshal(res); err != nil {
+			return nil, fmt.Errorf("serializing body query result: %w", err)
+		}
+	}
+
+	return rd, nil
+}
+
+// dataQuery maps the JSON body of HTTP responses into the data of replies.
+type dataQuery struct {
+	*gojq.Code
+}
+
+// Decode implements envconfig.Decoder.
+func (q *dataQuery) Decode(value string) error {
+	code, err := compileQuery(value)
+	if err != nil {
+		return err
+	}
+
+	q.Code = code
+	return nil
+}
+
+// attributeTemplates set the attributes of replies.
+type attributeTemplates map[string]*template.Template
+
+// Decode implements envconfig.Decoder.
+func (a *attributeTemplates) Decode(value string) error {
+	attrs := make(map[string]string)
+	if err := json.Unmarshal([]byte(value), &attrs); err != nil {
+		return fmt.Errorf("parsing response attributes: %w", err)
+	}
+
+	tpls, err := parseTemplates("attributes", attrs)
+	if err != nil {
+		return err
+	}
+
+	*a = tpls
+	return nil
+}
+
+// apply executes the templates and sets the results as attributes of the
+// given event.
+func (a attributeTemplates) apply(out *cloudevents.Event, data responseTemplateData) error {
+	for name, tpl := range a {
+		v, err := execute(tpl, data)
+		if err != nil {
+			return err
+		}
+
+		switch name {
+		case "type":
+			out.SetType(v)
+		case "source":
+			out.SetSource(v)
+		case "subject":
+			out.SetSubject(v)
+		case "dataschema":
+			out.SetDataSchema(v)
+		default:
+			if err := out.Context.SetExtension(name, v); err != nil {
+				return fmt.Errorf("setting attribute %q: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseTemplate parses a Go template. Executing the template fails when it
+// references a key which is missing from the data, instead of rendering
+// "<no value>".
+func parseTemplate(name, text string) (*template.Template, error) {
+	tpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	return tpl, nil
+}
+
+// parseTemplates parses a set of Go templates by key.
+func parseTemplates(name string, texts map[string]string) (map[string]*template.Template, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	tpls := make(map[string]*template.Template, len(texts))
+	for k, text := range texts {
+		tpl, err := parseTemplate(name+"."+k, text)
+		if err != nil {
+			return nil, err
+		}
+		tpls[k] = tpl
+	}
+
+	return tpls, nil
+}
+
+// execute renders a Go template into a string.
+func execute(tpl *template.Template, data interface{}) (string, error) {
+	var sb strings.Builder
+	if err := tpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("executing template: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// compileQuery parses and compiles a jq query.
+func compileQuery(query string) (*gojq.Code, error) {
+	q, err := gojq.Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("parsing jq query: %w", err)
+	}
+
+	code, err := gojq.Compile(q)
+	if err != nil {
+		return nil, fmt.Errorf("compiling jq query: %w", err)
+	}
+
+	return code, nil
+}
+
+// runQuery runs a compiled jq query and returns its last result.
+func runQuery(ctx context.Context, code *gojq.Code, v interface{}) (interface{}, error) {
+	var out interface{}
+
+	iter := code.RunWithContext(ctx, v)
+	for {
+		res, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := res.(error); ok {
+			return nil, err
+		}
+		out = res
+	}
+
+	return out, nil
+}
+
+// decodeData returns the JSON decoded value of the given data, or the
+// data as a string if it is not JSON.
+func decodeData(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return string(data)
+	}
+	return v
+}
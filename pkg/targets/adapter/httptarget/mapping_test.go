@@ -0,0 +1,239 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptarget
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+	ceevent "github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestTemplatedHTTPRequests(t *testing.T) {
+	type tResponse struct {
+		Path    string
+		Query   string
+		EventID string
+		Body    string
+	}
+
+	tServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			assert.FailNow(t, "mock service could not read body from request")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		res := &tResponse{
+			Path:    r.URL.Path,
+			Query:   r.URL.RawQuery,
+			EventID: r.Header.Get("X-Event-Id"),
+			Body:    string(body),
+		}
+		if err = json.NewEncoder(w).Encode(res); err != nil {
+			assert.FailNow(t, "mock service could not JSON encode response")
+		}
+	}))
+	t.Cleanup(tServer.Close)
+
+	testCases := map[string]struct {
+		// adapter config
+		requestTemplate    string
+		successCodes       []int
+		responseDataQuery  string
+		responseAttributes string
+
+		// request
+		data string
+
+		// expected
+		expectStatus     int
+		expectResponse   *tResponse
+		expectData       string
+		expectAttributes map[string]string
+	}{
+		"path, query and headers": {
+			requestTemplate: `{"path":"/users/{{ .Data.id }}","query":{"src":"{{ .Event.Source }}"},` +
+				`"headers":{"X-Event-Id":"{{ .Event.ID }}"}}`,
+			data: `{"id":"jane"}`,
+			expectResponse: &tResponse{
+				Path:    "/users/jane",
+				Query:   "src=" + url.QueryEscape(tCESource),
+				EventID: tID,
+				Body:    `{"id":"jane"}`,
+			},
+		},
+		"body template": {
+			requestTemplate: `{"body":"name={{ .Data.id }}"}`,
+			data:            `{"id":"jane"}`,
+			expectResponse: &tResponse{
+				Path: "/",
+				Body: "name=jane",
+			},
+		},
+		"missing key": {
+			requestTemplate: `{"path":"/users/{{ .Data.id }}"}`,
+			data:            `{"name":"jane"}`,
+			expectStatus:    http.StatusBadRequest,
+		},
+		"body query": {
+			requestTemplate: `{"bodyQuery":"{user: .id}"}`,
+			data:            `{"id":"jane"}`,
+			expectResponse: &tResponse{
+				Path: "/",
+				Body: `{"user":"jane"}`,
+			},
+		},
+		"response data query and attributes": {
+			requestTemplate:    `{"headers":{"X-Event-Id":"{{ .Event.ID }}"}}`,
+			responseDataQuery:  ".Path",
+			responseAttributes: `{"subject":"{{ .Data.EventID }}","requestid":"{{ .Header.Get \"X-Request-Id\" }}","type":"test.{{ .StatusCode }}"}`,
+			data:               `{}`,
+			expectData:         `"/"`,
+			expectAttributes: map[string]string{
+				"subject":   tID,
+				"requestid": "req-1",
+				"type":      "test.200",
+			},
+		},
+		"custom success code": {
+			requestTemplate: `{"path":"/missing"}`,
+			successCodes:    []int{200, 404},
+			data:            `{}`,
+			expectResponse: &tResponse{
+				Path: "/missing",
+				Body: `{}`,
+			},
+		},
+		"unlisted success code": {
+			successCodes: []int{201},
+			data:         `{}`,
+			expectStatus: http.StatusBadGateway,
+		},
+		"error code": {
+			requestTemplate: `{"path":"/missing"}`,
+			data:            `{}`,
+			expectStatus:    http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ceClient, send, responses := cetest.NewMockResponderClient(t, 1)
+
+			u, err := url.Parse(tServer.URL)
+			require.NoError(t, err, "test URL is not valid")
+
+			adapter := &httpAdapter{
+				eventType:   tEventType,
+				eventSource: tEventSource,
+
+				url:    u,
+				method: http.MethodPost,
+				client: tServer.Client(),
+
+				ceClient: ceClient,
+				logger:   logtesting.TestLogger(t),
+			}
+
+			if tc.requestTemplate != "" {
+				tpl := &requestTemplate{}
+				require.NoError(t, tpl.Decode(tc.requestTemplate))
+				adapter.request = tpl
+			}
+			if tc.successCodes != nil {
+				adapter.successCodes = make(map[int]struct{})
+				for _, c := range tc.successCodes {
+					adapter.successCodes[c] = struct{}{}
+				}
+			}
+			if tc.responseDataQuery != "" {
+				q := &dataQuery{}
+				require.NoError(t, q.Decode(tc.responseDataQuery))
+				adapter.responseDataQuery = q.Code
+			}
+			if tc.responseAttributes != "" {
+				require.NoError(t, adapter.responseAttributes.Decode(tc.responseAttributes))
+			}
+
+			go func() {
+				if err := adapter.Start(context.Background()); err != nil {
+					assert.FailNow(t, "could not start test adapter")
+				}
+			}()
+
+			event := ceevent.New()
+			require.NoError(t, event.SetData(tContentType, []byte(tc.data)))
+			event.SetID(tID)
+			event.SetType(tCETypeArbitrary)
+			event.SetSource(tCESource)
+
+			send <- event
+
+			select {
+			case res := <-responses:
+				if tc.expectStatus != 0 {
+					var httpResult *cehttp.Result
+					require.True(t, cloudevents.ResultAs(res.Result, &httpResult), "expected an HTTP result")
+					assert.Equal(t, tc.expectStatus, httpResult.StatusCode, "unexpected result status")
+					return
+				}
+
+				if tc.expectResponse != nil {
+					got := &tResponse{}
+					assert.NoError(t, res.Event.DataAs(got), "error parsing response from mocked service")
+					assert.Equal(t, tc.expectResponse, got)
+				}
+				if tc.expectData != "" {
+					assert.Equal(t, tc.expectData, string(res.Event.Data()))
+				}
+				for k, v := range tc.expectAttributes {
+					switch k {
+					case "type":
+						assert.Equal(t, v, res.Event.Type())
+					case "subject":
+						assert.Equal(t, v, res.Event.Subject())
+					default:
+						assert.Equal(t, v, res.Event.Extensions()[k])
+					}
+				}
+
+			case <-time.After(1 * time.Second):
+				assert.Fail(t, "expected cloud event response was not received")
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptarget
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryPolicy determines whether and when failed requests are retried.
+type retryPolicy struct {
+	attempts   int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	// overridable in tests
+	rand func() float64
+}
+
+// backoff returns the delay before the given retry, starting at 1, of a
+// request which received the given response. The returned boolean is false
+// if the request should not be retried.
+//
+// Exponential delays are randomized between half and all of their value, so
+// that requests which failed together are not retried in lockstep. Delays
+// requested by the endpoint are honored as is.
+func (p *retryPolicy) backoff(retry int, res *http.Response) (time.Duration, bool) {
+	if p == nil || retry > p.attempts {
+		return 0, false
+	}
+
+	if d, ok := retryAfter(res); ok {
+		return d, d <= p.maxBackoff
+	}
+
+	d := p.minBackoff
+	for i := 1; i < retry && d < p.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.maxBackoff {
+		d = p.maxBackoff
+	}
+
+	random := rand.Float64
+	if p.rand != nil {
+		random = p.rand
+	}
+
+	return d/2 + time.Duration(random()*float64(d/2)), true
+}
+
+// isRetryable returns whether a request which resulted in the given
+// response or error can be retried.
+func isRetryable(res *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+	return res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter returns the delay requested by the endpoint through the
+// Retry-After header of the given response, if any.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+
+	d := time.Until(t)
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
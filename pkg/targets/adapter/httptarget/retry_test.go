@@ -0,0 +1,294 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptarget
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+	ceevent "github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	p := &retryPolicy{
+		attempts:   4,
+		minBackoff: 100 * time.Millisecond,
+		maxBackoff: 300 * time.Millisecond,
+		rand:       func() float64 { return 0.5 },
+	}
+
+	withRetryAfter := func(v string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{v}}}
+	}
+
+	testCases := map[string]struct {
+		policy *retryPolicy
+		retry  int
+		res    *http.Response
+
+		expectDelay time.Duration
+		expectRetry bool
+	}{
+		"no policy": {
+			retry: 1,
+		},
+		"first retry": {
+			policy:      p,
+			retry:       1,
+			expectDelay: 75 * time.Millisecond,
+			expectRetry: true,
+		},
+		"exponential delay": {
+			policy:      p,
+			retry:       2,
+			expectDelay: 150 * time.Millisecond,
+			expectRetry: true,
+		},
+		"capped delay": {
+			policy:      p,
+			retry:       4,
+			expectDelay: 225 * time.Millisecond,
+			expectRetry: true,
+		},
+		"attempts exhausted": {
+			policy: p,
+			retry:  5,
+		},
+		"retry after seconds": {
+			policy:      &retryPolicy{attempts: 1, maxBackoff: 5 * time.Second},
+			retry:       1,
+			res:         withRetryAfter("2"),
+			expectDelay: 2 * time.Second,
+			expectRetry: true,
+		},
+		"retry after past date": {
+			policy:      p,
+			retry:       1,
+			res:         withRetryAfter("Wed, 21 Oct 2015 07:28:00 GMT"),
+			expectRetry: true,
+		},
+		"retry after exceeds max backoff": {
+			policy:      p,
+			retry:       1,
+			res:         withRetryAfter("60"),
+			expectDelay: time.Minute,
+		},
+		"invalid retry after": {
+			policy:      p,
+			retry:       1,
+			res:         withRetryAfter("soon"),
+			expectDelay: 75 * time.Millisecond,
+			expectRetry: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			delay, retry := tc.policy.backoff(tc.retry, tc.res)
+			assert.Equal(t, tc.expectRetry, retry)
+			if retry || tc.expectDelay != 0 {
+				assert.Equal(t, tc.expectDelay, delay)
+			}
+		})
+	}
+}
+
+func TestRetryBackoffJitter(t *testing.T) {
+	p := &retryPolicy{
+		attempts:   1,
+		minBackoff: 100 * time.Millisecond,
+		maxBackoff: 100 * time.Millisecond,
+	}
+
+	for i := 0; i < 100; i++ {
+		delay, retry := p.backoff(1, nil)
+		require.True(t, retry)
+		require.GreaterOrEqual(t, delay, 50*time.Millisecond)
+		require.LessOrEqual(t, delay, 100*time.Millisecond)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cb := newCircuitBreaker(2, time.Minute)
+	cb.now = func() time.Time { return now }
+
+	require.True(t, cb.allow())
+	cb.done(false)
+	require.True(t, cb.allow(), "circuit opened before reaching the threshold")
+	cb.done(false)
+
+	assert.False(t, cb.allow(), "circuit not open after reaching the threshold")
+
+	now = now.Add(time.Minute)
+	assert.True(t, cb.allow(), "trial request not allowed")
+	assert.False(t, cb.allow(), "concurrent request allowed during trial")
+	cb.done(false)
+	assert.False(t, cb.allow(), "circuit not open after failed trial")
+
+	now = now.Add(time.Minute)
+	assert.True(t, cb.allow(), "trial request not allowed")
+	cb.release()
+	assert.True(t, cb.allow(), "trial request not allowed after released trial")
+	cb.done(true)
+	assert.True(t, cb.allow(), "circuit not closed after successful trial")
+	cb.done(false)
+	assert.True(t, cb.allow(), "circuit opened before reaching the threshold")
+}
+
+func TestRetriedHTTPRequests(t *testing.T) {
+	testCases := map[string]struct {
+		// service mock
+		statuses []int
+
+		// adapter config
+		retry   *retryPolicy
+		breaker *circuitBreaker
+		events  int
+
+		// expected
+		expectRequests int32
+		expectStatus   int
+	}{
+		"no retries": {
+			statuses:       []int{http.StatusServiceUnavailable},
+			events:         1,
+			expectRequests: 1,
+			expectStatus:   http.StatusServiceUnavailable,
+		},
+		"retried until success": {
+			statuses:       []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			retry:          &retryPolicy{attempts: 3, minBackoff: time.Millisecond, maxBackoff: time.Millisecond},
+			events:         1,
+			expectRequests: 3,
+		},
+		"retries exhausted": {
+			statuses:       []int{http.StatusInternalServerError},
+			retry:          &retryPolicy{attempts: 2, minBackoff: time.Millisecond, maxBackoff: time.Millisecond},
+			events:         1,
+			expectRequests: 3,
+			expectStatus:   http.StatusInternalServerError,
+		},
+		"client errors not retried": {
+			statuses:       []int{http.StatusBadRequest},
+			retry:          &retryPolicy{attempts: 2, minBackoff: time.Millisecond, maxBackoff: time.Millisecond},
+			events:         1,
+			expectRequests: 1,
+			expectStatus:   http.StatusBadRequest,
+		},
+		"circuit open": {
+			statuses:       []int{http.StatusServiceUnavailable},
+			breaker:        newCircuitBreaker(2, time.Minute),
+			events:         3,
+			expectRequests: 2,
+			expectStatus:   statusCircuitOpen,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var requests int32
+
+			tServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(atomic.AddInt32(&requests, 1))
+				if n > len(tc.statuses) {
+					n = len(tc.statuses)
+				}
+				w.WriteHeader(tc.statuses[n-1])
+			}))
+			t.Cleanup(tServer.Close)
+
+			ceClient, send, responses := cetest.NewMockResponderClient(t, 1)
+
+			u, err := url.Parse(tServer.URL)
+			require.NoError(t, err, "test URL is not valid")
+
+			adapter := &httpAdapter{
+				eventType:   tEventType,
+				eventSource: tEventSource,
+
+				url:     u,
+				method:  http.MethodPost,
+				client:  tServer.Client(),
+				retry:   tc.retry,
+				breaker: tc.breaker,
+
+				ceClient: ceClient,
+				logger:   logtesting.TestLogger(t),
+			}
+
+			go func() {
+				if err := adapter.Start(context.Background()); err != nil {
+					assert.FailNow(t, "could not start test adapter")
+				}
+			}()
+
+			var res cetest.ClientMockResponse
+
+			for i := 0; i < tc.events; i++ {
+				event := ceevent.New()
+				require.NoError(t, event.SetData(tContentType, []byte(`{}`)))
+				event.SetID(tID)
+				event.SetType(tCETypeArbitrary)
+				event.SetSource(tCESource)
+
+				send <- event
+
+				select {
+				case res = <-responses:
+				case <-time.After(1 * time.Second):
+					require.Fail(t, "expected cloud event response was not received")
+				}
+
+				// successful deliveries are followed by their result
+				if res.Event.ID() != "" {
+					<-responses
+				}
+			}
+
+			assert.Equal(t, tc.expectRequests, atomic.LoadInt32(&requests), "unexpected number of requests")
+
+			if tc.expectStatus == 0 {
+				assert.NotEmpty(t, res.Event.ID(), "expected a response event")
+				return
+			}
+
+			var httpResult *cehttp.Result
+			require.True(t, cloudevents.ResultAs(res.Result, &httpResult), "expected an HTTP result")
+			assert.Equal(t, tc.expectStatus, httpResult.StatusCode, "unexpected result status")
+			if tc.breaker != nil {
+				assert.NotContains(t, tc.statuses, httpResult.StatusCode,
+					"circuit open status is not distinguishable from the endpoint statuses")
+			}
+		})
+	}
+}
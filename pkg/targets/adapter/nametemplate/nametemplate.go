@@ -0,0 +1,204 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nametemplate renders names, such as index names or object keys,
+// from the context attributes and the time of CloudEvents.
+package nametemplate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// dateLayouts maps the tokens of date patterns to Go time layouts.
+var dateLayouts = map[string]string{
+	"yyyy": "2006",
+	"yy":   "06",
+	"MM":   "01",
+	"dd":   "02",
+	"HH":   "15",
+	"mm":   "04",
+	"ss":   "05",
+}
+
+// Template renders names from the context attributes and the time of
+// CloudEvents, e.g. "logs-{type}-{yyyy.MM.dd}".
+type Template struct {
+	parts []templatePart
+}
+
+// templatePart is either a literal, a context attribute or a time layout.
+type templatePart struct {
+	literal    string
+	attribute  string
+	timeLayout string
+}
+
+// Parse parses the placeholders between braces of a template.
+// Placeholders made only of date pattern letters (y, M, d, H, m, s) and
+// separators are time layouts, other placeholders are context attributes.
+func Parse(tpl string) (*Template, error) {
+	t := &Template{}
+
+	for rest := tpl; rest != ""; {
+		start := strings.IndexByte(rest, '{')
+		if start == -1 {
+			t.parts = append(t.parts, templatePart{literal: rest})
+			break
+		}
+		if start > 0 {
+			t.parts = append(t.parts, templatePart{literal: rest[:start]})
+		}
+
+		end := strings.IndexByte(rest[start:], '}')
+		if end == -1 {
+			return nil, fmt.Errorf("unclosed placeholder in template %q", tpl)
+		}
+		placeholder := rest[start+1 : start+end]
+		rest = rest[start+end+1:]
+
+		if placeholder == "" {
+			return nil, fmt.Errorf("empty placeholder in template %q", tpl)
+		}
+
+		if !isDatePattern(placeholder) {
+			t.parts = append(t.parts, templatePart{attribute: placeholder})
+			continue
+		}
+
+		layout, err := timeLayout(placeholder)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date pattern in template %q: %w", tpl, err)
+		}
+		t.parts = append(t.parts, templatePart{timeLayout: layout})
+	}
+
+	return t, nil
+}
+
+// Attributes returns the context attributes referenced by the template.
+func (t *Template) Attributes() []string {
+	var attrs []string
+	for _, p := range t.parts {
+		if p.attribute != "" {
+			attrs = append(attrs, p.attribute)
+		}
+	}
+	return attrs
+}
+
+// Render returns the name for the given event. Dates are rendered in UTC
+// from the time of the event, or from the current time when the event
+// has none.
+func (t *Template) Render(event *cloudevents.Event) (string, error) {
+	var sb strings.Builder
+
+	for _, p := range t.parts {
+		switch {
+		case p.attribute != "":
+			val, err := attribute(event, p.attribute)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(val)
+
+		case p.timeLayout != "":
+			ts := event.Time()
+			if ts.IsZero() {
+				ts = time.Now()
+			}
+			sb.WriteString(ts.UTC().Format(p.timeLayout))
+
+		default:
+			sb.WriteString(p.literal)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// attribute returns the value of a context attribute or extension of the event.
+func attribute(event *cloudevents.Event, name string) (string, error) {
+	var val string
+
+	switch name {
+	case "id":
+		val = event.ID()
+	case "source":
+		val = event.Source()
+	case "type":
+		val = event.Type()
+	case "subject":
+		val = event.Subject()
+	case "specversion":
+		val = event.SpecVersion()
+	case "datacontenttype":
+		val = event.DataContentType()
+	case "dataschema":
+		val = event.DataSchema()
+	default:
+		ext, exists := event.Extensions()[name]
+		if !exists {
+			break
+		}
+		str, err := types.ToString(ext)
+		if err != nil {
+			return "", fmt.Errorf("converting attribute %q to string: %w", name, err)
+		}
+		val = str
+	}
+
+	if val == "" {
+		return "", fmt.Errorf("attribute %q is not set in the event", name)
+	}
+	return val, nil
+}
+
+// isDatePattern returns whether the placeholder is a date pattern.
+func isDatePattern(placeholder string) bool {
+	return strings.Trim(placeholder, "yMdHms.-_") == ""
+}
+
+// timeLayout converts a date pattern to a Go time layout.
+func timeLayout(pattern string) (string, error) {
+	var sb strings.Builder
+
+	for i := 0; i < len(pattern); {
+		j := i
+		for j < len(pattern) && pattern[j] == pattern[i] {
+			j++
+		}
+		token := pattern[i:j]
+		i = j
+
+		if strings.ContainsAny(token, ".-_") {
+			sb.WriteString(token)
+			continue
+		}
+
+		layout, ok := dateLayouts[token]
+		if !ok {
+			return "", fmt.Errorf("unsupported token %q", token)
+		}
+		sb.WriteString(layout)
+	}
+
+	return sb.String(), nil
+}
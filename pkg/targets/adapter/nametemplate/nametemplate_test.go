@@ -0,0 +1,101 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nametemplate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestNameTemplate(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetType("com.example.order")
+	event.SetSource("example")
+	event.SetTime(time.Date(2022, 9, 5, 23, 30, 15, 0, time.FixedZone("", 2*3600)))
+	event.SetExtension("tenant", "acme")
+
+	testCases := map[string]struct {
+		template     string
+		expectResult string
+		expectError  bool
+	}{
+		"literal": {
+			template:     "logs",
+			expectResult: "logs",
+		},
+		"attributes": {
+			template:     "logs-{type}-{tenant}",
+			expectResult: "logs-com.example.order-acme",
+		},
+		"date": {
+			template:     "logs-{yyyy.MM.dd}",
+			expectResult: "logs-2022.09.05",
+		},
+		"date and time": {
+			template:     "{yy-MM-dd_HH.mm.ss}-{id}",
+			expectResult: "22-09-05_21.30.15-1234",
+		},
+		"missing attribute": {
+			template:    "logs-{subject}",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tpl, err := Parse(tc.template)
+			require.NoError(t, err)
+
+			res, err := tpl.Render(&event)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectResult, res)
+		})
+	}
+}
+
+func TestParseTemplateErrors(t *testing.T) {
+	testCases := map[string]string{
+		"unclosed placeholder":     "logs-{type",
+		"empty placeholder":        "logs-{}",
+		"unsupported date pattern": "logs-{yyy}",
+	}
+
+	for name, tpl := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(tpl)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTemplateAttributes(t *testing.T) {
+	tpl, err := Parse("{type}/dt={yyyy-MM-dd}/{tenant}-{id}")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"type", "tenant", "id"}, tpl.Attributes())
+}
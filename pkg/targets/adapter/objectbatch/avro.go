@@ -0,0 +1,199 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectbatch
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// avroSchema is the schema of the events written to Avro object container
+// files. Extensions are converted to strings, and the time is expressed in
+// microseconds since the Unix epoch.
+const avroSchema = `{
+  "type": "record",
+  "name": "CloudEvent",
+  "namespace": "io.triggermesh",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "source", "type": "string"},
+    {"name": "type", "type": "string"},
+    {"name": "specversion", "type": "string"},
+    {"name": "subject", "type": ["null", "string"], "default": null},
+    {"name": "time", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}], "default": null},
+    {"name": "datacontenttype", "type": ["null", "string"], "default": null},
+    {"name": "dataschema", "type": ["null", "string"], "default": null},
+    {"name": "extensions", "type": {"type": "map", "values": "string"}, "default": {}},
+    {"name": "data", "type": ["null", "bytes"], "default": null}
+  ]
+}`
+
+// Codecs of Avro object container files, by compression.
+var avroCodecs = map[Compression]string{
+	CompressionNone: "null",
+	CompressionGzip: "deflate",
+	CompressionZstd: "zstandard",
+}
+
+// avroMagic starts Avro object container files.
+var avroMagic = []byte{'O', 'b', 'j', 1}
+
+// writeAvroEvent writes the binary encoding of the event.
+func writeAvroEvent(w *bytes.Buffer, event *cloudevents.Event) error {
+	writeAvroString(w, event.ID())
+	writeAvroString(w, event.Source())
+	writeAvroString(w, event.Type())
+	writeAvroString(w, event.SpecVersion())
+	writeAvroOptionalString(w, event.Subject())
+
+	if t := event.Time(); t.IsZero() {
+		writeAvroLong(w, 0)
+	} else {
+		writeAvroLong(w, 1)
+		writeAvroLong(w, t.UnixMicro())
+	}
+
+	writeAvroOptionalString(w, event.DataContentType())
+	writeAvroOptionalString(w, event.DataSchema())
+
+	exts := event.Extensions()
+	if len(exts) > 0 {
+		names := make([]string, 0, len(exts))
+		for name := range exts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		writeAvroLong(w, int64(len(names)))
+		for _, name := range names {
+			val, err := types.ToString(exts[name])
+			if err != nil {
+				return fmt.Errorf("converting extension %q to string: %w", name, err)
+			}
+			writeAvroString(w, name)
+			writeAvroString(w, val)
+		}
+	}
+	writeAvroLong(w, 0)
+
+	if data := event.Data(); data == nil {
+		writeAvroLong(w, 0)
+	} else {
+		writeAvroLong(w, 1)
+		writeAvroBytes(w, data)
+	}
+
+	return nil
+}
+
+// avroContainer returns an Avro object container file which contains a
+// single block made of the given records.
+func avroContainer(records []byte, count int, c Compression) ([]byte, error) {
+	codec, ok := avroCodecs[c]
+	if !ok {
+		return nil, fmt.Errorf("unsupported compression %q", c)
+	}
+
+	block, err := avroCompress(records, c)
+	if err != nil {
+		return nil, err
+	}
+
+	sync := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, sync); err != nil {
+		return nil, fmt.Errorf("generating sync marker: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(avroMagic)
+
+	// file metadata
+	writeAvroLong(&buf, 2)
+	writeAvroString(&buf, "avro.schema")
+	writeAvroString(&buf, avroSchema)
+	writeAvroString(&buf, "avro.codec")
+	writeAvroString(&buf, codec)
+	writeAvroLong(&buf, 0)
+	buf.Write(sync)
+
+	writeAvroLong(&buf, int64(count))
+	writeAvroBytes(&buf, block)
+	buf.Write(sync)
+
+	return buf.Bytes(), nil
+}
+
+// avroCompress compresses a block of records with the codec of the compression.
+func avroCompress(records []byte, c Compression) ([]byte, error) {
+	switch c {
+	case CompressionGzip:
+		var buf bytes.Buffer
+		w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		if err != nil {
+			return nil, fmt.Errorf("creating deflate encoder: %w", err)
+		}
+		if _, err := w.Write(records); err != nil {
+			return nil, fmt.Errorf("compressing block: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("compressing block: %w", err)
+		}
+		return buf.Bytes(), nil
+
+	case CompressionZstd:
+		return zstdCompress(records)
+	}
+
+	return records, nil
+}
+
+// writeAvroLong writes a zig-zag encoded variable-length integer.
+func writeAvroLong(w *bytes.Buffer, n int64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.Write(buf[:binary.PutVarint(buf[:], n)])
+}
+
+// writeAvroBytes writes a length-prefixed sequence of bytes.
+func writeAvroBytes(w *bytes.Buffer, b []byte) {
+	writeAvroLong(w, int64(len(b)))
+	w.Write(b)
+}
+
+// writeAvroString writes a length-prefixed string.
+func writeAvroString(w *bytes.Buffer, s string) {
+	writeAvroLong(w, int64(len(s)))
+	w.WriteString(s)
+}
+
+// writeAvroOptionalString writes a union of null and string, where the
+// empty string is null.
+func writeAvroOptionalString(w *bytes.Buffer, s string) {
+	if s == "" {
+		writeAvroLong(w, 0)
+		return
+	}
+	writeAvroLong(w, 1)
+	writeAvroString(w, s)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectbatch
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestAvroContainer(t *testing.T) {
+	testCases := map[string]struct {
+		compression Compression
+		expectCodec string
+	}{
+		"no compression": {
+			compression: CompressionNone,
+			expectCodec: "null",
+		},
+		"gzip compression": {
+			compression: CompressionGzip,
+			expectCodec: "deflate",
+		},
+		"zstd compression": {
+			compression: CompressionZstd,
+			expectCodec: "zstandard",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var o *Object
+			b, err := New(Options{MaxEvents: 2, Format: FormatAvro, Compression: tc.compression},
+				func(_ context.Context, obj *Object) error {
+					o = obj
+					return nil
+				}, zap.NewNop().Sugar())
+			require.NoError(t, err)
+
+			e1 := cloudevents.NewEvent()
+			e1.SetID("1")
+			e1.SetType("com.example.order")
+			e1.SetSource("example")
+			e1.SetTime(time.Unix(1662413415, 0))
+			e1.SetExtension("tenant", "acme")
+			require.NoError(t, e1.SetData(cloudevents.ApplicationJSON, map[string]int{"n": 1}))
+
+			e2 := cloudevents.NewEvent()
+			e2.SetID("2")
+			e2.SetType("com.example.order")
+			e2.SetSource("example")
+
+			receipts := make(chan *Receipt, 2)
+			for _, e := range []*cloudevents.Event{&e1, &e2} {
+				go func(e *cloudevents.Event) {
+					r, err := b.Add(context.Background(), "orders/", e, nil)
+					assert.NoError(t, err)
+					receipts <- r
+				}(e)
+			}
+			<-receipts
+			<-receipts
+
+			require.NotNil(t, o)
+			assert.Equal(t, ContentTypeAvro, o.ContentType)
+			assert.Empty(t, o.ContentEncoding)
+			assert.Regexp(t, `^orders/.+\.avro$`, o.Name)
+
+			r := bytes.NewReader(o.Body)
+
+			magic := make([]byte, 4)
+			_, err = io.ReadFull(r, magic)
+			require.NoError(t, err)
+			assert.Equal(t, avroMagic, magic)
+
+			meta := map[string]string{}
+			for n := readLong(t, r); n != 0; n = readLong(t, r) {
+				for i := int64(0); i < n; i++ {
+					meta[string(readBytes(t, r))] = string(readBytes(t, r))
+				}
+			}
+			assert.Equal(t, tc.expectCodec, meta["avro.codec"])
+			assert.True(t, json.Valid([]byte(meta["avro.schema"])), "Schema is not valid JSON")
+
+			sync := make([]byte, 16)
+			_, err = io.ReadFull(r, sync)
+			require.NoError(t, err)
+
+			assert.EqualValues(t, 2, readLong(t, r))
+			block := decompressBlock(t, readBytes(t, r), tc.compression)
+
+			marker := make([]byte, 16)
+			_, err = io.ReadFull(r, marker)
+			require.NoError(t, err)
+			assert.Equal(t, sync, marker)
+			assert.Zero(t, r.Len())
+
+			events := map[string]avroEvent{}
+			br := bytes.NewReader(block)
+			for i := 0; i < 2; i++ {
+				e := readAvroEvent(t, br)
+				events[e.id] = e
+			}
+			assert.Zero(t, br.Len())
+
+			assert.Equal(t, avroEvent{
+				id:          "1",
+				source:      "example",
+				typ:         "com.example.order",
+				time:        1662413415000000,
+				extensions:  map[string]string{"tenant": "acme"},
+				contentType: cloudevents.ApplicationJSON,
+				data:        `{"n":1}`,
+			}, events["1"])
+
+			assert.Equal(t, avroEvent{
+				id:         "2",
+				source:     "example",
+				typ:        "com.example.order",
+				extensions: map[string]string{},
+			}, events["2"])
+		})
+	}
+}
+
+// avroEvent holds the decoded fields of an event, for assertions.
+type avroEvent struct {
+	id, source, typ string
+	time            int64
+	extensions      map[string]string
+	contentType     string
+	data            string
+}
+
+func readAvroEvent(t *testing.T, r *bytes.Reader) avroEvent {
+	t.Helper()
+
+	e := avroEvent{
+		id:         string(readBytes(t, r)),
+		source:     string(readBytes(t, r)),
+		typ:        string(readBytes(t, r)),
+		extensions: map[string]string{},
+	}
+	assert.Equal(t, "1.0", string(readBytes(t, r)))
+
+	// subject
+	assert.Zero(t, readLong(t, r))
+
+	if readLong(t, r) == 1 {
+		e.time = readLong(t, r)
+	}
+	if readLong(t, r) == 1 {
+		e.contentType = string(readBytes(t, r))
+	}
+
+	// dataschema
+	assert.Zero(t, readLong(t, r))
+
+	for n := readLong(t, r); n != 0; n = readLong(t, r) {
+		for i := int64(0); i < n; i++ {
+			e.extensions[string(readBytes(t, r))] = string(readBytes(t, r))
+		}
+	}
+
+	if readLong(t, r) == 1 {
+		e.data = string(readBytes(t, r))
+	}
+
+	return e
+}
+
+func readLong(t *testing.T, r *bytes.Reader) int64 {
+	t.Helper()
+
+	n, err := binary.ReadVarint(r)
+	require.NoError(t, err)
+	return n
+}
+
+func readBytes(t *testing.T, r *bytes.Reader) []byte {
+	t.Helper()
+
+	b := make([]byte, readLong(t, r))
+	_, err := io.ReadFull(r, b)
+	require.NoError(t, err)
+	return b
+}
+
+func decompressBlock(t *testing.T, block []byte, c Compression) []byte {
+	t.Helper()
+
+	switch c {
+	case CompressionGzip:
+		b, err := io.ReadAll(flate.NewReader(bytes.NewReader(block)))
+		require.NoError(t, err)
+		return b
+
+	case CompressionZstd:
+		d, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer d.Close()
+		b, err := d.DecodeAll(block, nil)
+		require.NoError(t, err)
+		return b
+	}
+
+	return block
+}
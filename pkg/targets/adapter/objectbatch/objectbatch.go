@@ -0,0 +1,410 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectbatch groups events into newline-delimited JSON or Avro
+// objects, e.g. to land high volumes of events into object storage buckets.
+package objectbatch
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// Format of the written objects.
+type Format string
+
+// Supported formats.
+const (
+	FormatNDJSON Format = "ndjson"
+	FormatAvro   Format = "avro"
+)
+
+// Content types of the supported formats.
+const (
+	ContentTypeNDJSON = "application/x-ndjson"
+	ContentTypeAvro   = "application/avro"
+)
+
+// Compression of the written objects.
+type Compression string
+
+// Supported compressions.
+const (
+	CompressionNone Compression = "none"
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+// Object is a batch of events ready to be written to the storage.
+type Object struct {
+	// Name of the object, made of the prefix of the batch followed by a
+	// unique suffix and the extension of the format.
+	Name string
+	// Content of the object.
+	Body []byte
+	// Number of events in the object.
+	Events int
+
+	ContentType     string
+	ContentEncoding string
+}
+
+// Receipt is returned for each event once its object has been written.
+type Receipt struct {
+	Object string `json:"object"`
+	Events int    `json:"events"`
+
+	// Whether the event is the last one added to the object.
+	Final bool `json:"-"`
+}
+
+// WriteFunc writes an object to the storage.
+type WriteFunc func(ctx context.Context, o *Object) error
+
+// Options of a Batcher. Batches are written when the first threshold is
+// reached, thresholds with a zero value are disabled.
+type Options struct {
+	MaxEvents   int
+	MaxBytes    int
+	MaxAge      time.Duration
+	Format      Format
+	Compression Compression
+}
+
+// Batcher groups the events which share a prefix into a same object.
+type Batcher struct {
+	opts   Options
+	write  WriteFunc
+	logger *zap.SugaredLogger
+
+	mu      sync.Mutex
+	batches map[string]*batch
+}
+
+// batch is an object being filled with events.
+type batch struct {
+	prefix string
+
+	entries []*entry
+	size    int
+
+	timer *time.Timer
+}
+
+// entry is an event of a batch, along with the channel its sender waits on.
+type entry struct {
+	record []byte
+	res    chan result
+}
+
+// result is the outcome of the writing of a batch.
+type result struct {
+	receipt *Receipt
+	err     error
+}
+
+// New returns a Batcher which writes objects with the given function.
+func New(opts Options, write WriteFunc, logger *zap.SugaredLogger) (*Batcher, error) {
+	switch opts.Format {
+	case "":
+		opts.Format = FormatNDJSON
+	case FormatNDJSON, FormatAvro:
+	default:
+		return nil, fmt.Errorf("unsupported format %q", opts.Format)
+	}
+
+	switch opts.Compression {
+	case "":
+		opts.Compression = CompressionNone
+	case CompressionNone, CompressionGzip, CompressionZstd:
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", opts.Compression)
+	}
+
+	if opts.MaxEvents <= 0 && opts.MaxBytes <= 0 && opts.MaxAge <= 0 {
+		return nil, fmt.Errorf("at least one of the thresholds must be set")
+	}
+
+	return &Batcher{
+		opts:    opts,
+		write:   write,
+		logger:  logger,
+		batches: make(map[string]*batch),
+	}, nil
+}
+
+// Add appends the event to the batch of the given prefix, and waits until
+// the object that contains it is written. NDJSON objects contain the given
+// JSON document of the event, Avro objects contain the event itself.
+//
+// The event is removed from its batch if the context is done before the
+// object is written, so that it is not written along with the redelivery
+// of the event. Once the object is being written, it is no longer removed.
+func (b *Batcher) Add(ctx context.Context, prefix string, event *cloudevents.Event, doc []byte) (*Receipt, error) {
+	var record bytes.Buffer
+
+	switch b.opts.Format {
+	case FormatAvro:
+		if err := writeAvroEvent(&record, event); err != nil {
+			return nil, fmt.Errorf("encoding event to Avro: %w", err)
+		}
+	default:
+		// Documents are delimited by newlines.
+		if err := json.Compact(&record, doc); err != nil {
+			return nil, fmt.Errorf("document is not valid JSON: %w", err)
+		}
+		record.WriteByte('\n')
+	}
+
+	e := &entry{
+		record: record.Bytes(),
+		res:    make(chan result, 1),
+	}
+
+	bt, full := b.add(prefix, e)
+	if full {
+		b.flush(bt)
+	}
+
+	select {
+	case r := <-e.res:
+		return r.receipt, r.err
+	case <-ctx.Done():
+		b.remove(bt, e)
+		return nil, ctx.Err()
+	}
+}
+
+// add appends the entry to the batch of the prefix, and returns this batch
+// along with whether one of the size thresholds is reached, in which case
+// the batch was taken from the pending batches.
+func (b *Batcher) add(prefix string, e *entry) (*batch, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bt, exists := b.batches[prefix]
+	if !exists {
+		bt = &batch{prefix: prefix}
+		b.batches[prefix] = bt
+
+		if b.opts.MaxAge > 0 {
+			bt.timer = time.AfterFunc(b.opts.MaxAge, func() {
+				if b.take(bt) {
+					b.flush(bt)
+				}
+			})
+		}
+	}
+
+	bt.entries = append(bt.entries, e)
+	bt.size += len(e.record)
+
+	if (b.opts.MaxEvents > 0 && len(bt.entries) >= b.opts.MaxEvents) ||
+		(b.opts.MaxBytes > 0 && bt.size >= b.opts.MaxBytes) {
+		b.takeLocked(bt)
+		return bt, true
+	}
+	return bt, false
+}
+
+// remove removes the entry from the batch, unless the batch was already
+// taken to be written. Batches left empty are discarded.
+func (b *Batcher) remove(bt *batch, e *entry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.batches[bt.prefix] != bt {
+		return
+	}
+
+	for i, be := range bt.entries {
+		if be == e {
+			bt.entries = append(bt.entries[:i], bt.entries[i+1:]...)
+			bt.size -= len(e.record)
+			break
+		}
+	}
+
+	if len(bt.entries) == 0 {
+		b.takeLocked(bt)
+	}
+}
+
+// take removes the batch from the pending batches. It returns false if the
+// batch was already taken.
+func (b *Batcher) take(bt *batch) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.batches[bt.prefix] != bt {
+		return false
+	}
+	b.takeLocked(bt)
+	return true
+}
+
+// takeLocked removes the batch from the pending batches.
+// The caller must hold the lock.
+func (b *Batcher) takeLocked(bt *batch) {
+	if bt.timer != nil {
+		bt.timer.Stop()
+	}
+	delete(b.batches, bt.prefix)
+}
+
+// flush writes the batch and delivers the result to its waiters.
+func (b *Batcher) flush(bt *batch) {
+	o, err := b.object(bt)
+	if err == nil {
+		err = b.write(context.Background(), o)
+	}
+
+	if err != nil {
+		b.logger.Errorw("Failed to write batch", zap.String("prefix", bt.prefix),
+			zap.Int("events", len(bt.entries)), zap.Error(err))
+
+		for _, e := range bt.entries {
+			e.res <- result{err: err}
+		}
+		return
+	}
+
+	for i, e := range bt.entries {
+		e.res <- result{
+			receipt: &Receipt{
+				Object: o.Name,
+				Events: o.Events,
+				Final:  i == len(bt.entries)-1,
+			},
+		}
+	}
+}
+
+// object encodes the batch into an object.
+func (b *Batcher) object(bt *batch) (*Object, error) {
+	o := &Object{
+		Name:   bt.prefix + time.Now().UTC().Format("20060102T150405Z") + "-" + uuid.New().String(),
+		Events: len(bt.entries),
+	}
+
+	records := make([]byte, 0, bt.size)
+	for _, e := range bt.entries {
+		records = append(records, e.record...)
+	}
+
+	// Avro files carry their own compression codec.
+	if b.opts.Format == FormatAvro {
+		body, err := avroContainer(records, len(bt.entries), b.opts.Compression)
+		if err != nil {
+			return nil, err
+		}
+		o.Body = body
+		o.Name += ".avro"
+		o.ContentType = ContentTypeAvro
+		return o, nil
+	}
+
+	o.Name += ".ndjson"
+	o.ContentType = ContentTypeNDJSON
+
+	switch b.opts.Compression {
+	case CompressionGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(records); err != nil {
+			return nil, fmt.Errorf("compressing batch: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("compressing batch: %w", err)
+		}
+		o.Body = buf.Bytes()
+		o.Name += ".gz"
+		o.ContentEncoding = string(CompressionGzip)
+
+	case CompressionZstd:
+		body, err := zstdCompress(records)
+		if err != nil {
+			return nil, err
+		}
+		o.Body = body
+		o.Name += ".zst"
+		o.ContentEncoding = string(CompressionZstd)
+
+	default:
+		o.Body = records
+	}
+
+	return o, nil
+}
+
+// zstdCompress compresses data with zstd.
+func zstdCompress(data []byte) ([]byte, error) {
+	w, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating zstd encoder: %w", err)
+	}
+	defer w.Close()
+
+	return w.EncodeAll(data, nil), nil
+}
+
+// Close writes the pending batches.
+func (b *Batcher) Close() {
+	b.mu.Lock()
+	pending := make([]*batch, 0, len(b.batches))
+	for _, bt := range b.batches {
+		b.takeLocked(bt)
+		pending = append(pending, bt)
+	}
+	b.mu.Unlock()
+
+	for _, bt := range pending {
+		b.flush(bt)
+	}
+}
+
+// Config is the configuration of a Batcher, which adapters read from
+// their environment.
+type Config struct {
+	Enabled     bool          `envconfig:"OBJECT_BATCH"`
+	MaxEvents   int           `envconfig:"OBJECT_BATCH_MAX_EVENTS" default:"1000"`
+	MaxBytes    int           `envconfig:"OBJECT_BATCH_MAX_BYTES" default:"5242880"`
+	MaxAge      time.Duration `envconfig:"OBJECT_BATCH_MAX_AGE" default:"30s"`
+	Format      Format        `envconfig:"OBJECT_BATCH_FORMAT" default:"ndjson"`
+	Compression Compression   `envconfig:"OBJECT_BATCH_COMPRESSION" default:"none"`
+}
+
+// Options returns the options of a Batcher.
+func (e *Config) Options() Options {
+	return Options{
+		MaxEvents:   e.MaxEvents,
+		MaxBytes:    e.MaxBytes,
+		MaxAge:      e.MaxAge,
+		Format:      e.Format,
+		Compression: e.Compression,
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectbatch
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBatcher(t *testing.T) {
+	testCases := map[string]struct {
+		opts        Options
+		docs        []string
+		expectNames []string
+		expectBody  string
+	}{
+		"flush on max documents": {
+			opts:        Options{MaxEvents: 3},
+			docs:        []string{`{"n": 1}`, `{"n": 2}`, `{"n": 3}`},
+			expectNames: []string{"p/", ".ndjson"},
+			expectBody:  "{\"n\":1}\n{\"n\":2}\n{\"n\":3}\n",
+		},
+		"flush on max bytes": {
+			opts:        Options{MaxBytes: 8},
+			docs:        []string{`{"n":123456}`},
+			expectNames: []string{"p/", ".ndjson"},
+			expectBody:  "{\"n\":123456}\n",
+		},
+		"flush on max age with gzip": {
+			opts:        Options{MaxAge: 10 * time.Millisecond, Compression: CompressionGzip},
+			docs:        []string{`{"n":1}`, `{"n":2}`},
+			expectNames: []string{"p/", ".ndjson.gz"},
+			expectBody:  "{\"n\":1}\n{\"n\":2}\n",
+		},
+		"flush on max documents with zstd": {
+			opts:        Options{MaxEvents: 2, Compression: CompressionZstd},
+			docs:        []string{`{"n":1}`, `{"n":2}`},
+			expectNames: []string{"p/", ".ndjson.zst"},
+			expectBody:  "{\"n\":1}\n{\"n\":2}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var written []*Object
+			var mu sync.Mutex
+
+			b, err := New(tc.opts, func(_ context.Context, o *Object) error {
+				mu.Lock()
+				defer mu.Unlock()
+				written = append(written, o)
+				return nil
+			}, zap.NewNop().Sugar())
+			require.NoError(t, err)
+
+			receipts := addAll(t, b, "p/", tc.docs)
+
+			require.Len(t, written, 1)
+			o := written[0]
+
+			assert.True(t, strings.HasPrefix(o.Name, tc.expectNames[0]), "Unexpected object name %s", o.Name)
+			assert.True(t, strings.HasSuffix(o.Name, tc.expectNames[1]), "Unexpected object name %s", o.Name)
+			assert.Equal(t, len(tc.docs), o.Events)
+			assert.Equal(t, ContentTypeNDJSON, o.ContentType)
+			// documents are added concurrently, in any order
+			assert.ElementsMatch(t, strings.Split(tc.expectBody, "\n"), strings.Split(decompress(t, o), "\n"))
+
+			var final int
+			for _, r := range receipts {
+				assert.Equal(t, o.Name, r.Object)
+				assert.Equal(t, len(tc.docs), r.Events)
+				if r.Final {
+					final++
+				}
+			}
+			assert.Equal(t, 1, final, "Expected a single final receipt")
+		})
+	}
+}
+
+func TestBatcherPrefixes(t *testing.T) {
+	var written []*Object
+	var mu sync.Mutex
+
+	b, err := New(Options{MaxAge: time.Hour}, func(_ context.Context, o *Object) error {
+		mu.Lock()
+		defer mu.Unlock()
+		written = append(written, o)
+		return nil
+	}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	go func() {
+		// let documents be added before closing
+		time.Sleep(20 * time.Millisecond)
+		b.Close()
+	}()
+
+	var wg sync.WaitGroup
+	for _, prefix := range []string{"a/", "b/", "a/"} {
+		wg.Add(1)
+		go func(prefix string) {
+			defer wg.Done()
+			_, err := b.Add(context.Background(), prefix, nil, []byte(`{}`))
+			assert.NoError(t, err)
+		}(prefix)
+	}
+	wg.Wait()
+
+	require.Len(t, written, 2)
+	counts := map[string]int{}
+	for _, o := range written {
+		counts[o.Name[:2]] = o.Events
+	}
+	assert.Equal(t, map[string]int{"a/": 2, "b/": 1}, counts)
+}
+
+func TestBatcherCanceledEvents(t *testing.T) {
+	var written []*Object
+	var mu sync.Mutex
+
+	b, err := New(Options{MaxEvents: 2}, func(_ context.Context, o *Object) error {
+		mu.Lock()
+		defer mu.Unlock()
+		written = append(written, o)
+		return nil
+	}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = b.Add(ctx, "p/", nil, []byte(`{"n":1}`))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	receipts := addAll(t, b, "p/", []string{`{"n":2}`, `{"n":3}`})
+
+	require.Len(t, written, 1, "Canceled event was counted in its batch")
+	assert.Equal(t, 2, written[0].Events)
+	assert.ElementsMatch(t, []string{`{"n":2}`, `{"n":3}`, ""}, strings.Split(decompress(t, written[0]), "\n"))
+
+	var final int
+	for _, r := range receipts {
+		if r.Final {
+			final++
+		}
+	}
+	assert.Equal(t, 1, final, "Expected a single final receipt")
+}
+
+func TestBatcherErrors(t *testing.T) {
+	_, err := New(Options{MaxEvents: 1, Compression: "lz4"}, nil, nil)
+	assert.EqualError(t, err, `unsupported compression "lz4"`)
+
+	_, err = New(Options{MaxEvents: 1, Format: "csv"}, nil, nil)
+	assert.EqualError(t, err, `unsupported format "csv"`)
+
+	_, err = New(Options{}, nil, nil)
+	assert.Error(t, err)
+
+	errWrite := errors.New("fake error")
+	b, err := New(Options{MaxEvents: 1}, func(context.Context, *Object) error {
+		return errWrite
+	}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	_, err = b.Add(context.Background(), "", nil, []byte(`not JSON`))
+	assert.Error(t, err)
+
+	_, err = b.Add(context.Background(), "", nil, []byte(`{}`))
+	assert.Equal(t, errWrite, err)
+}
+
+func addAll(t *testing.T, b *Batcher, prefix string, docs []string) []*Receipt {
+	t.Helper()
+
+	receipts := make([]*Receipt, len(docs))
+
+	var wg sync.WaitGroup
+	for i, doc := range docs {
+		wg.Add(1)
+		go func(i int, doc string) {
+			defer wg.Done()
+			r, err := b.Add(context.Background(), prefix, nil, []byte(doc))
+			assert.NoError(t, err)
+			receipts[i] = r
+		}(i, doc)
+	}
+	wg.Wait()
+
+	return receipts
+}
+
+func decompress(t *testing.T, o *Object) string {
+	t.Helper()
+
+	var r io.Reader = bytes.NewReader(o.Body)
+
+	switch o.ContentEncoding {
+	case string(CompressionGzip):
+		gr, err := gzip.NewReader(r)
+		require.NoError(t, err)
+		r = gr
+	case string(CompressionZstd):
+		zr, err := zstd.NewReader(r)
+		require.NoError(t, err)
+		defer zr.Close()
+		r = zr
+	}
+
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(b)
+}
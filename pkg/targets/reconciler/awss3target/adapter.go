@@ -58,7 +58,7 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSS3Target) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	env := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -68,4 +68,13 @@ func MakeAppEnv(o *v1alpha1.AWSS3Target) []corev1.EnvVar {
 				Value: strconv.FormatBool(o.Spec.DiscardCEContext),
 			},
 		}...)
+
+	if o.Spec.ObjectKey != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "AWS_S3_OBJECT_KEY",
+			Value: *o.Spec.ObjectKey,
+		})
+	}
+
+	return append(env, reconciler.MakeObjectBatchEnvVars(o.Spec.Batch)...)
 }
@@ -19,6 +19,7 @@ package elasticsearchtarget
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -115,6 +116,41 @@ func MakeAppEnv(o *v1alpha1.ElasticsearchTarget) []corev1.EnvVar {
 		})
 	}
 
+	if o.Spec.DocumentID != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "ELASTICSEARCH_DOCUMENT_ID",
+			Value: *o.Spec.DocumentID,
+		})
+	}
+
+	if b := o.Spec.Bulk; b != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "ELASTICSEARCH_BULK",
+			Value: strconv.FormatBool(true),
+		})
+
+		if b.MaxEvents != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  "ELASTICSEARCH_BULK_MAX_EVENTS",
+				Value: strconv.Itoa(*b.MaxEvents),
+			})
+		}
+
+		if b.MaxBytes != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  "ELASTICSEARCH_BULK_MAX_BYTES",
+				Value: strconv.Itoa(*b.MaxBytes),
+			})
+		}
+
+		if b.FlushInterval != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  "ELASTICSEARCH_BULK_FLUSH_INTERVAL",
+				Value: time.Duration(*b.FlushInterval).String(),
+			})
+		}
+	}
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,
@@ -29,6 +29,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	common "github.com/triggermesh/triggermesh/pkg/reconciler"
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
 const envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
@@ -74,6 +75,22 @@ func MakeAppEnv(o *v1alpha1.GoogleCloudStorageTarget) []corev1.EnvVar {
 		},
 	}
 
+	if o.Spec.ObjectName != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "GOOGLE_STORAGE_OBJECT_NAME",
+			Value: *o.Spec.ObjectName,
+		})
+	}
+
+	if o.Spec.ContentType != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "GOOGLE_STORAGE_CONTENT_TYPE",
+			Value: *o.Spec.ContentType,
+		})
+	}
+
+	env = append(env, reconciler.MakeObjectBatchEnvVars(o.Spec.Batch)...)
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,
@@ -17,9 +17,11 @@ limitations under the License.
 package httptarget
 
 import (
+	"encoding/json"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -47,6 +49,18 @@ const (
 	envHTTPOAuthClientSecret = "HTTP_OAUTH_CLIENT_SECRET"
 	envHTTPOAuthTokenURL     = "HTTP_OAUTH_TOKEN_URL"
 	envHTTPOAuthScopes       = "HTTP_OAUTH_SCOPE"
+	envHTTPRequestTemplate   = "HTTP_REQUEST_TEMPLATE"
+	envHTTPSuccessCodes      = "HTTP_SUCCESS_CODES"
+	envHTTPResponseDataQuery = "HTTP_RESPONSE_DATA_QUERY"
+	envHTTPResponseAttrs     = "HTTP_RESPONSE_ATTRIBUTES"
+
+	envHTTPRetry                   = "HTTP_RETRY"
+	envHTTPRetryAttempts           = "HTTP_RETRY_ATTEMPTS"
+	envHTTPRetryMinBackoff         = "HTTP_RETRY_MIN_BACKOFF"
+	envHTTPRetryMaxBackoff         = "HTTP_RETRY_MAX_BACKOFF"
+	envHTTPCircuitBreaker          = "HTTP_CIRCUIT_BREAKER"
+	envHTTPCircuitBreakerThreshold = "HTTP_CIRCUIT_BREAKER_FAILURE_THRESHOLD"
+	envHTTPCircuitBreakerOpen      = "HTTP_CIRCUIT_BREAKER_OPEN_DURATION"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -178,5 +192,92 @@ func MakeAppEnv(o *v1alpha1.HTTPTarget) []corev1.EnvVar {
 		})
 	}
 
+	// Request templates and response attributes are serialized as JSON.
+	// encoding/json sorts map keys, which keeps the environment stable
+	// across reconciliations.
+
+	if o.Spec.Request != nil {
+		tpl, _ := json.Marshal(o.Spec.Request)
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPRequestTemplate,
+			Value: string(tpl),
+		})
+	}
+
+	if len(o.Spec.Response.SuccessCodes) > 0 {
+		codes := make([]string, len(o.Spec.Response.SuccessCodes))
+		for i, c := range o.Spec.Response.SuccessCodes {
+			codes[i] = strconv.Itoa(c)
+		}
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPSuccessCodes,
+			Value: strings.Join(codes, ","),
+		})
+	}
+
+	if o.Spec.Response.DataQuery != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPResponseDataQuery,
+			Value: *o.Spec.Response.DataQuery,
+		})
+	}
+
+	if len(o.Spec.Response.Attributes) > 0 {
+		attrs, _ := json.Marshal(o.Spec.Response.Attributes)
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPResponseAttrs,
+			Value: string(attrs),
+		})
+	}
+
+	if r := o.Spec.Retry; r != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPRetry,
+			Value: strconv.FormatBool(true),
+		})
+
+		if r.Attempts != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPRetryAttempts,
+				Value: strconv.Itoa(*r.Attempts),
+			})
+		}
+
+		if r.MinBackoff != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPRetryMinBackoff,
+				Value: time.Duration(*r.MinBackoff).String(),
+			})
+		}
+
+		if r.MaxBackoff != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPRetryMaxBackoff,
+				Value: time.Duration(*r.MaxBackoff).String(),
+			})
+		}
+	}
+
+	if cb := o.Spec.CircuitBreaker; cb != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPCircuitBreaker,
+			Value: strconv.FormatBool(true),
+		})
+
+		if cb.FailureThreshold != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPCircuitBreakerThreshold,
+				Value: strconv.Itoa(*cb.FailureThreshold),
+			})
+		}
+
+		if cb.OpenDuration != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPCircuitBreakerOpen,
+				Value: time.Duration(*cb.OpenDuration).String(),
+			})
+		}
+	}
+
 	return env
 }
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// Environment variables of the batching of objects, which are read by the
+// adapters through the objectbatch.Config struct.
+const (
+	envObjectBatch            = "OBJECT_BATCH"
+	envObjectBatchMaxEvents   = "OBJECT_BATCH_MAX_EVENTS"
+	envObjectBatchMaxBytes    = "OBJECT_BATCH_MAX_BYTES"
+	envObjectBatchMaxAge      = "OBJECT_BATCH_MAX_AGE"
+	envObjectBatchFormat      = "OBJECT_BATCH_FORMAT"
+	envObjectBatchCompression = "OBJECT_BATCH_COMPRESSION"
+)
+
+// MakeObjectBatchEnvVars returns environment variables for the given
+// object batching options.
+func MakeObjectBatchEnvVars(b *v1alpha1.ObjectBatchOptions) []corev1.EnvVar {
+	if b == nil {
+		return nil
+	}
+
+	batchEnvVars := []corev1.EnvVar{{
+		Name:  envObjectBatch,
+		Value: strconv.FormatBool(true),
+	}}
+
+	if b.MaxEvents != nil {
+		batchEnvVars = append(batchEnvVars, corev1.EnvVar{
+			Name:  envObjectBatchMaxEvents,
+			Value: strconv.Itoa(*b.MaxEvents),
+		})
+	}
+
+	if b.MaxBytes != nil {
+		batchEnvVars = append(batchEnvVars, corev1.EnvVar{
+			Name:  envObjectBatchMaxBytes,
+			Value: strconv.Itoa(*b.MaxBytes),
+		})
+	}
+
+	if b.MaxAge != nil {
+		batchEnvVars = append(batchEnvVars, corev1.EnvVar{
+			Name:  envObjectBatchMaxAge,
+			Value: time.Duration(*b.MaxAge).String(),
+		})
+	}
+
+	if b.Format != nil {
+		batchEnvVars = append(batchEnvVars, corev1.EnvVar{
+			Name:  envObjectBatchFormat,
+			Value: string(*b.Format),
+		})
+	}
+
+	if b.Compression != nil {
+		batchEnvVars = append(batchEnvVars, corev1.EnvVar{
+			Name:  envObjectBatchCompression,
+			Value: string(*b.Compression),
+		})
+	}
+
+	return batchEnvVars
+}